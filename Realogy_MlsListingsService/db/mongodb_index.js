@@ -27,10 +27,90 @@ db.listings.createIndex({ "dash.company_staff_guid": 1}, { collation: { locale:
 
 db.listings.createIndex({"source_system_key" : 1, "last_change_date" : 1}, {"name" : "sourceSystemKeyLastChangeDateIndex"})
 
+// keyset pagination (page tokens) orders listings by last_change_date and _id.
+db.listings.createIndex({"last_change_date" : 1, "_id" : 1}, {"name" : "lastChangeDateIdIndex"})
+
+db.listings.createIndex({"source_system_key" : 1, "last_change_date" : 1, "_id" : 1}, {"name" : "sourceSystemKeyLastChangeDateIdIndex"})
+
+// sortable fields of list requests ("sort"), with _id to break ties.
+db.listings.createIndex({"property.listing.price.list_price" : 1, "_id" : 1}, {"name" : "listPriceIdIndex"})
+
+db.listings.createIndex({"property.listing.days_on_market" : 1, "_id" : 1}, {"name" : "daysOnMarketIdIndex"})
+
+db.listings.createIndex({"property.listing.dates.listing_contract_date" : 1, "_id" : 1}, {"name" : "listingContractDateIdIndex"})
+
 // indexes for realogy based fields.
 db.listings.createIndex({"realogy.is_realogy_listing" : 1, "realogy.is_luxury_listing" : 1, "property.listing.standard_status": 1, "last_change_date" : 1}, {"name" : "realogyListingsPartialIndex"}, {"partialFilterExpression" : {"realogy.is_realogy_listing" : true, "realogy.is_luxury_listing" : true}})
 
+// geospatial search. "geo_location" is a GeoJSON point derived from property.location.gis latitude/longitude. the service sets it with
+// its writes, and the geo location syncer (api.geo_location.sync) with the writes of feeds. this backfills it before the first sync.
+db.listings.updateMany(
+    { "property.location.gis.latitude": { $nin: [0, null] }, "property.location.gis.longitude": { $nin: [0, null] } },
+    [ { $set: { "geo_location": { "type": "Point", "coordinates": [ "$property.location.gis.longitude", "$property.location.gis.latitude" ] } } } ]
+)
+
+db.listings.createIndex({"geo_location" : "2dsphere"}, {"name" : "geoLocation2dsphereIndex"})
+
+// comparable sales, the most recent sold listings around a subject.
+db.listings.createIndex({"property.listing.standard_status" : 1, "geo_location" : "2dsphere", "property.listing.dates.close_date" : -1}, {"name" : "standardStatusGeoLocationCloseDateIndex"})
+
+// market stats by subdivision and MLS area.
+db.listings.createIndex({"property.location.area.subdivision_name" : 1}, {"name" : "subdivisionNameIndex"})
+
+db.listings.createIndex({"property.location.area.mls_area_major" : 1}, {"name" : "mlsAreaMajorIndex"})
+
+// upcoming open houses, by start time. listings are also matched by their area indexes.
+db.listings.createIndex({"open_house.open_homes.open_house_start_time" : 1}, {"name" : "openHouseStartTimeIndex"})
+
+db.listings.createIndex({"live_stream_open_house.live_stream_open_homes.open_house_start_time" : 1}, {"name" : "liveStreamOpenHouseStartTimeIndex"})
+
+// listing history (price and status changes), read by listing and written once per change stream event.
+db.listing_history.createIndex({"listing_id" : 1, "source_system_key" : 1, "change_time" : 1, "_id" : 1}, {"name" : "listingIdSourceSystemKeyChangeTimeIndex"})
+
+db.listing_history.createIndex({"event_id" : 1}, {"name" : "eventIdIndex", "unique" : true})
+
+db.listing_history.createIndex({"recorded_at" : 1, "_id" : 1}, {"name" : "recordedAtIdIndex"})
+
+// webhooks, listed by the api key that registered them.
+db.listing_webhooks.createIndex({"owner" : 1, "created_time" : 1, "_id" : 1}, {"name" : "ownerCreatedTimeIndex"})
+
+// dead letters of webhooks, listed and replayed by webhook oldest first.
+db.listing_webhook_dead_letters.createIndex({"webhook_id" : 1, "created_time" : 1, "_id" : 1}, {"name" : "webhookIdCreatedTimeIndex"})
+
+// agent and office rosters, refreshed from the listings naming a member in any role. the list agent is found with listAgentMlsIdIndex.
+db.listings.createIndex({"property.listing.agent_office.co_list_agent.co_list_agent_mls_id" : 1}, {"name" : "coListAgentMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.buyer_agent.buyer_agent_mls_id" : 1}, {"name" : "buyerAgentMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.co_buyer_agent.co_buyer_agent_mls_id" : 1}, {"name" : "coBuyerAgentMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.list_office.list_office_mls_id" : 1}, {"name" : "listOfficeMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.co_list_office.co_list_office_mls_id" : 1}, {"name" : "coListOfficeMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.buyer_office.buyer_office_mls_id" : 1}, {"name" : "buyerOfficeMlsIdIndex"})
+
+db.listings.createIndex({"property.listing.agent_office.co_buyer_office.co_buyer_office_mls_id" : 1}, {"name" : "coBuyerOfficeMlsIdIndex"})
+
+// roster members, listed by source ordered by name and found by master id. stale members are deleted by refresh time.
+db.listing_agents.createIndex({"source_system_key" : 1, "full_name" : 1, "_id" : 1}, {"name" : "sourceSystemKeyFullNameIndex"})
+
+db.listing_agents.createIndex({"master_id" : 1, "last_activity_time" : -1}, {"name" : "masterIdLastActivityTimeIndex"})
+
+db.listing_agents.createIndex({"refreshed_at" : 1}, {"name" : "refreshedAtIndex"})
+
+db.listing_offices.createIndex({"source_system_key" : 1, "name" : 1, "_id" : 1}, {"name" : "sourceSystemKeyNameIndex"})
+
+db.listing_offices.createIndex({"refreshed_at" : 1}, {"name" : "refreshedAtIndex"})
+
+// duplicate listing clusters. candidate duplicates are found by property master id, postal code (postalCodeIndex) and geo_location.
+db.listings.createIndex({"master_id.property_master_id" : 1}, {"name" : "propertyMasterIdIndex"})
+
+db.listings.createIndex({"duplicate.cluster_id" : 1}, {"name" : "duplicateClusterIdIndex", "sparse" : true})
+
 // search indexes
+// the sortable fields of list requests are mapped for the "sort" option of $search. the address search index needs the same mappings.
+// "deleted" excludes the tombstones of soft deleted listings from searches.
 {
     "analyzer": "lucene.standard",
     "searchAnalyzer": "lucene.standard",
@@ -40,6 +120,25 @@ db.listings.createIndex({"realogy.is_realogy_listing" : 1, "realogy.is_luxury_li
         "listing_id": {
             "type": "string",
                 "analyzer": "lucene.keyword"
+        },
+        "last_change_date": {
+            "type": "date"
+        },
+        "deleted": {
+            "type": "boolean"
+        },
+        "property": {
+            "type": "document",
+            "fields": {
+                "listing": {
+                    "type": "document",
+                    "fields": {
+                        "days_on_market": { "type": "number" },
+                        "price": { "type": "document", "fields": { "list_price": { "type": "number" } } },
+                        "dates": { "type": "document", "fields": { "listing_contract_date": { "type": "date" } } }
+                    }
+                }
+            }
         }
     }
 }
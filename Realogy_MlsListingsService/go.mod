@@ -42,6 +42,8 @@ require github.com/golang-jwt/jwt/v4 v4.4.1
 
 require (
 	github.com/chidiwilliams/flatbson v0.3.0
+	github.com/golang/protobuf v1.5.2
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.14.0
 	github.com/jinzhu/copier v0.3.5
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.2.0
 )
@@ -51,8 +53,6 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/fatih/structtag v1.2.0 // indirect
 	github.com/golang/glog v1.0.0 // indirect
-	github.com/golang/protobuf v1.5.2 // indirect
-	github.com/grpc-ecosystem/grpc-gateway/v2 v2.14.0 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
 	github.com/lyft/protoc-gen-star v0.6.1 // indirect
@@ -0,0 +1,157 @@
+package comps
+
+import (
+	"math"
+	"sort"
+
+	"mlslisting/internal/config"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// weights of the attributes in the similarity of a sale to the subject.
+const (
+	propertyTypeWeight = 0.2
+	bedroomsWeight     = 0.2
+	bathroomsWeight    = 0.2
+	areaWeight         = 0.25
+	yearBuiltWeight    = 0.15
+)
+
+// differences with the subject at which an attribute no longer adds to the similarity.
+const (
+	roomsTolerance     = 3.0
+	areaTolerance      = 0.5 // a fraction of the subject area.
+	yearBuiltTolerance = 30.0
+)
+
+// earthRadiusMeters is the mean radius of the earth for haversine distances.
+const earthRadiusMeters = 6371000.0
+
+// SubjectOf returns the attributes of a listing as the subject of comparable sales.
+func SubjectOf(listing *pb.MlsListing) *pb.ComparableSubject {
+	property := listing.GetProperty()
+	structure := property.GetStructure()
+	gis := property.GetLocation().GetGis()
+	subject := &pb.ComparableSubject{
+		ListingId:             property.GetListing().GetListingId(),
+		SourceSystemKey:       property.GetListing().GetSourceSystemKey(),
+		PropertyType:          property.GetPropertyType(),
+		BedroomsTotal:         structure.GetBedroomsTotal(),
+		BathroomsTotalInteger: structure.GetBathroomsTotalInteger(),
+		BuildingAreaTotal:     structure.GetBuildingAreaTotal(),
+		YearBuilt:             structure.GetYearBuilt(),
+	}
+	if gis.GetLatitude() != 0 || gis.GetLongitude() != 0 {
+		subject.Point = &pb.GeoPoint{Latitude: gis.GetLatitude(), Longitude: gis.GetLongitude()}
+	}
+	return subject
+}
+
+// Similarity scores a sale from 0 to 1 on the attributes of the subject. Attributes the subject does not have are left out,
+// attributes the sale does not have score 0. A subject without any attribute is equally similar to all sales.
+func Similarity(subject *pb.ComparableSubject, sale *pb.MlsListing) float64 {
+	structure := sale.GetProperty().GetStructure()
+	var score, weights float64
+	add := func(weight float64, similarity float64) {
+		score += weight * similarity
+		weights += weight
+	}
+	if subject.PropertyType != "" {
+		add(propertyTypeWeight, boolScore(subject.PropertyType == sale.GetProperty().GetPropertyType()))
+	}
+	if subject.BedroomsTotal > 0 {
+		add(bedroomsWeight, closeness(float64(subject.BedroomsTotal), float64(structure.GetBedroomsTotal()), roomsTolerance))
+	}
+	if subject.BathroomsTotalInteger > 0 {
+		add(bathroomsWeight, closeness(float64(subject.BathroomsTotalInteger), float64(structure.GetBathroomsTotalInteger()), roomsTolerance))
+	}
+	if subject.BuildingAreaTotal > 0 {
+		add(areaWeight, closeness(subject.BuildingAreaTotal, structure.GetBuildingAreaTotal(), subject.BuildingAreaTotal*areaTolerance))
+	}
+	if subject.YearBuilt > 0 {
+		add(yearBuiltWeight, closeness(float64(subject.YearBuilt), float64(structure.GetYearBuilt()), yearBuiltTolerance))
+	}
+	if weights == 0 {
+		return 1
+	}
+	return score / weights
+}
+
+// closeness is 1 for equal values down to 0 for values a tolerance or more apart, and 0 when the value of the sale is missing.
+func closeness(subject float64, sale float64, tolerance float64) float64 {
+	if sale <= 0 {
+		return 0
+	}
+	return math.Max(0, 1-math.Abs(subject-sale)/tolerance)
+}
+
+func boolScore(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// PricePerSquareFoot is the close price of a sale over its building area, 0 without either.
+func PricePerSquareFoot(sale *pb.MlsListing) float64 {
+	area := sale.GetProperty().GetStructure().GetBuildingAreaTotal()
+	price := sale.GetProperty().GetListing().GetPrice().GetClosePrice()
+	if area <= 0 || price <= 0 {
+		return 0
+	}
+	return price / area
+}
+
+// AdjustedPricePerSquareFoot adjusts the price per square foot of a sale by a percentage per bedroom, bathroom and decade of
+// year built of difference with the subject: a sale with fewer bedrooms than the subject is adjusted up. Attributes missing
+// from the subject or the sale are not adjusted for.
+func AdjustedPricePerSquareFoot(subject *pb.ComparableSubject, sale *pb.MlsListing, adjustments config.CompsAdjustments) float64 {
+	structure := sale.GetProperty().GetStructure()
+	var pct float64
+	if subject.BedroomsTotal > 0 && structure.GetBedroomsTotal() > 0 {
+		pct += float64(subject.BedroomsTotal-structure.GetBedroomsTotal()) * adjustments.BedroomPct
+	}
+	if subject.BathroomsTotalInteger > 0 && structure.GetBathroomsTotalInteger() > 0 {
+		pct += float64(subject.BathroomsTotalInteger-structure.GetBathroomsTotalInteger()) * adjustments.BathroomPct
+	}
+	if subject.YearBuilt > 0 && structure.GetYearBuilt() > 0 {
+		pct += float64(subject.YearBuilt-structure.GetYearBuilt()) / 10 * adjustments.YearBuiltPctPerDecade
+	}
+	return PricePerSquareFoot(sale) * (1 + pct/100)
+}
+
+// DistanceMeters is the great circle distance between two points.
+func DistanceMeters(a *pb.GeoPoint, b *pb.GeoPoint) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Rank scores the sales against the subject and returns the limit most similar, the closest first among equally similar sales.
+func Rank(subject *pb.ComparableSubject, sales []*pb.MlsListing, adjustments config.CompsAdjustments, limit int) []*pb.ComparableSale {
+	comparables := make([]*pb.ComparableSale, 0, len(sales))
+	for _, sale := range sales {
+		comparable := &pb.ComparableSale{
+			MlsListing:                 sale,
+			Similarity:                 Similarity(subject, sale),
+			PricePerSquareFoot:         PricePerSquareFoot(sale),
+			AdjustedPricePerSquareFoot: AdjustedPricePerSquareFoot(subject, sale, adjustments),
+		}
+		if gis := sale.GetProperty().GetLocation().GetGis(); subject.Point != nil && gis != nil {
+			comparable.DistanceMeters = DistanceMeters(subject.Point, &pb.GeoPoint{Latitude: gis.Latitude, Longitude: gis.Longitude})
+		}
+		comparables = append(comparables, comparable)
+	}
+	sort.SliceStable(comparables, func(i, j int) bool {
+		if comparables[i].Similarity != comparables[j].Similarity {
+			return comparables[i].Similarity > comparables[j].Similarity
+		}
+		return comparables[i].DistanceMeters < comparables[j].DistanceMeters
+	})
+	if len(comparables) > limit {
+		comparables = comparables[:limit]
+	}
+	return comparables
+}
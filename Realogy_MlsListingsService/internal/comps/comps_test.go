@@ -0,0 +1,78 @@
+package comps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mlslisting/internal/config"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func sale(id string, propertyType string, bedrooms int32, bathrooms int32, area float64, yearBuilt int32, closePrice float64, lat float64) *pb.MlsListing {
+	return &pb.MlsListing{Property: &pb.Property{
+		PropertyType: propertyType,
+		Listing:      &pb.Listing{ListingId: id, Price: &pb.Price{ClosePrice: closePrice}},
+		Structure:    &pb.Structure{BedroomsTotal: bedrooms, BathroomsTotalInteger: bathrooms, BuildingAreaTotal: area, YearBuilt: yearBuilt},
+		Location:     &pb.Location{Gis: &pb.Gis{Latitude: lat, Longitude: -117.8}},
+	}}
+}
+
+var subject = &pb.ComparableSubject{
+	Point:                 &pb.GeoPoint{Latitude: 33.6, Longitude: -117.8},
+	PropertyType:          "RESIDENTIAL",
+	BedroomsTotal:         3,
+	BathroomsTotalInteger: 2,
+	BuildingAreaTotal:     2000,
+	YearBuilt:             1990,
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity(subject, sale("1", "RESIDENTIAL", 3, 2, 2000, 1990, 0, 0)))
+	assert.Equal(t, 0.0, Similarity(subject, sale("2", "LAND", 0, 0, 0, 0, 0, 0)))
+
+	// one bedroom less scores a third less on bedrooms, 20% of the score.
+	assert.InDelta(t, 1-0.2/3, Similarity(subject, sale("3", "RESIDENTIAL", 2, 2, 2000, 1990, 0, 0)), 1e-9)
+
+	// attributes missing from the subject are left out.
+	assert.Equal(t, 1.0, Similarity(&pb.ComparableSubject{BedroomsTotal: 3}, sale("4", "LAND", 3, 0, 0, 0, 0, 0)))
+	assert.Equal(t, 1.0, Similarity(&pb.ComparableSubject{}, sale("5", "LAND", 0, 0, 0, 0, 0, 0)))
+}
+
+func TestAdjustedPricePerSquareFoot(t *testing.T) {
+	adjustments := config.CompsAdjustments{BedroomPct: 3, BathroomPct: 2, YearBuiltPctPerDecade: 1}
+
+	same := sale("1", "RESIDENTIAL", 3, 2, 2000, 1990, 1000000, 0)
+	assert.Equal(t, 500.0, PricePerSquareFoot(same))
+	assert.Equal(t, 500.0, AdjustedPricePerSquareFoot(subject, same, adjustments))
+
+	// a smaller and older sale is adjusted up: +3% for the bedroom, +2% for the bathroom and +2% for two decades.
+	smaller := sale("2", "RESIDENTIAL", 2, 1, 1000, 1970, 400000, 0)
+	assert.InDelta(t, 400*1.07, AdjustedPricePerSquareFoot(subject, smaller, adjustments), 1e-9)
+
+	assert.Equal(t, 0.0, AdjustedPricePerSquareFoot(subject, sale("3", "RESIDENTIAL", 3, 2, 0, 1990, 1000000, 0), adjustments))
+}
+
+func TestDistanceMeters(t *testing.T) {
+	// a degree of latitude is about 111 km.
+	assert.InDelta(t, 111195, DistanceMeters(&pb.GeoPoint{Latitude: 33, Longitude: -117}, &pb.GeoPoint{Latitude: 34, Longitude: -117}), 1)
+	assert.Equal(t, 0.0, DistanceMeters(subject.Point, subject.Point))
+}
+
+func TestRank(t *testing.T) {
+	sales := []*pb.MlsListing{
+		sale("far", "RESIDENTIAL", 3, 2, 2000, 1990, 1000000, 33.62),
+		sale("land", "LAND", 0, 0, 0, 0, 100000, 33.6),
+		sale("near", "RESIDENTIAL", 3, 2, 2000, 1990, 1000000, 33.601),
+		sale("smaller", "RESIDENTIAL", 2, 2, 1800, 1990, 800000, 33.6),
+	}
+	comparables := Rank(subject, sales, config.CompsAdjustments{}, 3)
+
+	var ids []string
+	for _, comparable := range comparables {
+		ids = append(ids, comparable.MlsListing.Property.Listing.ListingId)
+	}
+	// equally similar sales are ordered by distance.
+	assert.Equal(t, []string{"near", "far", "smaller"}, ids)
+	assert.InDelta(t, 111, comparables[0].DistanceMeters, 1)
+}
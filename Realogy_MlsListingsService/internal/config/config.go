@@ -33,20 +33,80 @@ type Config struct {
 }
 
 type ApiConfig struct {
-	Pagination PaginationConfig `mapstructure:"pagination"`
-	Stream     StreamConfig     `mapstructure:"stream"`
-	BySource   BySource         `mapstructure:"by_source"`
-	ByAddress  ByAddress        `mapstructure:"by_address"`
-	Auth       Auth             `mapstructure:"auth"`
+	Pagination   PaginationConfig   `mapstructure:"pagination"`
+	Stream       StreamConfig       `mapstructure:"stream"`
+	BySource     BySource           `mapstructure:"by_source"`
+	ByAddress    ByAddress          `mapstructure:"by_address"`
+	Auth         Auth               `mapstructure:"auth"`
+	DisplayRules DisplayRulesConfig `mapstructure:"display_rules"`
+	History      HistoryConfig      `mapstructure:"history"`
+	Webhooks     WebhooksConfig     `mapstructure:"webhooks"`
+	Roster       RosterConfig       `mapstructure:"roster"`
+	Dedup        DedupConfig        `mapstructure:"dedup"`
+	GeoLocation  GeoLocationConfig  `mapstructure:"geo_location"`
+	Bulk         BulkConfig         `mapstructure:"bulk"`
+	Export       ExportConfig       `mapstructure:"export"`
+	GeoJSON      GeoJSONConfig      `mapstructure:"geojson"`
+	Patch        PatchConfig        `mapstructure:"patch"`
+	Comps        CompsConfig        `mapstructure:"comps"`
+	MarketStats  MarketStatsConfig  `mapstructure:"market_stats"`
 }
 
 type PaginationConfig struct {
-	LimitDefault int32 `mapstructure:"limit_default"`
-	LimitMax     int32 `mapstructure:"limit_max"`
+	LimitDefault       int32 `mapstructure:"limit_default"`
+	LimitMax           int32 `mapstructure:"limit_max"`
+	CountEstimateLimit int64 `mapstructure:"count_estimate_limit"`
 }
 
 type StreamConfig struct {
-	DeadlineSecs int32 `mapstructure:"deadline_secs"`
+	DeadlineSecs           int32              `mapstructure:"deadline_secs"`
+	CheckpointIntervalSecs int32              `mapstructure:"checkpoint_interval_secs"`
+	Shared                 SharedStreamConfig `mapstructure:"shared"`
+}
+
+type SharedStreamConfig struct {
+	Enabled              bool  `mapstructure:"enabled"`
+	SubscriberBufferSize int   `mapstructure:"subscriber_buffer_size"`
+	ReplaySize           int   `mapstructure:"replay_size"`
+	IdleSecs             int32 `mapstructure:"idle_secs"`
+}
+
+type BulkConfig struct {
+	MaxListings int `mapstructure:"max_listings"`
+}
+
+type ExportConfig struct {
+	ChunkSizeBytes   int `mapstructure:"chunk_size_bytes"`
+	MaxQueryTimeSecs int `mapstructure:"max_query_time_secs"`
+}
+
+type PatchConfig struct {
+	Policies []PatchPolicy `mapstructure:"policies"`
+}
+
+type PatchPolicy struct {
+	Sources        []string `mapstructure:"sources"`
+	WritableFields []string `mapstructure:"writable_fields"`
+}
+
+// WritableFields returns the listing fields a source may patch, from its first policy or else the first "*" one.
+func (c *PatchConfig) WritableFields(source string) []string {
+	var fallback []string
+	for _, policy := range c.Policies {
+		for _, s := range policy.Sources {
+			if s == source {
+				return policy.WritableFields
+			}
+			if s == "*" && fallback == nil {
+				fallback = policy.WritableFields
+			}
+		}
+	}
+	return fallback
+}
+
+type GeoJSONConfig struct {
+	Properties []string `mapstructure:"properties"`
 }
 
 type BySource struct {
@@ -71,6 +131,69 @@ type PrometheusConfig struct {
 	Port uint16 `mapstructure:"port"`
 }
 
+type DisplayRulesConfig struct {
+	Address      string `mapstructure:"address"`
+	CacheTtlSecs int32  `mapstructure:"cache_ttl_secs"`
+}
+
+type HistoryConfig struct {
+	Record bool `mapstructure:"record"`
+}
+
+type WebhooksConfig struct {
+	Deliver           bool  `mapstructure:"deliver"`
+	BatchSize         int   `mapstructure:"batch_size"`
+	BatchIntervalSecs int32 `mapstructure:"batch_interval_secs"`
+	QueueSize         int   `mapstructure:"queue_size"`
+	MaxAttempts       int   `mapstructure:"max_attempts"`
+	RetryBackoffSecs  int32 `mapstructure:"retry_backoff_secs"`
+	TimeoutSecs       int32 `mapstructure:"timeout_secs"`
+}
+
+type CompsConfig struct {
+	DefaultRadiusMeters   float64          `mapstructure:"default_radius_meters"`
+	MaxRadiusMeters       float64          `mapstructure:"max_radius_meters"`
+	DefaultSoldWithinDays int32            `mapstructure:"default_sold_within_days"`
+	MaxSoldWithinDays     int32            `mapstructure:"max_sold_within_days"`
+	LimitDefault          int32            `mapstructure:"limit_default"`
+	LimitMax              int32            `mapstructure:"limit_max"`
+	MaxCandidates         int64            `mapstructure:"max_candidates"`
+	Adjustments           CompsAdjustments `mapstructure:"adjustments"`
+}
+
+// CompsAdjustments are the percentages the price per square foot of a comparable sale is adjusted by, per difference with the subject.
+type CompsAdjustments struct {
+	BedroomPct            float64 `mapstructure:"bedroom_pct"`
+	BathroomPct           float64 `mapstructure:"bathroom_pct"`
+	YearBuiltPctPerDecade float64 `mapstructure:"year_built_pct_per_decade"`
+}
+
+type MarketStatsConfig struct {
+	DefaultWindowDays int32 `mapstructure:"default_window_days"`
+	MaxWindowDays     int32 `mapstructure:"max_window_days"`
+	MaxAreas          int   `mapstructure:"max_areas"`
+	CacheTtlSecs      int32 `mapstructure:"cache_ttl_secs"`
+	MaxQueryTimeSecs  int   `mapstructure:"max_query_time_secs"`
+}
+
+type RosterConfig struct {
+	Build               bool  `mapstructure:"build"`
+	RebuildIntervalSecs int32 `mapstructure:"rebuild_interval_secs"`
+}
+
+type GeoLocationConfig struct {
+	Sync               bool  `mapstructure:"sync"`
+	ResyncIntervalSecs int32 `mapstructure:"resync_interval_secs"`
+}
+
+type DedupConfig struct {
+	Build               bool     `mapstructure:"build"`
+	RebuildIntervalSecs int32    `mapstructure:"rebuild_interval_secs"`
+	ProximityMeters     float64  `mapstructure:"proximity_meters"`
+	MaxClusterSize      int      `mapstructure:"max_cluster_size"`
+	SourcePriority      []string `mapstructure:"source_priority"`
+}
+
 type Auth struct {
 	AccessRules string `mapstructure:"accessRules"`
 }
@@ -0,0 +1,321 @@
+package dedup
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/proto"
+
+	"mlslisting/internal/config"
+	"mlslisting/internal/derived"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+	"mlslisting/internal/tombstone"
+)
+
+const retryDelay = 10 * time.Second
+
+// earthRadiusMeters converts a distance to radians for $centerSphere.
+const earthRadiusMeters = 6378100.0
+
+// maxCandidates is the most listings matched against a listing of a cluster.
+const maxCandidates = 100
+
+// listing document fields the candidate duplicates of a listing are found by.
+const (
+	sourceField          = "source_system_key"
+	propertyMasterIdPath = "master_id.property_master_id"
+	postalCodePath       = "property.location.address.postal_code"
+	unparsedAddressPath  = "property.location.address.unparsed_address"
+)
+
+// listingEvent is a listing change event, projected to the id of the listing.
+type listingEvent struct {
+	DocumentKey struct {
+		Id string `bson:"_id"`
+	} `bson:"documentKey"`
+}
+
+// Builder keeps the duplicate clusters of the listings current. The clusters of changed listings are refreshed from the change
+// stream of the listings collection, and all the clusters are rebuilt every interval: clusters of hard deleted listings are
+// only corrected then.
+// Builds are not coordinated across instances of the service, a single instance should run the builder.
+type Builder struct {
+	listings        *mongo.Collection
+	config          *config.DedupConfig
+	rebuildInterval time.Duration
+}
+
+func NewBuilder(listings *mongo.Collection, dedupConfig *config.DedupConfig, rebuildInterval time.Duration) *Builder {
+	return &Builder{
+		listings:        listings,
+		config:          dedupConfig,
+		rebuildInterval: rebuildInterval,
+	}
+}
+
+// Run rebuilds the clusters and refreshes them from listing changes until ctx is done.
+func (b *Builder) Run(ctx context.Context) {
+	go b.rebuildEvery(ctx)
+	for ctx.Err() == nil {
+		if err := b.watch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Duplicate listings change stream failed, restarting. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (b *Builder) rebuildEvery(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := b.Rebuild(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Unable to rebuild the duplicate listing clusters. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(b.rebuildInterval):
+		}
+	}
+}
+
+// Rebuild clusters all the listings again, and removes the markers of the deleted ones.
+func (b *Builder) Rebuild(ctx context.Context) error {
+	started := time.Now()
+	cursor, err := b.listings.Find(ctx, bson.D{tombstone.NotDeleted()},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetNoCursorTimeout(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	visited := map[string]bool{}
+	clusters := 0
+	for cursor.Next(ctx) {
+		listing, err := Decode(cursor)
+		if err != nil {
+			return err
+		}
+		if visited[listing.Id] {
+			continue
+		}
+		cluster, err := b.cluster(ctx, listing, visited)
+		if err != nil {
+			return err
+		}
+		if err := b.mark(ctx, cluster); err != nil {
+			return err
+		}
+		if len(cluster) > 1 {
+			clusters++
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	if _, err := b.listings.UpdateMany(ctx,
+		bson.D{tombstone.Deleted(), {Key: derived.DuplicateField, Value: bson.D{{Key: "$exists", Value: true}}}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: derived.DuplicateField, Value: ""}}}}); err != nil {
+		return err
+	}
+	log.Infof("Rebuilt %d duplicate listing clusters in %v", clusters, time.Since(started))
+	return nil
+}
+
+func (b *Builder) watch(ctx context.Context) error {
+	pipeline := mongo.Pipeline{
+		derived.ChangeStage(),
+		bson.D{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace", "delete"}}}}}}},
+		bson.D{{Key: "$project", Value: bson.D{{Key: "documentKey", Value: 1}}}},
+	}
+	cs, err := b.listings.Watch(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var event listingEvent
+		if err := cs.Decode(&event); err != nil {
+			log.Errorf("Unable to decode listing event for the duplicate listings. %v", err)
+			continue
+		}
+		if err := b.Refresh(ctx, event.DocumentKey.Id); err != nil {
+			return err
+		}
+	}
+	return cs.Err()
+}
+
+// Refresh clusters a listing again, with the listings of its cluster and of the clusters its new duplicates were in.
+// A hard deleted listing is taken out of the cluster it is the id or the canonical listing of.
+func (b *Builder) Refresh(ctx context.Context, id string) error {
+	seeds, err := b.find(ctx, bson.D{{Key: "_id", Value: id}}, 1)
+	if err != nil {
+		return err
+	}
+	if len(seeds) == 0 {
+		if seeds, err = b.find(ctx, bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: derived.ClusterIdPath, Value: id}},
+			bson.D{{Key: derived.CanonicalMlsIdPath, Value: id}},
+		}}}, 0); err != nil {
+			return err
+		}
+	}
+
+	visited := map[string]bool{}
+	expanded := map[string]bool{}
+	for len(seeds) > 0 {
+		seed := seeds[0]
+		seeds = seeds[1:]
+		if visited[seed.Id] {
+			continue
+		}
+		if seeds, err = b.expand(ctx, seed, expanded, seeds); err != nil {
+			return err
+		}
+		if seed.Deleted {
+			visited[seed.Id] = true
+			if err := b.mark(ctx, []Listing{seed}); err != nil {
+				return err
+			}
+			continue
+		}
+		cluster, err := b.cluster(ctx, seed, visited)
+		if err != nil {
+			return err
+		}
+		for _, member := range cluster {
+			if seeds, err = b.expand(ctx, member, expanded, seeds); err != nil {
+				return err
+			}
+		}
+		if err := b.mark(ctx, cluster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expand appends the listings of the current cluster of a listing to the seeds, once per cluster.
+func (b *Builder) expand(ctx context.Context, listing Listing, expanded map[string]bool, seeds []Listing) ([]Listing, error) {
+	clusterId := listing.MlsListing.GetDuplicate().GetClusterId()
+	if clusterId == "" || expanded[clusterId] {
+		return seeds, nil
+	}
+	expanded[clusterId] = true
+	members, err := b.find(ctx, bson.D{{Key: derived.ClusterIdPath, Value: clusterId}}, 0)
+	if err != nil {
+		return nil, err
+	}
+	return append(seeds, members...), nil
+}
+
+// cluster returns the listings matching a listing, and the listings matching them, up to the max cluster size. A cluster has
+// a single listing of each source, and the listings of visited are in other clusters already.
+func (b *Builder) cluster(ctx context.Context, seed Listing, visited map[string]bool) ([]Listing, error) {
+	visited[seed.Id] = true
+	cluster := []Listing{seed}
+	sources := map[string]bool{source(seed.MlsListing): true}
+	for i := 0; i < len(cluster) && len(cluster) < b.config.MaxClusterSize; i++ {
+		candidates, err := b.candidates(ctx, cluster[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates {
+			if visited[candidate.Id] || sources[source(candidate.MlsListing)] || len(cluster) >= b.config.MaxClusterSize {
+				continue
+			}
+			if len(Match(cluster[i].MlsListing, candidate.MlsListing, b.config.ProximityMeters)) == 0 {
+				continue
+			}
+			visited[candidate.Id] = true
+			sources[source(candidate.MlsListing)] = true
+			cluster = append(cluster, candidate)
+		}
+	}
+	return cluster, nil
+}
+
+// candidates returns the listings of other sources that may match a listing: with its property master id, its street number
+// in its postal code, or within the proximity of its location.
+func (b *Builder) candidates(ctx context.Context, listing Listing) ([]Listing, error) {
+	var matches bson.A
+	if id := propertyMasterId(listing.MlsListing); id != "" {
+		matches = append(matches, bson.D{{Key: propertyMasterIdPath, Value: id}})
+	}
+	if number, postalCode := StreetNumber(listing.MlsListing), postalCode5(listing.MlsListing); number != "" && postalCode != "" {
+		matches = append(matches, bson.D{
+			{Key: postalCodePath, Value: bson.D{{Key: "$regex", Value: "^" + regexp.QuoteMeta(postalCode)}}},
+			{Key: unparsedAddressPath, Value: bson.D{{Key: "$regex", Value: "^" + regexp.QuoteMeta(number) + `\b`}, {Key: "$options", Value: "i"}}},
+		})
+	}
+	if p := Point(listing.MlsListing); p != nil {
+		matches = append(matches, bson.D{{Key: derived.GeoLocationField, Value: bson.D{{Key: "$geoWithin", Value: bson.D{{Key: "$centerSphere", Value: bson.A{
+			bson.A{p.Longitude, p.Latitude},
+			b.config.ProximityMeters / earthRadiusMeters,
+		}}}}}}})
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return b.find(ctx, bson.D{
+		{Key: "$or", Value: matches},
+		{Key: sourceField, Value: bson.D{{Key: "$ne", Value: source(listing.MlsListing)}}},
+		tombstone.NotDeleted(),
+	}, maxCandidates)
+}
+
+func (b *Builder) find(ctx context.Context, filter bson.D, limit int64) ([]Listing, error) {
+	cursor, err := b.listings.Find(ctx, filter, options.Find().SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var listings []Listing
+	for cursor.Next(ctx) {
+		listing, err := Decode(cursor)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, listing)
+	}
+	return listings, cursor.Err()
+}
+
+// mark writes the markers of the listings of a cluster that changed, deleted listings and listings alone have none.
+func (b *Builder) mark(ctx context.Context, cluster []Listing) error {
+	var markers map[string]*pb.DuplicateCluster
+	if len(cluster) > 0 && !cluster[0].Deleted {
+		markers = Markers(cluster, b.config.SourcePriority)
+	}
+	for _, listing := range cluster {
+		marker, current := markers[listing.Id], listing.MlsListing.GetDuplicate()
+		var update bson.D
+		switch {
+		case marker == nil && current.GetClusterId() == "":
+			continue
+		case marker == nil:
+			update = bson.D{{Key: "$unset", Value: bson.D{{Key: derived.DuplicateField, Value: ""}}}}
+		case proto.Equal(marker, current):
+			continue
+		default:
+			update = bson.D{{Key: "$set", Value: bson.D{{Key: derived.DuplicateField, Value: bson.D{
+				{Key: "cluster_id", Value: marker.ClusterId},
+				{Key: "canonical", Value: marker.Canonical},
+				{Key: "canonical_mls_id", Value: marker.CanonicalMlsId},
+				{Key: "size", Value: marker.Size},
+			}}}}}
+		}
+		if _, err := b.listings.UpdateOne(ctx, bson.D{{Key: "_id", Value: listing.Id}}, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Package dedup clusters the listings of the same property from several sources. The listings of a cluster are marked with
+// their duplicate cluster, and one of them stands for the cluster as its canonical listing.
+package dedup
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"mlslisting/internal/comps"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+	"mlslisting/internal/tombstone"
+)
+
+// how a listing matches another.
+const (
+	ByPropertyMasterId = "property_master_id"
+	ByAddress          = "address"
+	ByProximity        = "proximity"
+)
+
+// Listing is a listing with the id of its document.
+type Listing struct {
+	Id         string
+	MlsListing *pb.MlsListing
+	Deleted    bool
+}
+
+// Decode decodes the listing document of the cursor, pb listings have no document id.
+func Decode(cursor *mongo.Cursor) (Listing, error) {
+	var listing pb.MlsListing
+	if err := cursor.Decode(&listing); err != nil {
+		return Listing{}, err
+	}
+	id, _ := cursor.Current.Lookup("_id").StringValueOK()
+	deleted, _ := cursor.Current.Lookup(tombstone.DeletedField).BooleanOK()
+	return Listing{Id: id, MlsListing: &listing, Deleted: deleted}, nil
+}
+
+var (
+	nonAlphanumeric = regexp.MustCompile(`[^A-Z0-9#]+`)
+	digits          = regexp.MustCompile(`[0-9]`)
+)
+
+// abbreviations are the standard abbreviations of the words of street addresses, unit designators are all "#".
+var abbreviations = map[string]string{
+	"STREET": "ST", "AVENUE": "AVE", "AV": "AVE", "ROAD": "RD", "DRIVE": "DR", "BOULEVARD": "BLVD", "LANE": "LN", "COURT": "CT",
+	"PLACE": "PL", "TERRACE": "TER", "CIRCLE": "CIR", "PARKWAY": "PKWY", "HIGHWAY": "HWY", "TRAIL": "TRL", "SQUARE": "SQ",
+	"NORTH": "N", "SOUTH": "S", "EAST": "E", "WEST": "W", "NORTHEAST": "NE", "NORTHWEST": "NW", "SOUTHEAST": "SE", "SOUTHWEST": "SW",
+	"APARTMENT": "#", "APT": "#", "UNIT": "#", "SUITE": "#", "STE": "#",
+}
+
+// Address is the normalized street address of a listing, with its unit number when the address does not have it, or "".
+func Address(listing *pb.MlsListing) string {
+	address := listing.GetProperty().GetLocation().GetAddress()
+	words := strings.Fields(nonAlphanumeric.ReplaceAllString(strings.ReplaceAll(strings.ToUpper(address.GetUnparsedAddress()), "#", " # "), " "))
+	if len(words) == 0 {
+		return ""
+	}
+	for i, word := range words {
+		if abbreviation, ok := abbreviations[word]; ok {
+			words[i] = abbreviation
+		}
+	}
+	// "# 4" and "#4" are the same unit, a trailing designator without a number is dropped.
+	normalized := strings.ReplaceAll(strings.Join(words, " "), "# ", "#")
+	normalized = strings.TrimSuffix(strings.TrimSuffix(normalized, "#"), " ")
+	if unit := strings.ToUpper(strings.TrimSpace(address.GetUnitNumber())); unit != "" && !strings.HasSuffix(normalized, unit) {
+		normalized += " #" + strings.TrimPrefix(unit, "#")
+	}
+	return normalized
+}
+
+// AddressKey is the normalized address of a listing within its 5 digit postal code, "" without both.
+func AddressKey(listing *pb.MlsListing) string {
+	address, postalCode := Address(listing), postalCode5(listing)
+	if address == "" || postalCode == "" {
+		return ""
+	}
+	return address + "|" + postalCode
+}
+
+// StreetNumber is the street number of a listing, the first word of its normalized address when it has a digit.
+func StreetNumber(listing *pb.MlsListing) string {
+	if words := strings.Fields(Address(listing)); len(words) > 0 && digits.MatchString(words[0]) {
+		return words[0]
+	}
+	return strings.ToUpper(strings.TrimSpace(listing.GetProperty().GetLocation().GetAddress().GetStreetNumber()))
+}
+
+// Unit is the unit number of a listing, "" for a listing of a whole property.
+func Unit(listing *pb.MlsListing) string {
+	address := Address(listing)
+	if i := strings.LastIndex(address, "#"); i >= 0 {
+		return address[i+1:]
+	}
+	return ""
+}
+
+// Point is the location of a listing, nil without one.
+func Point(listing *pb.MlsListing) *pb.GeoPoint {
+	return comps.SubjectOf(listing).Point
+}
+
+func postalCode5(listing *pb.MlsListing) string {
+	postalCode := strings.TrimSpace(listing.GetProperty().GetLocation().GetAddress().GetPostalCode())
+	if len(postalCode) > 5 {
+		return postalCode[:5]
+	}
+	return postalCode
+}
+
+func propertyMasterId(listing *pb.MlsListing) string {
+	return listing.GetMasterId().GetPropertyMasterId()
+}
+
+func source(listing *pb.MlsListing) string {
+	return listing.GetProperty().GetListing().GetSourceSystemKey()
+}
+
+// Match returns how two listings of different sources are of the same property: the same property master id, the same address
+// key, or locations within proximityMeters with the same street and unit number. Listings of the same source do not match,
+// a source has a property once.
+func Match(a *pb.MlsListing, b *pb.MlsListing, proximityMeters float64) []string {
+	if source(a) == source(b) {
+		return nil
+	}
+	var matchedBy []string
+	if id := propertyMasterId(a); id != "" && id == propertyMasterId(b) {
+		matchedBy = append(matchedBy, ByPropertyMasterId)
+	}
+	if key := AddressKey(a); key != "" && key == AddressKey(b) {
+		matchedBy = append(matchedBy, ByAddress)
+	}
+	pointA, pointB := Point(a), Point(b)
+	if pointA != nil && pointB != nil && comps.DistanceMeters(pointA, pointB) <= proximityMeters {
+		if number := StreetNumber(a); number != "" && number == StreetNumber(b) && Unit(a) == Unit(b) {
+			matchedBy = append(matchedBy, ByProximity)
+		}
+	}
+	return matchedBy
+}
+
+// Canonical returns the index of the listing that stands for a cluster: the one of the source first in the source priority,
+// then the most recently changed one. Sources without a priority come after the others.
+func Canonical(cluster []Listing, sourcePriority []string) int {
+	priority := func(l Listing) int {
+		for i, s := range sourcePriority {
+			if s == source(l.MlsListing) {
+				return i
+			}
+		}
+		return len(sourcePriority)
+	}
+	ordered := make([]int, len(cluster))
+	for i := range ordered {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := cluster[ordered[i]], cluster[ordered[j]]
+		if pa, pb := priority(a), priority(b); pa != pb {
+			return pa < pb
+		}
+		ca := a.MlsListing.GetProperty().GetListing().GetDates().GetLastChangeDate().AsTime()
+		cb := b.MlsListing.GetProperty().GetListing().GetDates().GetLastChangeDate().AsTime()
+		if !ca.Equal(cb) {
+			return ca.After(cb)
+		}
+		return a.Id < b.Id
+	})
+	return ordered[0]
+}
+
+// Markers returns the duplicate markers of the listings of a cluster by listing id, a listing alone has no marker.
+func Markers(cluster []Listing, sourcePriority []string) map[string]*pb.DuplicateCluster {
+	markers := map[string]*pb.DuplicateCluster{}
+	if len(cluster) < 2 {
+		return markers
+	}
+	clusterId := cluster[0].Id
+	for _, l := range cluster {
+		if l.Id < clusterId {
+			clusterId = l.Id
+		}
+	}
+	canonical := cluster[Canonical(cluster, sourcePriority)].Id
+	for _, l := range cluster {
+		markers[l.Id] = &pb.DuplicateCluster{ClusterId: clusterId, Canonical: l.Id == canonical, CanonicalMlsId: canonical, Size: int32(len(cluster))}
+	}
+	return markers
+}
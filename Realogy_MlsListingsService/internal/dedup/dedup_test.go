@@ -0,0 +1,93 @@
+package dedup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func listing(source string, address string, unit string, postalCode string, lat float64) *pb.MlsListing {
+	listing := &pb.MlsListing{Property: &pb.Property{
+		Listing: &pb.Listing{SourceSystemKey: source, Dates: &pb.Dates{LastChangeDate: timestamppb.New(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))}},
+		Location: &pb.Location{
+			Address: &pb.Address{UnparsedAddress: address, UnitNumber: unit, PostalCode: postalCode},
+		},
+	}}
+	if lat != 0 {
+		listing.Property.Location.Gis = &pb.Gis{Latitude: lat, Longitude: -117.8}
+	}
+	return listing
+}
+
+func TestAddress(t *testing.T) {
+	for address, normalized := range map[string]string{
+		"123 North Main Street":      "123 N MAIN ST",
+		"123 n. main st.":            "123 N MAIN ST",
+		"45 Ocean Avenue, Apt. 4B":   "45 OCEAN AVE #4B",
+		"45 Ocean Ave Unit 4B":       "45 OCEAN AVE #4B",
+		"45 OCEAN AVE # 4B":          "45 OCEAN AVE #4B",
+		"45 Ocean Ave #":             "45 OCEAN AVE",
+		"  ":                         "",
+		"9 Sunset Boulevard Suite 2": "9 SUNSET BLVD #2",
+	} {
+		assert.Equal(t, normalized, Address(listing("SOLO", address, "", "", 0)), address)
+	}
+	// the unit number is added to an address without it.
+	assert.Equal(t, "45 OCEAN AVE #4B", Address(listing("SOLO", "45 Ocean Ave", "4b", "", 0)))
+	assert.Equal(t, "45 OCEAN AVE #4B", Address(listing("SOLO", "45 Ocean Ave Apt 4B", "4B", "", 0)))
+}
+
+func TestAddressKey(t *testing.T) {
+	assert.Equal(t, "123 N MAIN ST|92618", AddressKey(listing("SOLO", "123 North Main Street", "", "92618-1234", 0)))
+	assert.Empty(t, AddressKey(listing("SOLO", "123 North Main Street", "", "", 0)))
+	assert.Empty(t, AddressKey(listing("SOLO", "", "", "92618", 0)))
+}
+
+func TestMatch(t *testing.T) {
+	a := listing("SOLO", "123 North Main Street", "", "92618", 33.6)
+	// the same address, 11 meters apart.
+	b := listing("CABCREIS", "123 N Main St", "", "92618-1234", 33.6001)
+	assert.Equal(t, []string{ByAddress, ByProximity}, Match(a, b, 25))
+	assert.Equal(t, []string{ByAddress}, Match(a, b, 5))
+
+	// listings of the same source are not duplicates.
+	assert.Empty(t, Match(a, listing("SOLO", "123 N Main St", "", "92618", 33.6), 25))
+
+	// the same property master id.
+	c := listing("ELL", "PO Box 7", "", "", 0)
+	a.MasterId, c.MasterId = &pb.MasterId{PropertyMasterId: "P1"}, &pb.MasterId{PropertyMasterId: "P1"}
+	assert.Equal(t, []string{ByPropertyMasterId}, Match(a, c, 25))
+
+	// the house next door, and another unit of the building, are not the same property.
+	assert.Empty(t, Match(a, listing("ELL", "125 Main Street", "", "92618", 33.6), 25))
+	assert.Empty(t, Match(listing("SOLO", "45 Ocean Ave #1", "", "92618", 33.6), listing("ELL", "45 Ocean Ave #2", "", "92618", 33.6), 25))
+	// proximity with a differently written street.
+	assert.Equal(t, []string{ByProximity}, Match(a, listing("ELL", "123 Main", "", "92618", 33.6), 25))
+}
+
+func TestMarkers(t *testing.T) {
+	cluster := []Listing{
+		{Id: "SOLO_1", MlsListing: listing("SOLO", "123 Main St", "", "92618", 0)},
+		{Id: "CABCREIS_2", MlsListing: listing("CABCREIS", "123 Main St", "", "92618", 0)},
+		{Id: "ELL_3", MlsListing: listing("ELL", "123 Main St", "", "92618", 0)},
+	}
+	markers := Markers(cluster, []string{"ELL", "SOLO"})
+	assert.Len(t, markers, 3)
+	assert.Equal(t, "CABCREIS_2", markers["ELL_3"].ClusterId)
+	assert.Equal(t, "ELL_3", markers["SOLO_1"].CanonicalMlsId)
+	assert.True(t, markers["ELL_3"].Canonical)
+	assert.False(t, markers["SOLO_1"].Canonical)
+	assert.Equal(t, int32(3), markers["CABCREIS_2"].Size)
+
+	// without a priority, the most recently changed listing is the canonical one.
+	cluster[1].MlsListing.Property.Listing.Dates.LastChangeDate = timestamppb.New(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 1, Canonical(cluster, nil))
+	assert.Equal(t, 0, Canonical(cluster, []string{"SOLO"}))
+
+	// a listing alone has no marker.
+	assert.Empty(t, Markers(cluster[:1], nil))
+}
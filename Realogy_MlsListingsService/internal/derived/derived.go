@@ -0,0 +1,116 @@
+// Package derived has the fields of listing documents derived from the listings themselves: the GeoJSON location of their
+// gis coordinates, and the duplicate marker of the listings of the same property from several sources. Writes of derived
+// fields alone are not changes of the listings, change streams drop them.
+package derived
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// listing document fields of the derived fields.
+const (
+	// GeoLocationField is the GeoJSON point of the gis latitude/longitude of a listing, backed by a 2dsphere index.
+	GeoLocationField = "geo_location"
+	// GisPath is the listing field the geo location is derived from.
+	GisPath = "property.location.gis"
+
+	// DuplicateField is the duplicate marker of a listing of a cluster of duplicates, set by the dedup builder.
+	DuplicateField = "duplicate"
+	ClusterIdPath  = "duplicate.cluster_id"
+	CanonicalPath  = "duplicate.canonical"
+	// CanonicalMlsIdPath is the mls id of the canonical listing of the cluster.
+	CanonicalMlsIdPath = "duplicate.canonical_mls_id"
+)
+
+// fields are the derived fields of listing documents.
+var fields = []string{GeoLocationField, DuplicateField}
+
+// GeoLocation returns the GeoJSON point of gis coordinates, false without coordinates. 0 is not a coordinate, feeds send it
+// for a missing one.
+func GeoLocation(gis *pb.Gis) (primitive.D, bool) {
+	if gis.GetLatitude() == 0 || gis.GetLongitude() == 0 {
+		return nil, false
+	}
+	return bson.D{{Key: "type", Value: "Point"}, {Key: "coordinates", Value: bson.A{gis.GetLongitude(), gis.GetLatitude()}}}, true
+}
+
+// WithGeoLocation returns the update of a listing document that also sets its geo location from the gis coordinates the update
+// writes, or unsets it without coordinates.
+func WithGeoLocation(update primitive.D, gis *pb.Gis) primitive.D {
+	if location, ok := GeoLocation(gis); ok {
+		return withOperator(update, "$set", bson.E{Key: GeoLocationField, Value: location})
+	}
+	return withOperator(update, "$unset", bson.E{Key: GeoLocationField, Value: ""})
+}
+
+// withOperator returns the update with a field added to one of its operators.
+func withOperator(update primitive.D, operator string, field bson.E) primitive.D {
+	updated := make(primitive.D, 0, len(update)+1)
+	added := false
+	for _, e := range update {
+		if fields, ok := e.Value.(primitive.D); ok && e.Key == operator && !added {
+			e = bson.E{Key: operator, Value: append(append(primitive.D{}, fields...), field)}
+			added = true
+		}
+		updated = append(updated, e)
+	}
+	if !added {
+		updated = append(updated, bson.E{Key: operator, Value: primitive.D{field}})
+	}
+	return updated
+}
+
+// geoLocationExpression is the aggregation expression of the geo location of a listing document, $$REMOVE without coordinates.
+func geoLocationExpression() primitive.D {
+	latitude := bson.D{{Key: "$ifNull", Value: bson.A{"$" + GisPath + ".latitude", 0}}}
+	longitude := bson.D{{Key: "$ifNull", Value: bson.A{"$" + GisPath + ".longitude", 0}}}
+	return bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$and", Value: bson.A{bson.D{{Key: "$ne", Value: bson.A{latitude, 0}}}, bson.D{{Key: "$ne", Value: bson.A{longitude, 0}}}}}},
+		bson.D{{Key: "type", Value: "Point"}, {Key: "coordinates", Value: bson.A{longitude, latitude}}},
+		"$$REMOVE",
+	}}}
+}
+
+// StaleGeoLocation is the condition of the listing documents whose geo location is not the one of their gis coordinates.
+func StaleGeoLocation() bson.E {
+	return bson.E{Key: "$expr", Value: bson.D{{Key: "$ne", Value: bson.A{"$" + GeoLocationField, geoLocationExpression()}}}}
+}
+
+// SetGeoLocation is the pipeline update setting the geo location of listing documents from their gis coordinates.
+func SetGeoLocation() bson.A {
+	return bson.A{bson.D{{Key: "$set", Value: bson.D{{Key: GeoLocationField, Value: geoLocationExpression()}}}}}
+}
+
+// Collapse is the condition of the listings that are canonical or have no duplicates, listings never clustered have no marker.
+func Collapse() bson.E {
+	return bson.E{Key: CanonicalPath, Value: bson.D{{Key: "$ne", Value: false}}}
+}
+
+// ChangeStage is the change stream stage that drops the updates writing derived fields only, whether the update describes
+// them as whole fields or by their dotted paths.
+func ChangeStage() bson.D {
+	notDerived := func(key string) primitive.D {
+		var derived bson.A
+		for _, field := range fields {
+			derived = append(derived,
+				bson.D{{Key: "$eq", Value: bson.A{key, field}}},
+				bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$indexOfCP", Value: bson.A{key, field + "."}}}, 0}}},
+			)
+		}
+		return bson.D{{Key: "$not", Value: bson.A{bson.D{{Key: "$or", Value: derived}}}}}
+	}
+	changes := func(input interface{}, key string) primitive.D {
+		return bson.D{{Key: "$gt", Value: bson.A{bson.D{{Key: "$size", Value: bson.D{{Key: "$filter", Value: bson.D{
+			{Key: "input", Value: input},
+			{Key: "cond", Value: notDerived(key)},
+		}}}}}, 0}}}
+	}
+	return bson.D{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "$ne", Value: bson.A{"$operationType", "update"}}},
+		changes(bson.D{{Key: "$objectToArray", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$updateDescription.updatedFields", bson.D{}}}}}}, "$$this.k"),
+		changes(bson.D{{Key: "$ifNull", Value: bson.A{"$updateDescription.removedFields", bson.A{}}}}, "$$this"),
+	}}}}}}}
+}
@@ -0,0 +1,61 @@
+package derived_test
+
+import (
+	"mlslisting/internal/derived"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestGeoLocation(t *testing.T) {
+	location, ok := derived.GeoLocation(&pb.Gis{Latitude: 40.72, Longitude: -74.01})
+	assert.True(t, ok)
+	assert.Equal(t, primitive.D{{Key: "type", Value: "Point"}, {Key: "coordinates", Value: bson.A{-74.01, 40.72}}}, location)
+
+	// feeds send 0 for a missing coordinate.
+	_, ok = derived.GeoLocation(&pb.Gis{Latitude: 40.72})
+	assert.False(t, ok)
+	_, ok = derived.GeoLocation(nil)
+	assert.False(t, ok)
+}
+
+func TestWithGeoLocation(t *testing.T) {
+	update := primitive.D{
+		{Key: "$set", Value: primitive.D{{Key: "property.location.gis.latitude", Value: 40.72}}},
+		{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	}
+	location, _ := derived.GeoLocation(&pb.Gis{Latitude: 40.72, Longitude: -74.01})
+	assert.Equal(t, primitive.D{
+		{Key: "$set", Value: primitive.D{{Key: "property.location.gis.latitude", Value: 40.72}, {Key: derived.GeoLocationField, Value: location}}},
+		{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	}, derived.WithGeoLocation(update, &pb.Gis{Latitude: 40.72, Longitude: -74.01}))
+
+	// without coordinates the geo location is unset, the update is not modified.
+	assert.Equal(t, append(update, bson.E{Key: "$unset", Value: primitive.D{{Key: derived.GeoLocationField, Value: ""}}}), derived.WithGeoLocation(update, nil))
+	assert.Len(t, update[0].Value, 1)
+}
+
+func TestChangeStage(t *testing.T) {
+	data, err := bson.MarshalExtJSON(derived.ChangeStage(), false, false)
+	assert.Nil(t, err)
+	notDerived := func(key string) string {
+		return `{"$not": [{"$or": [
+			{"$eq": ["` + key + `", "geo_location"]}, {"$eq": [{"$indexOfCP": ["` + key + `", "geo_location."]}, 0]},
+			{"$eq": ["` + key + `", "duplicate"]}, {"$eq": [{"$indexOfCP": ["` + key + `", "duplicate."]}, 0]}
+		]}]}`
+	}
+	assert.JSONEq(t, `{"$match": {"$expr": {"$or": [
+		{"$ne": ["$operationType", "update"]},
+		{"$gt": [{"$size": {"$filter": {"input": {"$objectToArray": {"$ifNull": ["$updateDescription.updatedFields", {}]}}, "cond": `+notDerived("$$this.k")+`}}}, 0]},
+		{"$gt": [{"$size": {"$filter": {"input": {"$ifNull": ["$updateDescription.removedFields", []]}, "cond": `+notDerived("$$this")+`}}}, 0]}
+	]}}}`, string(data))
+}
+
+func TestCollapse(t *testing.T) {
+	// listings never clustered have no marker, they are collapsed with the canonical ones.
+	assert.Equal(t, bson.E{Key: "duplicate.canonical", Value: bson.D{{Key: "$ne", Value: false}}}, derived.Collapse())
+}
@@ -0,0 +1,103 @@
+package derived
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const retryDelay = 10 * time.Second
+
+// listingEvent is a listing change event, projected to the id of the listing.
+type listingEvent struct {
+	DocumentKey struct {
+		Id string `bson:"_id"`
+	} `bson:"documentKey"`
+}
+
+// Syncer keeps the geo location of listings current with their gis coordinates, for the listings written by feeds: the
+// service sets it with its own writes. Changed listings are synced from the change stream of the listings collection, and
+// resynced every interval for the changes the stream missed: all the listings when the syncer starts, then the listings
+// changed since the previous resync.
+// Syncs only write stale geo locations, a single instance should still run the syncer.
+type Syncer struct {
+	listings       *mongo.Collection
+	resyncInterval time.Duration
+	// resynced is the start of the previous resync, zero before the first one.
+	resynced time.Time
+}
+
+func NewSyncer(listings *mongo.Collection, resyncInterval time.Duration) *Syncer {
+	return &Syncer{listings: listings, resyncInterval: resyncInterval}
+}
+
+// Run syncs the geo locations of all the listings and then of the changed ones until ctx is done.
+func (s *Syncer) Run(ctx context.Context) {
+	go s.resyncEvery(ctx)
+	for ctx.Err() == nil {
+		if err := s.watch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Geo location change stream failed, restarting. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (s *Syncer) resyncEvery(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := s.Resync(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Unable to sync the geo location of the listings. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.resyncInterval):
+		}
+	}
+}
+
+// Resync sets the stale geo locations of all the listings the first time, then of the listings changed since the previous
+// resync: the stale geo location condition is not indexed, the last change date bounds the listings it is evaluated on.
+func (s *Syncer) Resync(ctx context.Context) error {
+	started := time.Now()
+	filter := bson.D{StaleGeoLocation()}
+	if !s.resynced.IsZero() {
+		filter = bson.D{{Key: "last_change_date", Value: bson.D{{Key: "$gte", Value: s.resynced}}}, StaleGeoLocation()}
+	}
+	result, err := s.listings.UpdateMany(ctx, filter, SetGeoLocation())
+	if err != nil {
+		return err
+	}
+	s.resynced = started
+	log.Infof("Synced the geo location of %d listings in %v", result.ModifiedCount, time.Since(started))
+	return nil
+}
+
+func (s *Syncer) watch(ctx context.Context) error {
+	pipeline := mongo.Pipeline{
+		ChangeStage(),
+		bson.D{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}}}}},
+		bson.D{{Key: "$project", Value: bson.D{{Key: "documentKey", Value: 1}}}},
+	}
+	cs, err := s.listings.Watch(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var event listingEvent
+		if err := cs.Decode(&event); err != nil {
+			log.Errorf("Unable to decode listing event for the geo location. %v", err)
+			continue
+		}
+		if _, err := s.listings.UpdateOne(ctx, bson.D{{Key: "_id", Value: event.DocumentKey.Id}, StaleGeoLocation()}, SetGeoLocation()); err != nil {
+			return err
+		}
+	}
+	return cs.Err()
+}
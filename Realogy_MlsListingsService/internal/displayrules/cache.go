@@ -0,0 +1,120 @@
+package displayrules
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
+)
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = 30 * time.Second
+)
+
+// Cache keeps the display rules of each MLS by source system key.
+// Entries expire after the ttl and are invalidated by the events of the display rules service, see Watch.
+type Cache struct {
+	client drpb.MlsDisplayRulesServiceClient
+	ttl    time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]entry
+
+	now        func() time.Time
+	retryDelay time.Duration
+}
+
+type entry struct {
+	// nil when the MLS has no active display rules.
+	rules   *drpb.MlsDisplayRules
+	expires time.Time
+}
+
+// NewCache returns a cache of the display rules looked up through client.
+func NewCache(client drpb.MlsDisplayRulesServiceClient, ttl time.Duration) *Cache {
+	return &Cache{
+		client:     client,
+		ttl:        ttl,
+		entries:    map[string]entry{},
+		now:        time.Now,
+		retryDelay: minRetryDelay,
+	}
+}
+
+// Rules returns the display rules of an MLS, nil when it has no active rules.
+// An error is returned when the rules can not be looked up, in which case listings must not be returned unredacted.
+func (c *Cache) Rules(ctx context.Context, source string) (*drpb.MlsDisplayRules, error) {
+	c.mu.RLock()
+	e, ok := c.entries[source]
+	c.mu.RUnlock()
+	if ok && c.now().Before(e.expires) {
+		return e.rules, nil
+	}
+
+	res, err := c.client.GetMlsDisplayRulesBySource(ctx, &drpb.GetMlsDisplayRulesBySourceRequest{SourceSystemKey: source})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	rules := res.GetMlsDisplayRules()
+
+	c.mu.Lock()
+	c.entries[source] = entry{rules: rules, expires: c.now().Add(c.ttl)}
+	c.mu.Unlock()
+	return rules, nil
+}
+
+// Invalidate drops the cached rules of an MLS, or of all of them when source is empty.
+func (c *Cache) Invalidate(source string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if source == "" {
+		c.entries = map[string]entry{}
+		return
+	}
+	delete(c.entries, source)
+}
+
+// Watch invalidates cached rules on the change events of the display rules service until ctx is done.
+// The event stream is reopened from the last received event when it fails. Everything is invalidated on (re)connect
+// since changes may have been missed meanwhile.
+func (c *Cache) Watch(ctx context.Context) {
+	resumeEventId := ""
+	delay := c.retryDelay
+	for ctx.Err() == nil {
+		stream, err := c.client.StreamMlsDisplayRulesEvent(ctx, &drpb.StreamMlsDisplayRulesEventRequest{ResumeEventId: resumeEventId})
+		if err == nil {
+			c.Invalidate("")
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Warnf("Display rules event stream failed, reconnecting. %v", err)
+					}
+					break
+				}
+				delay = c.retryDelay
+				if id := event.GetEventMetaData().GetData(); id != "" {
+					resumeEventId = id
+				}
+				// a delete event has no document, the MLS it was for is unknown.
+				c.Invalidate(event.GetMlsDisplayRules().GetSource())
+			}
+		} else {
+			log.Warnf("Unable to open display rules event stream. %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package displayrules
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
+)
+
+type fakeClient struct {
+	mu     sync.Mutex
+	rules  map[string]*drpb.MlsDisplayRules
+	err    error
+	gets   int
+	events chan *drpb.StreamMlsDisplayRulesEventResponse
+	resume []string
+}
+
+func (f *fakeClient) GetMlsDisplayRulesBySource(ctx context.Context, in *drpb.GetMlsDisplayRulesBySourceRequest, opts ...grpc.CallOption) (*drpb.GetMlsDisplayRulesBySourceResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gets++
+	if f.err != nil {
+		return nil, f.err
+	}
+	rules, ok := f.rules[in.SourceSystemKey]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no rules")
+	}
+	return &drpb.GetMlsDisplayRulesBySourceResponse{MlsDisplayRules: rules}, nil
+}
+
+func (f *fakeClient) StreamMlsDisplayRulesEvent(ctx context.Context, in *drpb.StreamMlsDisplayRulesEventRequest, opts ...grpc.CallOption) (drpb.MlsDisplayRulesService_StreamMlsDisplayRulesEventClient, error) {
+	f.mu.Lock()
+	f.resume = append(f.resume, in.ResumeEventId)
+	f.mu.Unlock()
+	return &fakeStream{ctx: ctx, events: f.events}, nil
+}
+
+func (f *fakeClient) getCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.gets
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events chan *drpb.StreamMlsDisplayRulesEventResponse
+}
+
+func (s *fakeStream) Recv() (*drpb.StreamMlsDisplayRulesEventResponse, error) {
+	select {
+	case event, ok := <-s.events:
+		if !ok {
+			return nil, io.EOF
+		}
+		return event, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestRulesCached(t *testing.T) {
+	client := &fakeClient{rules: map[string]*drpb.MlsDisplayRules{"BRIGHTMLS": {Source: "BRIGHTMLS", HideYearBuilt: true}}}
+	cache := NewCache(client, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		rules, err := cache.Rules(context.Background(), "BRIGHTMLS")
+		assert.Nil(t, err)
+		assert.True(t, rules.HideYearBuilt)
+	}
+	assert.Equal(t, 1, client.getCount())
+
+	cache.Invalidate("BRIGHTMLS")
+	_, _ = cache.Rules(context.Background(), "BRIGHTMLS")
+	assert.Equal(t, 2, client.getCount())
+}
+
+func TestRulesNotFoundCached(t *testing.T) {
+	client := &fakeClient{}
+	cache := NewCache(client, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		rules, err := cache.Rules(context.Background(), "AR_TMLS")
+		assert.Nil(t, err)
+		assert.Nil(t, rules)
+	}
+	assert.Equal(t, 1, client.getCount())
+}
+
+func TestRulesExpire(t *testing.T) {
+	client := &fakeClient{}
+	cache := NewCache(client, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	_, _ = cache.Rules(context.Background(), "AR_TMLS")
+	now = now.Add(2 * time.Minute)
+	_, _ = cache.Rules(context.Background(), "AR_TMLS")
+	assert.Equal(t, 2, client.getCount())
+}
+
+func TestRulesError(t *testing.T) {
+	client := &fakeClient{err: errors.New("unavailable")}
+	cache := NewCache(client, time.Minute)
+
+	_, err := cache.Rules(context.Background(), "BRIGHTMLS")
+	assert.NotNil(t, err)
+
+	client.err = nil
+	_, err = cache.Rules(context.Background(), "BRIGHTMLS")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, client.getCount())
+}
+
+func TestWatchInvalidates(t *testing.T) {
+	client := &fakeClient{events: make(chan *drpb.StreamMlsDisplayRulesEventResponse)}
+	cache := NewCache(client, time.Minute)
+	cache.retryDelay = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cache.Watch(ctx)
+
+	client.events <- &drpb.StreamMlsDisplayRulesEventResponse{EventMetaData: &drpb.EventMetaData{Data: "1"}}
+	_, _ = cache.Rules(ctx, "BRIGHTMLS")
+	_, _ = cache.Rules(ctx, "AR_TMLS")
+
+	client.events <- &drpb.StreamMlsDisplayRulesEventResponse{
+		EventMetaData:   &drpb.EventMetaData{Data: "2"},
+		EventType:       "update",
+		MlsDisplayRules: &drpb.MlsDisplayRules{Source: "BRIGHTMLS"},
+	}
+	// the next event is only received once the previous one is handled.
+	client.events <- &drpb.StreamMlsDisplayRulesEventResponse{EventMetaData: &drpb.EventMetaData{Data: "3"}, EventType: "insert", MlsDisplayRules: &drpb.MlsDisplayRules{Source: "OTHER"}}
+	_, _ = cache.Rules(ctx, "BRIGHTMLS")
+	_, _ = cache.Rules(ctx, "AR_TMLS")
+	assert.Equal(t, 3, client.getCount())
+
+	close(client.events)
+	assert.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return len(client.resume) > 1
+	}, time.Second, time.Millisecond)
+	client.mu.Lock()
+	assert.Equal(t, []string{"", "3"}, client.resume[:2])
+	client.mu.Unlock()
+}
@@ -0,0 +1,71 @@
+package displayrules
+
+import (
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// SourceSystemKeyPath is the listing document path the display rules of a listing are looked up by.
+const SourceSystemKeyPath = "property.listing.source_system_key"
+
+// Redact blanks the fields of a listing governed by the display rules of its MLS.
+// Nil rules mean the MLS has no active display rules and the listing is returned as is.
+func Redact(listing *pb.MlsListing, rules *drpb.MlsDisplayRules) {
+	if listing == nil || rules == nil {
+		return
+	}
+	property := listing.GetProperty()
+	l := property.GetListing()
+
+	if rules.HidePriceHistory {
+		if price := l.GetPrice(); price != nil {
+			price.OriginalListPrice = 0
+			price.PriceChangeTimestamp = nil
+			price.IsPriceReduced = false
+		}
+	}
+	if rules.HideYearBuilt {
+		if structure := property.GetStructure(); structure != nil {
+			structure.YearBuilt = 0
+			structure.YearBuiltSource = ""
+		}
+		if dates := l.GetDates(); dates != nil {
+			dates.Age = 0
+		}
+	}
+	if rules.HideSchoolDistrict {
+		if location := property.GetLocation(); location != nil {
+			location.School = nil
+		}
+	}
+	if rules.HideListingDate {
+		if dates := l.GetDates(); dates != nil {
+			dates.ListingContractDate = nil
+			dates.OnMarketDate = nil
+		}
+	}
+	if !rules.ShowMlsNumber && l != nil {
+		l.MlsListingId = ""
+	}
+
+	agentOffice := l.GetAgentOffice()
+	if !rules.ShowListingAgent && agentOffice != nil {
+		agentOffice.ListAgent = nil
+		agentOffice.CoListAgent = nil
+	}
+	if rules.AgentPhoneAttributionRule == drpb.DisplayRule_MustNotDisplay {
+		if agent := agentOffice.GetListAgent(); agent != nil {
+			agent.ListAgentPhone = ""
+			agent.ListAgentOfficePhone = ""
+			agent.ListAgentOfficePhoneType = ""
+		}
+		if agent := agentOffice.GetCoListAgent(); agent != nil {
+			agent.CoListAgentOfficePhone = ""
+		}
+	}
+	if rules.BuyerAgentCommDisplayRule == drpb.DisplayRule_MustNotDisplay {
+		if compensation := l.GetCompensation(); compensation != nil {
+			compensation.BuyerAgencyCompensation = nil
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package displayrules_test
+
+import (
+	"mlslisting/internal/displayrules"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func listing() *pb.MlsListing {
+	return &pb.MlsListing{
+		Property: &pb.Property{
+			Listing: &pb.Listing{
+				SourceSystemKey: "BRIGHTMLS",
+				MlsListingId:    "MDBC2000001",
+				Price: &pb.Price{
+					ListPrice:            500000,
+					OriginalListPrice:    550000,
+					IsPriceReduced:       true,
+					PriceChangeTimestamp: timestamppb.Now(),
+				},
+				Dates: &pb.Dates{
+					ListingContractDate: timestamppb.Now(),
+					OnMarketDate:        timestamppb.Now(),
+					Age:                 12,
+				},
+				AgentOffice: &pb.AgentOffice{
+					ListAgent:   &pb.ListAgent{ListAgentFullname: "Jane Doe", ListAgentPhone: "555-0100", ListAgentOfficePhone: "555-0101"},
+					CoListAgent: &pb.CoListAgent{CoListAgentOfficePhone: "555-0102"},
+					ListOffice:  &pb.ListOffice{},
+				},
+				Compensation: &pb.Compensation{BuyerAgencyCompensation: &pb.BuyerAgencyCompensation{}},
+			},
+			Structure: &pb.Structure{YearBuilt: 2010, YearBuiltSource: "Assessor"},
+			Location:  &pb.Location{School: &pb.School{}},
+		},
+	}
+}
+
+func TestRedactNilRules(t *testing.T) {
+	l := listing()
+	displayrules.Redact(l, nil)
+	assert.Equal(t, listing().Property.Listing.MlsListingId, l.Property.Listing.MlsListingId)
+	assert.NotNil(t, l.Property.Listing.AgentOffice.ListAgent)
+}
+
+func TestRedactNilListing(t *testing.T) {
+	displayrules.Redact(nil, &drpb.MlsDisplayRules{})
+	displayrules.Redact(&pb.MlsListing{}, &drpb.MlsDisplayRules{HidePriceHistory: true, HideYearBuilt: true, HideListingDate: true})
+}
+
+func TestRedactShowRules(t *testing.T) {
+	l := listing()
+	displayrules.Redact(l, &drpb.MlsDisplayRules{ShowListingAgent: true, ShowMlsNumber: true, BuyerAgentCommDisplayRule: drpb.DisplayRule_MustDisplay})
+
+	assert.Equal(t, "MDBC2000001", l.Property.Listing.MlsListingId)
+	assert.Equal(t, "555-0100", l.Property.Listing.AgentOffice.ListAgent.ListAgentPhone)
+	assert.NotNil(t, l.Property.Listing.Compensation.BuyerAgencyCompensation)
+	assert.Equal(t, float64(550000), l.Property.Listing.Price.OriginalListPrice)
+	assert.Equal(t, int32(2010), l.Property.Structure.YearBuilt)
+	assert.NotNil(t, l.Property.Location.School)
+}
+
+func TestRedactHideRules(t *testing.T) {
+	l := listing()
+	displayrules.Redact(l, &drpb.MlsDisplayRules{
+		HidePriceHistory:          true,
+		HideYearBuilt:             true,
+		HideSchoolDistrict:        true,
+		HideListingDate:           true,
+		BuyerAgentCommDisplayRule: drpb.DisplayRule_MustNotDisplay,
+	})
+
+	price := l.Property.Listing.Price
+	assert.Equal(t, float64(500000), price.ListPrice)
+	assert.Zero(t, price.OriginalListPrice)
+	assert.False(t, price.IsPriceReduced)
+	assert.Nil(t, price.PriceChangeTimestamp)
+	assert.Zero(t, l.Property.Structure.YearBuilt)
+	assert.Empty(t, l.Property.Structure.YearBuiltSource)
+	assert.Zero(t, l.Property.Listing.Dates.Age)
+	assert.Nil(t, l.Property.Location.School)
+	assert.Nil(t, l.Property.Listing.Dates.ListingContractDate)
+	assert.Nil(t, l.Property.Listing.Dates.OnMarketDate)
+	assert.Empty(t, l.Property.Listing.MlsListingId)
+	assert.Nil(t, l.Property.Listing.AgentOffice.ListAgent)
+	assert.Nil(t, l.Property.Listing.AgentOffice.CoListAgent)
+	assert.NotNil(t, l.Property.Listing.AgentOffice.ListOffice)
+	assert.Nil(t, l.Property.Listing.Compensation.BuyerAgencyCompensation)
+}
+
+func TestRedactAgentPhone(t *testing.T) {
+	l := listing()
+	displayrules.Redact(l, &drpb.MlsDisplayRules{ShowListingAgent: true, AgentPhoneAttributionRule: drpb.DisplayRule_MustNotDisplay})
+
+	agent := l.Property.Listing.AgentOffice.ListAgent
+	assert.Equal(t, "Jane Doe", agent.ListAgentFullname)
+	assert.Empty(t, agent.ListAgentPhone)
+	assert.Empty(t, agent.ListAgentOfficePhone)
+	assert.Empty(t, l.Property.Listing.AgentOffice.CoListAgent.CoListAgentOfficePhone)
+}
@@ -0,0 +1,223 @@
+package eventhub
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Event is a listing change event of a shared change stream. The response is shared by all subscribers, it must not be modified.
+type Event struct {
+	Response *pb.StreamMlsListingEventResponse
+	// cluster time of the change.
+	Time time.Time
+	// last change date of the listing, zero without a listing.
+	ChangeDate time.Time
+}
+
+type changeEvent struct {
+	EventData struct {
+		EventId string `bson:"_data"`
+	} `bson:"_id"`
+	EventType   string              `bson:"operationType"`
+	EventTime   primitive.Timestamp `bson:"clusterTime"`
+	DocumentKey struct {
+		Id string `bson:"_id"`
+	} `bson:"documentKey"`
+	Listing *pb.MlsListing `bson:"fullDocument"`
+}
+
+// changeDate is the last change date of the listing of a change event, it is not a field of pb listings.
+type changeDate struct {
+	Listing struct {
+		LastChangeDate time.Time `bson:"last_change_date"`
+	} `bson:"fullDocument"`
+}
+
+// decode returns the current event of a change stream as StreamMlsListingEvent sends it.
+func decode(cs *mongo.ChangeStream) (*Event, error) {
+	var event changeEvent
+	if err := cs.Decode(&event); err != nil {
+		return nil, err
+	}
+	var date changeDate
+	if err := cs.Decode(&date); err != nil {
+		return nil, err
+	}
+	return &Event{
+		Response: &pb.StreamMlsListingEventResponse{
+			MlsId:      event.DocumentKey.Id,
+			MlsListing: event.Listing,
+			MlsChange: &pb.MlsChange{
+				Marker:     event.EventData.EventId,
+				ChangeType: event.EventType,
+				ChangeTime: timestamppb.New(time.Unix(int64(event.EventTime.T), int64(event.EventTime.I))),
+			},
+		},
+		Time:       time.Unix(int64(event.EventTime.T), 0),
+		ChangeDate: date.Listing.LastChangeDate,
+	}, nil
+}
+
+// class is a shared change stream and its subscribers.
+type class struct {
+	key        string
+	replaySize int
+	cancel     context.CancelFunc
+
+	mu          sync.Mutex
+	subscribers map[*Subscriber]struct{}
+	// last events published, oldest first.
+	replay    []*Event
+	latest    time.Time
+	idleSince time.Time
+	closed    bool
+}
+
+func newClass(key string, replaySize int, cancel context.CancelFunc) *class {
+	return &class{key: key, replaySize: replaySize, cancel: cancel, subscribers: map[*Subscriber]struct{}{}, idleSince: time.Now()}
+}
+
+// publish buffers an event for each subscriber, disconnecting the subscribers whose buffer is full.
+func (c *class) publish(event *Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.latest = event.Time
+	if c.replaySize > 0 {
+		if len(c.replay) == c.replaySize {
+			c.replay = c.replay[1:]
+		}
+		c.replay = append(c.replay, event)
+	}
+	for s := range c.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			c.drop(s, ErrSlowConsumer)
+		}
+	}
+}
+
+// subscribe adds a subscriber, its buffer starts with the replayed events after the marker.
+func (c *class) subscribe(id string, subscription string, marker string, bufferSize int) (*Subscriber, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, ErrInterrupted
+	}
+
+	var backlog []*Event
+	lastTime := c.latest
+	if marker != "" {
+		i := c.find(marker)
+		if i < 0 {
+			return nil, ErrMarkerNotFound
+		}
+		backlog, lastTime = c.replay[i+1:], c.replay[i].Time
+	}
+
+	s := &Subscriber{lastTime: lastTime.UnixNano(), Id: id, Subscription: subscription, class: c, events: make(chan *Event, bufferSize+len(backlog))}
+	for _, event := range backlog {
+		s.events <- event
+	}
+	c.subscribers[s] = struct{}{}
+	return s, nil
+}
+
+// find returns the index of the replayed event of a marker, -1 when it is not buffered.
+func (c *class) find(marker string) int {
+	for i := len(c.replay) - 1; i >= 0; i-- {
+		if c.replay[i].Response.MlsChange.Marker == marker {
+			return i
+		}
+	}
+	return -1
+}
+
+// drop disconnects a subscriber with an error, c.mu is held.
+func (c *class) drop(s *Subscriber, err error) {
+	delete(c.subscribers, s)
+	s.err = err
+	close(s.events)
+	if len(c.subscribers) == 0 {
+		c.idleSince = time.Now()
+	}
+}
+
+func (c *class) unsubscribe(s *Subscriber) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.subscribers[s]; ok {
+		c.drop(s, ErrInterrupted)
+	}
+}
+
+func (c *class) idle(now time.Time, idleTimeout time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subscribers) == 0 && now.Sub(c.idleSince) >= idleTimeout
+}
+
+// close stops the change stream and disconnects the subscribers.
+func (c *class) close(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for s := range c.subscribers {
+		c.drop(s, err)
+	}
+	c.cancel()
+}
+
+func (c *class) collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for s := range c.subscribers {
+		lag := c.latest.Sub(time.Unix(0, atomic.LoadInt64(&s.lastTime))).Seconds()
+		if lag < 0 {
+			lag = 0
+		}
+		ch <- prometheus.MustNewConstMetric(lagDesc, prometheus.GaugeValue, lag, s.Id, s.Subscription)
+		ch <- prometheus.MustNewConstMetric(bufferedDesc, prometheus.GaugeValue, float64(len(s.events)), s.Id, s.Subscription)
+	}
+}
+
+// Subscriber receives the events of a shared change stream through a bounded buffer.
+type Subscriber struct {
+	// change time of the last event taken, unix nanoseconds. First field for 64-bit alignment of atomic access.
+	lastTime int64
+
+	Id           string
+	Subscription string
+
+	class  *class
+	events chan *Event
+	err    error
+}
+
+// Next returns the next event. The error is ctx.Err() when ctx is done, ErrSlowConsumer or ErrInterrupted when the subscriber was disconnected.
+func (s *Subscriber) Next(ctx context.Context) (*Event, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case event, ok := <-s.events:
+		if !ok {
+			return nil, s.err
+		}
+		atomic.StoreInt64(&s.lastTime, event.Time.UnixNano())
+		return event, nil
+	}
+}
+
+// Close unsubscribes, the shared change stream is kept open for the idle timeout once it has no subscribers.
+func (s *Subscriber) Close() {
+	s.class.unsubscribe(s)
+}
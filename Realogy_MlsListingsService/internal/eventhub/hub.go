@@ -0,0 +1,199 @@
+package eventhub
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrMarkerNotFound is returned when a subscriber resumes after a marker the hub no longer buffers, it needs a change stream of its own.
+	ErrMarkerNotFound = errors.New("marker is not buffered by the shared change stream")
+
+	// ErrSlowConsumer is returned to a subscriber disconnected because its buffer was full.
+	ErrSlowConsumer = errors.New("subscriber did not keep up with the listing events")
+
+	// ErrInterrupted is returned to subscribers of a shared change stream that failed or was closed.
+	ErrInterrupted = errors.New("shared listing change stream was interrupted")
+)
+
+var (
+	lagDesc = prometheus.NewDesc("mls_listing_stream_subscriber_lag_seconds",
+		"Change time of the latest listing event of a shared change stream minus that of the last event taken by the subscriber.",
+		[]string{"subscriber", "subscription"}, nil)
+	bufferedDesc = prometheus.NewDesc("mls_listing_stream_subscriber_buffered_events",
+		"Listing events buffered for the subscriber, it is disconnected when its buffer is full.",
+		[]string{"subscriber", "subscription"}, nil)
+	changeStreamsDesc = prometheus.NewDesc("mls_listing_stream_shared_change_streams",
+		"Shared listing change streams open.", nil, nil)
+)
+
+// Hub tails the listings change stream once per pipeline and fans the events out to the subscribers of that pipeline.
+// A shared change stream is closed once it has had no subscribers for the idle timeout.
+type Hub struct {
+	collection  *mongo.Collection
+	bufferSize  int
+	replaySize  int
+	idleTimeout time.Duration
+
+	mu           sync.Mutex
+	classes      map[string]*class
+	subscriberId uint64
+}
+
+// NewHub returns a hub buffering up to bufferSize events per subscriber and the last replaySize events of each shared change stream,
+// which subscribers can resume from.
+func NewHub(collection *mongo.Collection, bufferSize int, replaySize int, idleTimeout time.Duration) *Hub {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &Hub{collection: collection, bufferSize: bufferSize, replaySize: replaySize, idleTimeout: idleTimeout, classes: map[string]*class{}}
+}
+
+// Run closes idle shared change streams until ctx is done, then closes all of them.
+func (h *Hub) Run(ctx context.Context) {
+	interval := h.idleTimeout / 2
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			h.closeAll()
+			return
+		case now := <-ticker.C:
+			h.closeIdle(now)
+		}
+	}
+}
+
+// Subscribe subscribes to the events of the pipeline, after the marker when one is given. The shared change stream of the
+// pipeline is opened when there is none, from now. ErrMarkerNotFound is returned when the marker is not buffered.
+func (h *Hub) Subscribe(ctx context.Context, subscription string, pipeline mongo.Pipeline, marker string) (*Subscriber, error) {
+	key, err := classKey(pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c := h.classes[key]
+	if c == nil {
+		if marker != "" {
+			return nil, ErrMarkerNotFound
+		}
+		if c, err = h.open(ctx, key, pipeline); err != nil {
+			return nil, err
+		}
+		h.classes[key] = c
+	}
+	h.subscriberId++
+	return c.subscribe(strconv.FormatUint(h.subscriberId, 10), subscription, marker, h.bufferSize)
+}
+
+// open starts the shared change stream of a pipeline.
+func (h *Hub) open(ctx context.Context, key string, pipeline mongo.Pipeline) (*class, error) {
+	cs, err := h.collection.Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if err != nil {
+		return nil, err
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	c := newClass(key, h.replaySize, cancel)
+	go h.run(runCtx, c, pipeline, cs)
+	return c, nil
+}
+
+// run publishes the events of a shared change stream. A failed change stream is resumed once, its subscribers are
+// disconnected when that fails too.
+func (h *Hub) run(ctx context.Context, c *class, pipeline mongo.Pipeline, cs *mongo.ChangeStream) {
+	for {
+		for cs.Next(ctx) {
+			event, err := decode(cs)
+			if err != nil {
+				log.Errorf("Unable to decode listing event of a shared change stream. %v", err)
+				continue
+			}
+			c.publish(event)
+		}
+		err := cs.Err()
+		resumeToken := cs.ResumeToken()
+		cs.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Warnf("Shared listing change stream failed, resuming. %v", err)
+		changeStreamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+		if resumeToken != nil {
+			changeStreamOptions.SetResumeAfter(resumeToken)
+		}
+		if cs, err = h.collection.Watch(ctx, pipeline, changeStreamOptions); err != nil {
+			log.Errorf("Unable to resume shared listing change stream, disconnecting its subscribers. %v", err)
+			h.remove(c)
+			return
+		}
+	}
+}
+
+// remove closes a shared change stream and disconnects its subscribers.
+func (h *Hub) remove(c *class) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.classes[c.key] == c {
+		delete(h.classes, c.key)
+	}
+	c.close(ErrInterrupted)
+}
+
+func (h *Hub) closeIdle(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, c := range h.classes {
+		if c.idle(now, h.idleTimeout) {
+			delete(h.classes, key)
+			c.close(ErrInterrupted)
+		}
+	}
+}
+
+func (h *Hub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, c := range h.classes {
+		delete(h.classes, key)
+		c.close(ErrInterrupted)
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (h *Hub) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lagDesc
+	ch <- bufferedDesc
+	ch <- changeStreamsDesc
+}
+
+// Collect implements prometheus.Collector, reporting the lag and buffered events of each subscriber.
+func (h *Hub) Collect(ch chan<- prometheus.Metric) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch <- prometheus.MustNewConstMetric(changeStreamsDesc, prometheus.GaugeValue, float64(len(h.classes)))
+	for _, c := range h.classes {
+		c.collect(ch)
+	}
+}
+
+// classKey identifies the shared change stream of a pipeline, requests with the same filters and fields share one.
+func classKey(pipeline mongo.Pipeline) (string, error) {
+	key, err := bson.MarshalExtJSON(bson.D{{Key: "pipeline", Value: pipeline}}, true, false)
+	return string(key), err
+}
@@ -0,0 +1,122 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func event(n int) *Event {
+	return &Event{
+		Response: &pb.StreamMlsListingEventResponse{MlsId: fmt.Sprint(n), MlsChange: &pb.MlsChange{Marker: fmt.Sprintf("marker%d", n)}},
+		Time:     time.Unix(int64(1000+n), 0),
+	}
+}
+
+func testClass() *class {
+	return newClass("key", 3, func() {})
+}
+
+func TestPublish(t *testing.T) {
+	c := testClass()
+	first, _ := c.subscribe("1", "", "", 10)
+	second, _ := c.subscribe("2", "", "", 10)
+	c.publish(event(1))
+
+	for _, s := range []*Subscriber{first, second} {
+		received, err := s.Next(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "1", received.Response.MlsId)
+	}
+}
+
+func TestSubscribeAfterMarker(t *testing.T) {
+	c := testClass()
+	for n := 1; n <= 4; n++ {
+		c.publish(event(n))
+	}
+
+	s, err := c.subscribe("1", "", "marker2", 10)
+	assert.Nil(t, err)
+	c.publish(event(5))
+	for n := 3; n <= 5; n++ {
+		received, err := s.Next(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprint(n), received.Response.MlsId)
+	}
+
+	// only the last 3 events are replayed.
+	_, err = c.subscribe("2", "", "marker1", 10)
+	assert.Equal(t, ErrMarkerNotFound, err)
+}
+
+func TestSlowConsumer(t *testing.T) {
+	c := testClass()
+	slow, _ := c.subscribe("1", "", "", 2)
+	fast, _ := c.subscribe("2", "", "", 2)
+	for n := 1; n <= 3; n++ {
+		c.publish(event(n))
+		if n < 3 {
+			fast.Next(context.Background())
+		}
+	}
+
+	// the buffered events are received before the error.
+	for n := 1; n <= 2; n++ {
+		received, err := slow.Next(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprint(n), received.Response.MlsId)
+	}
+	_, err := slow.Next(context.Background())
+	assert.Equal(t, ErrSlowConsumer, err)
+
+	received, err := fast.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "3", received.Response.MlsId)
+}
+
+func TestCloseSubscriber(t *testing.T) {
+	c := testClass()
+	s, _ := c.subscribe("1", "", "", 2)
+	s.Close()
+	s.Close()
+	assert.True(t, c.idle(time.Now().Add(time.Minute), time.Minute))
+
+	c.close(ErrInterrupted)
+	_, err := c.subscribe("2", "", "", 2)
+	assert.Equal(t, ErrInterrupted, err)
+}
+
+func TestCollectLag(t *testing.T) {
+	hub := NewHub(nil, 10, 10, time.Minute)
+	c := testClass()
+	hub.classes["key"] = c
+	s, _ := c.subscribe("1", "updates", "", 10)
+	for n := 1; n <= 5; n++ {
+		c.publish(event(n))
+	}
+	s.Next(context.Background())
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(hub)
+	families, err := registry.Gather()
+	assert.Nil(t, err)
+
+	values := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			values[family.GetName()] = metric.GetGauge().GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"mls_listing_stream_shared_change_streams":      1,
+		"mls_listing_stream_subscriber_buffered_events": 4,
+		"mls_listing_stream_subscriber_lag_seconds":     4,
+	}, values)
+}
@@ -0,0 +1,36 @@
+package export
+
+import "bytes"
+
+// Chunker buffers the bytes of an export and sends them in chunks of at least size bytes, the last one may be smaller.
+type Chunker struct {
+	size int
+	send func(data []byte) error
+	buf  bytes.Buffer
+}
+
+// NewChunker returns a chunker sending to send, Flush must be called after the last write.
+func NewChunker(size int, send func(data []byte) error) *Chunker {
+	return &Chunker{size: size, send: send}
+}
+
+func (c *Chunker) Write(p []byte) (int, error) {
+	c.buf.Write(p)
+	if c.buf.Len() >= c.size {
+		if err := c.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends the buffered bytes.
+func (c *Chunker) Flush() error {
+	if c.buf.Len() == 0 {
+		return nil
+	}
+	// the chunk is sent before the buffer is reused.
+	data := append([]byte(nil), c.buf.Bytes()...)
+	c.buf.Reset()
+	return c.send(data)
+}
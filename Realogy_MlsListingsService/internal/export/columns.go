@@ -0,0 +1,133 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+var (
+	listingDescriptor   = (&pb.MlsListing{}).ProtoReflect().Descriptor()
+	timestampDescriptor = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor()
+)
+
+// Column is a field of MlsListing flattened into a column of the export.
+type Column struct {
+	// proto field names from MlsListing to the field joined by dots, e.g. "property.listing.price.list_price".
+	Name   string
+	fields []protoreflect.FieldDescriptor
+}
+
+// Columns returns the columns of a field mask on MlsListing. Mask paths are field names in proto or json form,
+// a message selects the fields under it in declaration order. An empty mask selects all fields.
+func Columns(mask *fieldmaskpb.FieldMask) ([]Column, error) {
+	if len(mask.GetPaths()) == 0 {
+		return flatten(nil, listingDescriptor), nil
+	}
+	var columns []Column
+	seen := map[string]bool{}
+	for _, path := range mask.Paths {
+		fields, err := resolve(strings.TrimSpace(path))
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range expand(fields) {
+			if !seen[column.Name] {
+				seen[column.Name] = true
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns, nil
+}
+
+// resolve returns the fields of a mask path from MlsListing.
+func resolve(path string) ([]protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, fmt.Errorf("field mask path must not be empty")
+	}
+	message := listingDescriptor
+	segments := strings.Split(path, ".")
+	fields := make([]protoreflect.FieldDescriptor, 0, len(segments))
+	for i, segment := range segments {
+		if message == nil || message == timestampDescriptor {
+			return nil, fmt.Errorf("field mask path %q is not valid at %q", path, strings.Join(segments[:i+1], "."))
+		}
+		field := message.Fields().ByName(protoreflect.Name(segment))
+		if field == nil {
+			field = message.Fields().ByJSONName(segment)
+		}
+		if field == nil {
+			return nil, fmt.Errorf("field mask path %q is not valid. %q is not a field of %s", path, segment, message.Name())
+		}
+		fields = append(fields, field)
+		message = nil
+		if field.Message() != nil && !field.IsList() && !field.IsMap() {
+			message = field.Message()
+		}
+	}
+	return fields, nil
+}
+
+// expand returns the column of a field, or the columns under it when it is a message.
+func expand(fields []protoreflect.FieldDescriptor) []Column {
+	last := fields[len(fields)-1]
+	if isNested(last) {
+		return flatten(fields, last.Message())
+	}
+	return []Column{newColumn(fields)}
+}
+
+// flatten returns the columns of the fields of a message, nested messages are flattened in place.
+func flatten(parent []protoreflect.FieldDescriptor, message protoreflect.MessageDescriptor) []Column {
+	var columns []Column
+	for i := 0; i < message.Fields().Len(); i++ {
+		fields := append(append([]protoreflect.FieldDescriptor{}, parent...), message.Fields().Get(i))
+		columns = append(columns, expand(fields)...)
+	}
+	return columns
+}
+
+// isNested reports whether a field is flattened into the columns of its fields. Timestamps and repeated messages are single columns.
+func isNested(field protoreflect.FieldDescriptor) bool {
+	return field.Message() != nil && field.Message() != timestampDescriptor && !field.IsList() && !field.IsMap()
+}
+
+func newColumn(fields []protoreflect.FieldDescriptor) Column {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, string(field.Name()))
+	}
+	return Column{Name: strings.Join(names, "."), fields: fields}
+}
+
+// value returns the value of the column in a listing, nil when a message on the path is not set.
+func (c Column) value(listing protoreflect.Message) protoreflect.Value {
+	m := listing
+	for _, field := range c.fields[:len(c.fields)-1] {
+		if !m.Has(field) {
+			return protoreflect.Value{}
+		}
+		m = m.Get(field).Message()
+	}
+	last := c.fields[len(c.fields)-1]
+	if last.Message() != nil && !last.IsList() && !last.IsMap() && !m.Has(last) {
+		return protoreflect.Value{}
+	}
+	return m.Get(last)
+}
+
+// JSONName returns the json name of the field of the column, e.g. "listPrice".
+func (c Column) JSONName() string {
+	return c.fields[len(c.fields)-1].JSONName()
+}
+
+// Value returns the value of the column in a listing as it is exported to JSON, nil when it is not set.
+func (c Column) Value(listing *pb.MlsListing) interface{} {
+	return jsonValue(c.fields[len(c.fields)-1], c.value(listing.ProtoReflect()))
+}
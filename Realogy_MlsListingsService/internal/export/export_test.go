@@ -0,0 +1,146 @@
+package export_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"mlslisting/internal/export"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func names(columns []export.Column) []string {
+	var names []string
+	for _, column := range columns {
+		names = append(names, column.Name)
+	}
+	return names
+}
+
+func testListing() *pb.MlsListing {
+	return &pb.MlsListing{
+		Property: &pb.Property{
+			PropertyType: "SFR",
+			Listing:      &pb.Listing{ListingId: "100018", Price: &pb.Price{ListPrice: 250000}},
+			Location:     &pb.Location{Address: &pb.Address{City: "Irving, TX"}},
+		},
+		Media: &pb.Media{
+			NumImages:             2,
+			ModificationTimestamp: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+			MediaInfo:             []*pb.MediaInfo{{IndexNum: 1, MediaUrl: "https://photos/1.jpg"}},
+		},
+	}
+}
+
+func TestColumnsAll(t *testing.T) {
+	columns, err := export.Columns(nil)
+	assert.Nil(t, err)
+	all := names(columns)
+	assert.Contains(t, all, "property.listing.price.list_price")
+	assert.Contains(t, all, "media.modification_timestamp")
+	// repeated messages are a single column.
+	assert.Contains(t, all, "media.media_info")
+	assert.NotContains(t, all, "media.media_info.media_url")
+}
+
+func TestColumnsMask(t *testing.T) {
+	columns, err := export.Columns(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.listingId", "media", "media.num_images"}})
+	assert.Nil(t, err)
+	// a message selects the fields under it, a column is exported once.
+	assert.Equal(t, []string{
+		"property.listing.listing_id",
+		"media.num_images",
+		"media.modification_timestamp",
+		"media.image_hash_code",
+		"media.uuid",
+		"media.last_change_timestamp",
+		"media.media_info",
+	}, names(columns))
+
+	for path, message := range map[string]string{
+		"":                                 "field mask path must not be empty",
+		"property.price":                   `field mask path "property.price" is not valid. "price" is not a field of Property`,
+		"media.modification_timestamp.day": `field mask path "media.modification_timestamp.day" is not valid at "media.modification_timestamp.day"`,
+	} {
+		_, err := export.Columns(&fieldmaskpb.FieldMask{Paths: []string{path}})
+		if assert.NotNil(t, err, path) {
+			assert.Equal(t, message, err.Error())
+		}
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	columns, _ := export.Columns(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.listing_id", "property.listing.price.list_price",
+		"property.location.address.city", "property.structure.bedrooms_total", "media.modification_timestamp", "media.media_info"}})
+	var out bytes.Buffer
+	w := export.NewWriter(&out, pb.ExportFormat_NDJSON, columns)
+	assert.Nil(t, w.Write(testListing()))
+	assert.Nil(t, w.Write(&pb.MlsListing{}))
+	assert.Nil(t, w.Flush())
+
+	assert.Equal(t, `{"property.listing.listing_id":"100018","property.listing.price.list_price":250000,"property.location.address.city":"Irving, TX",`+
+		`"property.structure.bedrooms_total":null,"media.modification_timestamp":"2022-01-02T03:04:05Z","media.media_info":[{"index_num":1,"media_url":"https://photos/1.jpg"}]}
+{"property.listing.listing_id":null,"property.listing.price.list_price":null,"property.location.address.city":null,`+
+		`"property.structure.bedrooms_total":null,"media.modification_timestamp":null,"media.media_info":null}
+`, out.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	columns, _ := export.Columns(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.listing_id", "property.listing.price.list_price",
+		"property.location.address.city", "media.media_info"}})
+	var out bytes.Buffer
+	w := export.NewWriter(&out, pb.ExportFormat_CSV, columns)
+	assert.Nil(t, w.Write(testListing()))
+	assert.Nil(t, w.Flush())
+
+	assert.Equal(t, `property.listing.listing_id,property.listing.price.list_price,property.location.address.city,media.media_info
+100018,250000,"Irving, TX","[{""index_num"":1,""media_url"":""https://photos/1.jpg""}]"
+`, out.String())
+
+	// the header is written without rows.
+	out.Reset()
+	w = export.NewWriter(&out, pb.ExportFormat_CSV, columns[:1])
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, "property.listing.listing_id\n", out.String())
+}
+
+func TestChunkerGzip(t *testing.T) {
+	var chunks [][]byte
+	chunker := export.NewChunker(16, func(data []byte) error {
+		chunks = append(chunks, data)
+		return nil
+	})
+	compressor := gzip.NewWriter(chunker)
+	columns, _ := export.Columns(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.listing_id"}})
+	w := export.NewWriter(compressor, pb.ExportFormat_NDJSON, columns)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, w.Write(testListing()))
+	}
+	assert.Nil(t, w.Flush())
+	assert.Nil(t, compressor.Close())
+	assert.Nil(t, chunker.Flush())
+
+	assert.True(t, len(chunks) > 1)
+	for _, chunk := range chunks[:len(chunks)-1] {
+		assert.True(t, len(chunk) >= 16)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(bytes.Join(chunks, nil)))
+	assert.Nil(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, bytes.Repeat([]byte(`{"property.listing.listing_id":"100018"}`+"\n"), 100), data)
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "application/x-ndjson", export.ContentType(pb.ExportFormat_NDJSON, false))
+	assert.Equal(t, "text/csv", export.ContentType(pb.ExportFormat_CSV, false))
+	assert.Equal(t, "application/gzip", export.ContentType(pb.ExportFormat_CSV, true))
+	assert.Equal(t, "listings.csv.gz", export.FileName(pb.ExportFormat_CSV, true))
+	assert.Equal(t, "listings.ndjson", export.FileName(pb.ExportFormat_NDJSON, false))
+}
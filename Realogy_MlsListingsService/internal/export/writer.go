@@ -0,0 +1,184 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// ContentType returns the media type of an export.
+func ContentType(format pb.ExportFormat, gzip bool) string {
+	switch {
+	case gzip:
+		return "application/gzip"
+	case format == pb.ExportFormat_CSV:
+		return "text/csv"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// FileName returns the name of an export file, e.g. "listings.csv.gz".
+func FileName(format pb.ExportFormat, gzip bool) string {
+	name := "listings.ndjson"
+	if format == pb.ExportFormat_CSV {
+		name = "listings.csv"
+	}
+	if gzip {
+		name += ".gz"
+	}
+	return name
+}
+
+// Writer writes listings as the rows of an export. CSV exports start with a header row of the column names.
+type Writer struct {
+	format  pb.ExportFormat
+	columns []Column
+	out     io.Writer
+	csv     *csv.Writer
+	header  bool
+}
+
+// NewWriter returns a writer of rows to out, Flush must be called after the last row.
+func NewWriter(out io.Writer, format pb.ExportFormat, columns []Column) *Writer {
+	w := &Writer{format: format, columns: columns, out: out}
+	if format == pb.ExportFormat_CSV {
+		w.csv = csv.NewWriter(out)
+	}
+	return w
+}
+
+// Write writes the row of a listing.
+func (w *Writer) Write(listing *pb.MlsListing) error {
+	if w.csv != nil {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+		record := make([]string, 0, len(w.columns))
+		for _, column := range w.columns {
+			cell, err := csvCell(column.Value(listing))
+			if err != nil {
+				return fmt.Errorf("column %s: %v", column.Name, err)
+			}
+			record = append(record, cell)
+		}
+		return w.csv.Write(record)
+	}
+
+	// columns are written in order, a map would sort them by name.
+	var row bytes.Buffer
+	row.WriteByte('{')
+	for i, column := range w.columns {
+		if i > 0 {
+			row.WriteByte(',')
+		}
+		name, _ := json.Marshal(column.Name)
+		value, err := json.Marshal(column.Value(listing))
+		if err != nil {
+			return fmt.Errorf("column %s: %v", column.Name, err)
+		}
+		row.Write(name)
+		row.WriteByte(':')
+		row.Write(value)
+	}
+	row.WriteString("}\n")
+	_, err := w.out.Write(row.Bytes())
+	return err
+}
+
+// Flush writes the buffered rows, and the header of a CSV export without rows.
+func (w *Writer) Flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *Writer) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+	names := make([]string, 0, len(w.columns))
+	for _, column := range w.columns {
+		names = append(names, column.Name)
+	}
+	return w.csv.Write(names)
+}
+
+// csvCell formats a value as a CSV cell, strings as is and other values as JSON. Null is an empty cell.
+func csvCell(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+	cell, err := json.Marshal(value)
+	return string(cell), err
+}
+
+// jsonValue returns a value of a field for encoding/json. Enums are their names, timestamps RFC 3339 strings in UTC
+// and messages their protojson form with proto field names.
+func jsonValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+	switch {
+	case field.IsList():
+		list := value.List()
+		values := make([]interface{}, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, singularValue(field, list.Get(i)))
+		}
+		return values
+	case field.IsMap():
+		values := map[string]interface{}{}
+		value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			values[key.String()] = singularValue(field.MapValue(), value)
+			return true
+		})
+		return values
+	}
+	return singularValue(field, value)
+}
+
+func singularValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if enum := field.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+		return int32(value.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		message := value.Message().Interface()
+		if timestamp, ok := message.(*timestamppb.Timestamp); ok {
+			return timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+		}
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+		if err != nil {
+			return nil
+		}
+		return json.RawMessage(data)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		// JSON has no NaN or infinity.
+		if f := value.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Sprint(f)
+		}
+	}
+	return value.Interface()
+}
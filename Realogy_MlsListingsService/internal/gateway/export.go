@@ -0,0 +1,102 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mlslisting/internal/export"
+	"net/http"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+const exportPath = "/mls/export/source/"
+
+// the path parameter is not overridden by the query.
+var exportQueryFilter = utilities.NewDoubleArray([][]string{{"source_system_key"}})
+
+// exportHandler serves ExportMlsListings as a file download at "/mls/export/source/{source_system_key}".
+// The chunks of the export are written as they are received, the grpc gateway would delimit them with new lines.
+// The other request fields are query parameters, e.g. "?format=CSV&gzip=true&fields=property.listing&filter.propertyType=SFR".
+func exportHandler(client pb.MlsListingServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeStatus(w, status.New(codes.Unimplemented, "method not allowed"))
+			return
+		}
+		request := &pb.ExportMlsListingsRequest{SourceSystemKey: strings.TrimPrefix(r.URL.Path, exportPath)}
+		if strings.Contains(request.SourceSystemKey, "/") {
+			writeStatus(w, status.New(codes.NotFound, "Not Found"))
+			return
+		}
+		if err := gwruntime.PopulateQueryParameters(request, r.URL.Query(), exportQueryFilter); err != nil {
+			writeStatus(w, status.New(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		ctx, cancel := context.WithCancel(outgoingContext(r))
+		defer cancel()
+		stream, err := client.ExportMlsListings(ctx, request)
+		if err != nil {
+			writeStatus(w, status.Convert(err))
+			return
+		}
+
+		// errors are sent as the status until the first chunk is written, the export is truncated afterwards.
+		wroteHeader := false
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if !wroteHeader {
+					writeStatus(w, status.Convert(err))
+					return
+				}
+				log.Errorf("Export of %s failed after it started: %v", request.SourceSystemKey, err)
+				return
+			}
+			if !wroteHeader {
+				writeExportHeader(w, request)
+				wroteHeader = true
+			}
+			if _, err := w.Write(chunk.Data); err != nil {
+				log.Errorf("Unable to write export chunk: %v", err)
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+		if !wroteHeader {
+			writeExportHeader(w, request)
+		}
+	}
+}
+
+func writeExportHeader(w http.ResponseWriter, request *pb.ExportMlsListingsRequest) {
+	w.Header().Set("Content-Type", export.ContentType(request.Format, request.Gzip))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.FileName(request.Format, request.Gzip)))
+	w.WriteHeader(http.StatusOK)
+}
+
+// writeStatus writes an error as the grpc gateway does.
+func writeStatus(w http.ResponseWriter, s *status.Status) {
+	body, err := protojson.Marshal(s.Proto())
+	if err != nil {
+		log.Errorf("Unable to marshal error status: %v", err)
+		body = []byte(`{"code":13,"message":"unexpected error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(gwruntime.HTTPStatusFromCode(s.Code()))
+	w.Write(body)
+}
@@ -22,6 +22,10 @@ import (
 
 const (
 	health = "health"
+	// etagHeader is the grpc response header of the ETag of a listing.
+	etagHeader = "etag"
+	// cacheControlHeader is the grpc response header of how long market statistics may be cached.
+	cacheControlHeader = "cache-control"
 )
 
 // Endpoint describes a gRPC endpoint
@@ -39,6 +43,9 @@ type Options struct {
 
 	// Mux is a list of options to be passed to the server-gateway multiplexer
 	Mux []gwruntime.ServeMuxOption
+
+	// GeoJSONProperties are the listing fields of the feature properties of GeoJSON responses
+	GeoJSONProperties []string
 }
 
 // Run starts a HTTP server and blocks while running if successful.
@@ -61,11 +68,15 @@ func Run(ctx context.Context, opts Options) error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/"+health, healthServer(conn))
 
-	gw, err := newGateway(ctx, conn)
+	gw, err := newGateway(ctx, conn, opts)
 	if err != nil {
 		return err
 	}
 	mux.Handle("/", gw)
+	odata := odataHandler(pb.NewMlsListingServiceClient(conn))
+	mux.Handle(odataPath, odata)
+	mux.Handle(odataPath+"/", odata)
+	mux.Handle(exportPath, exportHandler(pb.NewMlsListingServiceClient(conn)))
 
 	s := &http.Server{
 		Addr:    opts.Addr,
@@ -119,6 +130,7 @@ func Start(ctx context.Context, config config.Config) error {
 			Network: config.Gateway.Network,
 			Addr:    fmt.Sprintf("localhost:%d", config.Grpc.Port),
 		},
+		GeoJSONProperties: config.Api.GeoJSON.Properties,
 	}); err != nil {
 		return err
 	}
@@ -132,34 +144,44 @@ func Start(ctx context.Context, config config.Config) error {
 }
 
 // Creates new server gateway server which translates HTTP into gRPC request
-func newGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+func newGateway(ctx context.Context, conn *grpc.ClientConn, opts Options) (http.Handler, error) {
+
+	jsonMarshaler := &gwruntime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:   false,
+			EmitUnpopulated: true,
+			Multiline:       false,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+	geoJSONMarshaler, err := newGeoJSONMarshaler(jsonMarshaler, opts.GeoJSONProperties)
+	if err != nil {
+		return nil, err
+	}
 
 	mux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
-			Marshaler: &gwruntime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{
-					UseProtoNames:   false,
-					EmitUnpopulated: true,
-					Multiline:       false,
-				},
-				UnmarshalOptions: protojson.UnmarshalOptions{
-					DiscardUnknown: true,
-				},
-			},
+			Marshaler: jsonMarshaler,
 		}),
+		gwruntime.WithMarshalerOption(geoJSONMIME, geoJSONMarshaler),
 		gwruntime.WithIncomingHeaderMatcher(httpHeaderMatcher),
+		gwruntime.WithOutgoingHeaderMatcher(grpcHeaderMatcher),
+		gwruntime.WithErrorHandler(errorHandler),
 		gwruntime.WithStreamErrorHandler(streamErrorHandler),
 	)
 
 	for _, f := range []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		pb.RegisterMlsListingServiceHandler,
+		pb.RegisterMlsListingsStatsServiceHandler,
 	} {
 		if err := f(ctx, mux, conn); err != nil {
 			return nil, err
 		}
 	}
 
-	return mux, nil
+	return geoJSONFormat(mux), nil
 }
 
 // interrupt stream error from grpc and wrap it with http error.
@@ -176,6 +198,27 @@ func streamErrorHandler(ctx context.Context, err error) *status.Status {
 	return status.Newf(code, msg)
 }
 
+// errorHandler writes errors as the default handler does. Version conflicts of listing updates are written as
+// 412 Precondition Failed rather than 400, they are the failed preconditions sent with the ETag of the current version.
+func errorHandler(ctx context.Context, mux *gwruntime.ServeMux, marshaler gwruntime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		if md, ok := gwruntime.ServerMetadataFromContext(ctx); ok && len(md.HeaderMD.Get(etagHeader)) > 0 {
+			w = &statusWriter{ResponseWriter: w, status: http.StatusPreconditionFailed}
+		}
+	}
+	gwruntime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// statusWriter writes the header of a response with its status instead of the one written.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
 func dial(ctx context.Context, network, addr string) (*grpc.ClientConn, error) {
 	switch network {
 	case "tcp":
@@ -207,7 +250,23 @@ func httpHeaderMatcher(key string) (string, bool) {
 		return key, true
 	case "Authorization", "authorization": // authorization is transformed to "Authorization".
 		return key, true
+	case "Apply-Display-Rules": // opts in to the mls display rules, see interceptor.ApplyDisplayRulesHeader.
+		return key, true
+	case "If-Match": // the ETag a listing update expects the listing to have.
+		return key, true
 	default: // expand this to allow more headers. restricted only to "apiKey" for now.
 		return key, false
 	}
 }
+
+// match headers in the grpc response and name the http headers they are forwarded as.
+func grpcHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case etagHeader: // the ETag of a listing version.
+		return "ETag", true
+	case cacheControlHeader: // how long market statistics may be cached.
+		return "Cache-Control", true
+	default:
+		return gwruntime.MetadataHeaderPrefix + key, true
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -139,6 +140,38 @@ func TestIntegrationGatewayMlsListingsBySource(t *testing.T) {
 	AssertElementsMatch(t, msg.MlsListings, testListings.MlsListings)
 }
 
+func TestIntegrationGatewayMlsListingsBySourceWithFields(t *testing.T) {
+	endpointUrl := fmt.Sprintf("http://localhost:%d/mls/source/AR_TMLS?fields=property.listing.price.list_price,property.location.gis", port)
+	resp, err := http.Get(endpointUrl)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	buf, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var msg pb.GetMlsListingsBySourceResponse
+	if err := jsonpb.UnmarshalString(string(buf), &msg); err != nil {
+		t.Errorf("unable to unmarshall due to %v", err)
+		return
+	}
+
+	// only the requested fields are returned.
+	assert.Equal(t, len(msg.MlsListings), 1)
+	listing := msg.MlsListings[0]
+	assert.NotZero(t, listing.Property.Listing.Price.ListPrice)
+	assert.NotNil(t, listing.Property.Location.Gis)
+	assert.Empty(t, listing.Property.Listing.ListingId)
+	assert.Nil(t, listing.Property.Location.Address)
+	assert.Nil(t, listing.Media)
+
+	// unknown fields are rejected.
+	resp2, err := http.Get(fmt.Sprintf("http://localhost:%d/mls/source/AR_TMLS?fields=property.price", port))
+	assert.Nil(t, err)
+	defer resp2.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp2.StatusCode)
+}
+
 func TestIntegrationGatewayGetMlsListingsByCompanyStaffGuid(t *testing.T) {
 	endpointUrl := fmt.Sprintf("http://localhost:%d/mls/dash/staff/152ced2d-ef39-4587-b201-668e8d8b9114", port)
 	resp, err := http.Get(endpointUrl)
@@ -169,6 +202,172 @@ func TestIntegrationGatewayGetMlsListingsByCompanyStaffGuid(t *testing.T) {
 }
 
 // json comparison
+func TestIntegrationGatewayODataProperty(t *testing.T) {
+	query := url.Values{}
+	query.Set("$filter", "ListingId eq '100018'")
+	query.Set("$select", "ListingKey,ListingId,City")
+	query.Set("$count", "true")
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/odata/Property?%s", port, query.Encode()))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "4.0", resp.Header.Get("OData-Version"))
+
+	var body struct {
+		Context string                   `json:"@odata.context"`
+		Count   int64                    `json:"@odata.count"`
+		Value   []map[string]interface{} `json:"value"`
+	}
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "/odata/$metadata#Property", body.Context)
+	assert.Equal(t, int64(1), body.Count)
+	if assert.Equal(t, 1, len(body.Value)) {
+		assert.Equal(t, "100018-KY_WKRMLS", body.Value[0]["ListingKey"])
+		assert.Equal(t, "100018", body.Value[0]["ListingId"])
+		assert.Contains(t, body.Value[0], "City")
+	}
+}
+
+func TestIntegrationGatewayODataMetadata(t *testing.T) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/odata/$metadata", port))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/xml", resp.Header.Get("Content-Type"))
+	buf, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Contains(t, string(buf), `<EntitySet Name="Property" EntityType="org.reso.metadata.Property">`)
+}
+
+func TestIntegrationGatewayODataInvalidFilter(t *testing.T) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/odata/Property?$filter=%s", port, url.QueryEscape("Town eq 'Irving'")))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "400", body.Error.Code)
+	assert.Equal(t, `Invalid input. $filter: unknown field "Town"`, body.Error.Message)
+}
+
+func TestIntegrationGatewayExportMlsListings(t *testing.T) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/mls/export/source/KY_WKRMLS?format=CSV&fields=property.listing.listingId,property.propertyType", port))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="listings.csv"`, resp.Header.Get("Content-Disposition"))
+	buf, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	assert.Equal(t, "property.listing.listing_id,property.property_type", lines[0])
+	assert.Contains(t, lines, "100018,LAND")
+}
+
+func TestIntegrationGatewayExportMlsListingsInvalidFields(t *testing.T) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/mls/export/source/KY_WKRMLS?fields=property.price", port))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+}
+
+func TestIntegrationGatewayMlsListingsGeoJSON(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:%d/mls/listing/100018", port), nil)
+	assert.Nil(t, err)
+	req.Header.Set("Accept", "application/geo+json")
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/geo+json", resp.Header.Get("Content-Type"))
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type        string     `json:"type"`
+				Coordinates [2]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&collection))
+	assert.Equal(t, "FeatureCollection", collection.Type)
+	if assert.Equal(t, 1, len(collection.Features)) {
+		assert.Equal(t, "Point", collection.Features[0].Geometry.Type)
+		assert.Equal(t, [2]float64{-87.8069197, 36.8587035}, collection.Features[0].Geometry.Coordinates)
+		assert.Equal(t, "100018", collection.Features[0].Properties["listingId"])
+	}
+
+	// errors are JSON.
+	resp, err = http.Get(fmt.Sprintf("http://localhost:%d/mls/listing/99887766?format=geojson", port))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+}
+
+func TestIntegrationGatewayUpdateMlsListingIfMatch(t *testing.T) {
+	listingUrl := fmt.Sprintf("http://localhost:%d/mls/listing/493278/source/CABCREIS", port)
+	resp, err := http.Get(listingUrl)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// an update expecting another revision fails with the ETag of the current one.
+	req, err := http.NewRequest(http.MethodPut, listingUrl, strings.NewReader(`{"property":{"listing":{"price":{"listPrice":62000}}}}`))
+	assert.Nil(t, err)
+	req.Header.Set("If-Match", `"999999"`)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+}
+
+func TestIntegrationGatewayDeleteMlsListingOfFeed(t *testing.T) {
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("http://localhost:%d/mls/listing/100018/source/KY_WKRMLS?hard=true", port), nil)
+	assert.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	// only listings of internal sources are deleted.
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIntegrationGatewayPatchMlsListing(t *testing.T) {
+	listingUrl := fmt.Sprintf("http://localhost:%d/mls/listing/493278/source/CABCREIS", port)
+
+	// without an update mask, the fields of the body are masked.
+	req, err := http.NewRequest(http.MethodPatch, listingUrl, strings.NewReader(`{"property":{"listing":{"remarks":{"publicRemarks":"Patched over http"}}}}`))
+	assert.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("ETag"))
+
+	var patched pb.PatchMlsListingResponse
+	buf, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Nil(t, jsonpb.UnmarshalString(string(buf), &patched))
+	assert.Equal(t, "Patched over http", patched.MlsListing.Property.Listing.Remarks.PublicRemarks)
+}
+
 func AssertElementsMatch(t *testing.T, protoResponse []*pb.MlsListing, testListings []*pb.MlsListing) {
 	actualJson, _ := json.Marshal(protoResponse)
 	expectedJson, _ := json.Marshal(testListings)
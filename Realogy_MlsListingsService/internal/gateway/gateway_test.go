@@ -0,0 +1,31 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorHandlerRevisionConflict(t *testing.T) {
+	mux := gwruntime.NewServeMux(gwruntime.WithOutgoingHeaderMatcher(grpcHeaderMatcher))
+	err := status.Error(codes.FailedPrecondition, "Listing 100018 has changed")
+
+	// failed preconditions with the ETag of the current revision are version conflicts.
+	ctx := gwruntime.NewServerMetadataContext(context.Background(), gwruntime.ServerMetadata{HeaderMD: metadata.Pairs(etagHeader, `"3"`)})
+	w := httptest.NewRecorder()
+	errorHandler(ctx, mux, &gwruntime.JSONPb{}, w, httptest.NewRequest(http.MethodPut, "/mls/listing/100018/source/KY_WKRMLS", nil), err)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+
+	ctx = gwruntime.NewServerMetadataContext(context.Background(), gwruntime.ServerMetadata{})
+	w = httptest.NewRecorder()
+	errorHandler(ctx, mux, &gwruntime.JSONPb{}, w, httptest.NewRequest(http.MethodGet, "/mls/source/KY_WKRMLS", nil), err)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,178 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"mlslisting/internal/export"
+	"net/http"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+const geoJSONMIME = "application/geo+json"
+
+var mlsListingName = (&pb.MlsListing{}).ProtoReflect().Descriptor().FullName()
+
+type feature struct {
+	Type       string                 `json:"type"`
+	Geometry   point                  `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// geoJSONMarshaler writes the listings of a response as a GeoJSON FeatureCollection of their coordinates.
+// Listings without coordinates are left out and their listing ids reported in "listingsWithoutCoordinates",
+// the other fields of the response, e.g. "pageInfo", are kept as members of the collection.
+// Responses without listings, errors included, are written by the JSON marshaler.
+type geoJSONMarshaler struct {
+	gwruntime.Marshaler
+	properties []export.Column
+}
+
+// newGeoJSONMarshaler returns a marshaler of feature properties of the listing fields at paths, named by their json name.
+func newGeoJSONMarshaler(jsonMarshaler gwruntime.Marshaler, paths []string) (*geoJSONMarshaler, error) {
+	var columns []export.Column
+	if len(paths) > 0 { // an empty mask would select all the fields.
+		var err error
+		if columns, err = export.Columns(&fieldmaskpb.FieldMask{Paths: paths}); err != nil {
+			return nil, fmt.Errorf("geojson properties: %v", err)
+		}
+	}
+	names := map[string]string{}
+	for _, column := range columns {
+		if other, ok := names[column.JSONName()]; ok {
+			return nil, fmt.Errorf("geojson properties: %s and %s are both named %q", other, column.Name, column.JSONName())
+		}
+		names[column.JSONName()] = column.Name
+	}
+	return &geoJSONMarshaler{Marshaler: jsonMarshaler, properties: columns}, nil
+}
+
+func (m *geoJSONMarshaler) ContentType(v interface{}) string {
+	if len(listingFields(v)) == 0 {
+		return m.Marshaler.ContentType(v)
+	}
+	return geoJSONMIME
+}
+
+func (m *geoJSONMarshaler) Marshal(v interface{}) ([]byte, error) {
+	fields := listingFields(v)
+	if len(fields) == 0 {
+		return m.Marshaler.Marshal(v)
+	}
+
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+
+	features := []feature{}
+	withoutCoordinates := []string{}
+	message := v.(proto.Message).ProtoReflect()
+	for _, fd := range fields {
+		delete(members, fd.JSONName())
+		for _, listing := range listingsOf(message, fd) {
+			f, ok := m.feature(listing)
+			if !ok {
+				withoutCoordinates = append(withoutCoordinates, listing.GetProperty().GetListing().GetListingId())
+				continue
+			}
+			features = append(features, f)
+		}
+	}
+	for name, value := range map[string]interface{}{"type": "FeatureCollection", "features": features, "listingsWithoutCoordinates": withoutCoordinates} {
+		if members[name], err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(members)
+}
+
+// feature returns the point feature of a listing, false when it has no coordinates.
+func (m *geoJSONMarshaler) feature(listing *pb.MlsListing) (feature, bool) {
+	gis := listing.GetProperty().GetLocation().GetGis()
+	latitude, longitude := gis.GetLatitude(), gis.GetLongitude()
+	if latitude == 0 && longitude == 0 || latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return feature{}, false
+	}
+	properties := make(map[string]interface{}, len(m.properties))
+	for _, column := range m.properties {
+		properties[column.JSONName()] = column.Value(listing)
+	}
+	// GeoJSON positions are longitude first.
+	return feature{Type: "Feature", Geometry: point{Type: "Point", Coordinates: [2]float64{longitude, latitude}}, Properties: properties}, true
+}
+
+// listingFields returns the top level MlsListing fields of a response.
+func listingFields(v interface{}) []protoreflect.FieldDescriptor {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+	var fields []protoreflect.FieldDescriptor
+	descriptors := m.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < descriptors.Len(); i++ {
+		fd := descriptors.Get(i)
+		if fd.Message() != nil && fd.Message().FullName() == mlsListingName && !fd.IsMap() {
+			fields = append(fields, fd)
+		}
+	}
+	return fields
+}
+
+func listingsOf(m protoreflect.Message, fd protoreflect.FieldDescriptor) []*pb.MlsListing {
+	if !m.Has(fd) {
+		return nil
+	}
+	if !fd.IsList() {
+		return []*pb.MlsListing{m.Get(fd).Message().Interface().(*pb.MlsListing)}
+	}
+	list := m.Get(fd).List()
+	listings := make([]*pb.MlsListing, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		listings = append(listings, list.Get(i).Message().Interface().(*pb.MlsListing))
+	}
+	return listings
+}
+
+// geoJSONFormat negotiates GeoJSON for requests with "?format=geojson" or an Accept header of it, e.g. "application/geo+json, */*",
+// as the grpc gateway matches Accept headers exactly. Streams are written as JSON.
+func geoJSONFormat(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		geoJSON := r.URL.Query().Get("format") == "geojson"
+		for _, accept := range r.Header.Values("Accept") {
+			for _, mediaRange := range strings.Split(accept, ",") {
+				if mediaType, _, err := mime.ParseMediaType(mediaRange); err == nil && mediaType == geoJSONMIME {
+					geoJSON = true
+				}
+			}
+		}
+		if geoJSON {
+			r.Header.Del("Accept")
+			if !isStream(r.URL.Path) {
+				r.Header.Set("Accept", geoJSONMIME)
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// isStream tells whether a route is a streaming one, their chunks are not collections of listings.
+func isStream(path string) bool {
+	return strings.HasPrefix(path, "/mls/stream/") || path == "/mls/changes"
+}
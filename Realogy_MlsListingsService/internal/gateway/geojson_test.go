@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func testGeoJSONMarshaler(t *testing.T) *geoJSONMarshaler {
+	m, err := newGeoJSONMarshaler(&gwruntime.JSONPb{}, []string{"property.listing.listing_id", "property.listing.price.list_price"})
+	assert.Nil(t, err)
+	return m
+}
+
+func geoListing(id string, latitude, longitude float64) *pb.MlsListing {
+	return &pb.MlsListing{Property: &pb.Property{
+		Listing:  &pb.Listing{ListingId: id, Price: &pb.Price{ListPrice: 250000}},
+		Location: &pb.Location{Gis: &pb.Gis{Latitude: latitude, Longitude: longitude}},
+	}}
+}
+
+func TestGeoJSONMarshal(t *testing.T) {
+	m := testGeoJSONMarshaler(t)
+	response := &pb.SearchMlsListingsResponse{MlsListings: []*pb.MlsListing{
+		geoListing("100018", 36.8587035, -87.8069197),
+		geoListing("100095", 0, 0),
+		{},
+	}}
+
+	assert.Equal(t, geoJSONMIME, m.ContentType(response))
+	data, err := m.Marshal(response)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "FeatureCollection",
+		"features": [{
+			"type": "Feature",
+			"geometry": {"type": "Point", "coordinates": [-87.8069197, 36.8587035]},
+			"properties": {"listingId": "100018", "listPrice": 250000}
+		}],
+		"listingsWithoutCoordinates": ["100095", ""]
+	}`, string(data))
+}
+
+func TestGeoJSONMarshalOtherMessages(t *testing.T) {
+	m := testGeoJSONMarshaler(t)
+	// errors and responses without listings are JSON.
+	s := status.New(codes.NotFound, "Not Found").Proto()
+	assert.Equal(t, "application/json", m.ContentType(s))
+	data, err := m.Marshal(s)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"code": 5, "message": "Not Found"}`, string(data))
+}
+
+func TestNewGeoJSONMarshalerInvalid(t *testing.T) {
+	_, err := newGeoJSONMarshaler(&gwruntime.JSONPb{}, []string{"property.listing.price"})
+	assert.Nil(t, err)
+	_, err = newGeoJSONMarshaler(&gwruntime.JSONPb{}, []string{"property.listing.list_price"})
+	assert.NotNil(t, err)
+	_, err = newGeoJSONMarshaler(&gwruntime.JSONPb{}, []string{"property.listing.compensation"})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), `are both named "percentage"`)
+	}
+}
+
+func TestGeoJSONFormat(t *testing.T) {
+	var accept string
+	h := geoJSONFormat(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+	}))
+	for url, expected := range map[string]string{
+		"/mls/source/RMLS?format=geojson":        geoJSONMIME,
+		"/mls/source/RMLS":                       "application/json",
+		"/mls/stream/source/RMLS?format=geojson": "",
+	} {
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		r.Header.Set("Accept", "application/json")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		assert.Equal(t, expected, accept, url)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/mls/source/RMLS", nil)
+	r.Header.Set("Accept", "application/geo+json;q=0.9, */*")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, geoJSONMIME, accept)
+}
@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mlslisting/internal/odata"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+const odataPath = "/odata"
+
+type odataServiceDocument struct {
+	Context string              `json:"@odata.context"`
+	Value   []odataEntitySetRef `json:"value"`
+}
+
+type odataEntitySetRef struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Url  string `json:"url"`
+}
+
+type odataCollection struct {
+	Context  string                   `json:"@odata.context"`
+	Count    *int64                   `json:"@odata.count,omitempty"`
+	Value    []map[string]interface{} `json:"value"`
+	NextLink string                   `json:"@odata.nextLink,omitempty"`
+}
+
+type odataError struct {
+	Error odataErrorDetail `json:"error"`
+}
+
+type odataErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// odataHandler serves the listings as the "Property" resource of an OData v4 service, the RESO Web API.
+// Queries are run by QueryMlsListings, with the headers the gateway forwards to the grpc server.
+func odataHandler(client pb.MlsListingServiceClient) http.HandlerFunc {
+	metadataDocument, err := odata.Metadata()
+	if err != nil {
+		log.Panicf("Unable to build the OData metadata document: %v", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeODataError(w, http.StatusMethodNotAllowed, "the OData service is read only")
+			return
+		}
+		w.Header().Set("OData-Version", "4.0")
+		switch strings.TrimSuffix(r.URL.Path, "/") {
+		case odataPath:
+			writeODataJSON(w, odataServiceDocument{
+				Context: odataPath + "/$metadata",
+				Value:   []odataEntitySetRef{{Name: odata.EntitySet, Kind: "EntitySet", Url: odata.EntitySet}},
+			})
+		case odataPath + "/$metadata":
+			w.Header().Set("Content-Type", "application/xml")
+			w.Write(metadataDocument)
+		case odataPath + "/" + odata.EntitySet:
+			queryProperty(w, r, client)
+		default:
+			writeODataError(w, http.StatusNotFound, fmt.Sprintf("no resource at %s", r.URL.Path))
+		}
+	}
+}
+
+func queryProperty(w http.ResponseWriter, r *http.Request, client pb.MlsListingServiceClient) {
+	query := r.URL.Query()
+	request := &pb.QueryMlsListingsRequest{
+		Filter:  query.Get("$filter"),
+		OrderBy: query.Get("$orderby"),
+	}
+	if selected := query.Get("$select"); selected != "" {
+		request.Select = strings.Split(selected, ",")
+	}
+	var err error
+	if request.Top, err = queryInt(query, "$top"); err != nil {
+		writeODataError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if request.Skip, err = queryInt(query, "$skip"); err != nil {
+		writeODataError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	switch query.Get("$count") {
+	case "", "false":
+	case "true":
+		request.Count = true
+	default:
+		writeODataError(w, http.StatusBadRequest, "$count must be true or false")
+		return
+	}
+
+	response, err := client.QueryMlsListings(outgoingContext(r), request)
+	if err != nil {
+		s := status.Convert(err)
+		writeODataError(w, gwruntime.HTTPStatusFromCode(s.Code()), s.Message())
+		return
+	}
+
+	selected, err := odata.ParseSelect(response.Select)
+	if err != nil {
+		writeODataError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	collection := odataCollection{
+		Context: odataPath + "/$metadata#" + odata.EntitySet,
+		Value:   make([]map[string]interface{}, 0, len(response.Listings)),
+	}
+	if request.Count {
+		collection.Count = &response.Count
+	}
+	for i, listing := range response.Listings {
+		collection.Value = append(collection.Value, odata.Record(response.ListingKeys[i], listing, selected))
+	}
+	if response.Top > 0 && len(response.Listings) == int(response.Top) {
+		next := url.Values{}
+		for key, values := range query {
+			next[key] = values
+		}
+		next.Set("$top", strconv.Itoa(int(response.Top)))
+		next.Set("$skip", strconv.Itoa(int(request.Skip+response.Top)))
+		collection.NextLink = odataPath + "/" + odata.EntitySet + "?" + next.Encode()
+	}
+	writeODataJSON(w, collection)
+}
+
+func queryInt(query url.Values, name string) (int32, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("%s must be a non negative integer", name)
+	}
+	return int32(number), nil
+}
+
+// outgoingContext forwards the request headers the grpc gateway forwards, see httpHeaderMatcher.
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		if _, ok := httpHeaderMatcher(key); ok {
+			md.Append(strings.ToLower(key), values...)
+		}
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+func writeODataJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json;odata.metadata=minimal")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Errorf("Unable to write OData response: %v", err)
+	}
+}
+
+func writeODataError(w http.ResponseWriter, httpStatus int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	code := strconv.Itoa(httpStatus)
+	if httpStatus == http.StatusInternalServerError {
+		code = codes.Internal.String()
+	}
+	json.NewEncoder(w).Encode(odataError{Error: odataErrorDetail{Code: code, Message: message}})
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: realogy/api/mls/displayrules/v1/mls_display_rules.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MlsDisplayRulesServiceClient is the client API for MlsDisplayRulesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MlsDisplayRulesServiceClient interface {
+	// Get Mls Display Rules for a given mls source.
+	GetMlsDisplayRulesBySource(ctx context.Context, in *GetMlsDisplayRulesBySourceRequest, opts ...grpc.CallOption) (*GetMlsDisplayRulesBySourceResponse, error)
+	// Stream mls display rules events.
+	StreamMlsDisplayRulesEvent(ctx context.Context, in *StreamMlsDisplayRulesEventRequest, opts ...grpc.CallOption) (MlsDisplayRulesService_StreamMlsDisplayRulesEventClient, error)
+}
+
+type mlsDisplayRulesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMlsDisplayRulesServiceClient(cc grpc.ClientConnInterface) MlsDisplayRulesServiceClient {
+	return &mlsDisplayRulesServiceClient{cc}
+}
+
+func (c *mlsDisplayRulesServiceClient) GetMlsDisplayRulesBySource(ctx context.Context, in *GetMlsDisplayRulesBySourceRequest, opts ...grpc.CallOption) (*GetMlsDisplayRulesBySourceResponse, error) {
+	out := new(GetMlsDisplayRulesBySourceResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.displayrules.v1.MlsDisplayRulesService/GetMlsDisplayRulesBySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsDisplayRulesServiceClient) StreamMlsDisplayRulesEvent(ctx context.Context, in *StreamMlsDisplayRulesEventRequest, opts ...grpc.CallOption) (MlsDisplayRulesService_StreamMlsDisplayRulesEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MlsDisplayRulesService_ServiceDesc.Streams[0], "/realogy.api.mls.displayrules.v1.MlsDisplayRulesService/StreamMlsDisplayRulesEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mlsDisplayRulesServiceStreamMlsDisplayRulesEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MlsDisplayRulesService_StreamMlsDisplayRulesEventClient interface {
+	Recv() (*StreamMlsDisplayRulesEventResponse, error)
+	grpc.ClientStream
+}
+
+type mlsDisplayRulesServiceStreamMlsDisplayRulesEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *mlsDisplayRulesServiceStreamMlsDisplayRulesEventClient) Recv() (*StreamMlsDisplayRulesEventResponse, error) {
+	m := new(StreamMlsDisplayRulesEventResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MlsDisplayRulesServiceServer is the server API for MlsDisplayRulesService service.
+// All implementations must embed UnimplementedMlsDisplayRulesServiceServer
+// for forward compatibility
+type MlsDisplayRulesServiceServer interface {
+	// Get Mls Display Rules for a given mls source.
+	GetMlsDisplayRulesBySource(context.Context, *GetMlsDisplayRulesBySourceRequest) (*GetMlsDisplayRulesBySourceResponse, error)
+	// Stream mls display rules events.
+	StreamMlsDisplayRulesEvent(*StreamMlsDisplayRulesEventRequest, MlsDisplayRulesService_StreamMlsDisplayRulesEventServer) error
+	mustEmbedUnimplementedMlsDisplayRulesServiceServer()
+}
+
+// UnimplementedMlsDisplayRulesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMlsDisplayRulesServiceServer struct {
+}
+
+func (UnimplementedMlsDisplayRulesServiceServer) GetMlsDisplayRulesBySource(context.Context, *GetMlsDisplayRulesBySourceRequest) (*GetMlsDisplayRulesBySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMlsDisplayRulesBySource not implemented")
+}
+func (UnimplementedMlsDisplayRulesServiceServer) StreamMlsDisplayRulesEvent(*StreamMlsDisplayRulesEventRequest, MlsDisplayRulesService_StreamMlsDisplayRulesEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMlsDisplayRulesEvent not implemented")
+}
+func (UnimplementedMlsDisplayRulesServiceServer) mustEmbedUnimplementedMlsDisplayRulesServiceServer() {
+}
+
+// UnsafeMlsDisplayRulesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MlsDisplayRulesServiceServer will
+// result in compilation errors.
+type UnsafeMlsDisplayRulesServiceServer interface {
+	mustEmbedUnimplementedMlsDisplayRulesServiceServer()
+}
+
+func RegisterMlsDisplayRulesServiceServer(s grpc.ServiceRegistrar, srv MlsDisplayRulesServiceServer) {
+	s.RegisterService(&MlsDisplayRulesService_ServiceDesc, srv)
+}
+
+func _MlsDisplayRulesService_GetMlsDisplayRulesBySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMlsDisplayRulesBySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsDisplayRulesServiceServer).GetMlsDisplayRulesBySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.displayrules.v1.MlsDisplayRulesService/GetMlsDisplayRulesBySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsDisplayRulesServiceServer).GetMlsDisplayRulesBySource(ctx, req.(*GetMlsDisplayRulesBySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsDisplayRulesService_StreamMlsDisplayRulesEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMlsDisplayRulesEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MlsDisplayRulesServiceServer).StreamMlsDisplayRulesEvent(m, &mlsDisplayRulesServiceStreamMlsDisplayRulesEventServer{stream})
+}
+
+type MlsDisplayRulesService_StreamMlsDisplayRulesEventServer interface {
+	Send(*StreamMlsDisplayRulesEventResponse) error
+	grpc.ServerStream
+}
+
+type mlsDisplayRulesServiceStreamMlsDisplayRulesEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *mlsDisplayRulesServiceStreamMlsDisplayRulesEventServer) Send(m *StreamMlsDisplayRulesEventResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// MlsDisplayRulesService_ServiceDesc is the grpc.ServiceDesc for MlsDisplayRulesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MlsDisplayRulesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "realogy.api.mls.displayrules.v1.MlsDisplayRulesService",
+	HandlerType: (*MlsDisplayRulesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMlsDisplayRulesBySource",
+			Handler:    _MlsDisplayRulesService_GetMlsDisplayRulesBySource_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMlsDisplayRulesEvent",
+			Handler:       _MlsDisplayRulesService_StreamMlsDisplayRulesEvent_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "realogy/api/mls/displayrules/v1/mls_display_rules.proto",
+}
@@ -367,6 +367,40 @@ func local_request_MlsListingService_AddMlsListings_0(ctx context.Context, marsh
 
 }
 
+func request_MlsListingService_BulkUpsertMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq BulkUpsertMlsListingsRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.BulkUpsertMlsListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_BulkUpsertMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq BulkUpsertMlsListingsRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.BulkUpsertMlsListings(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 var (
 	filter_MlsListingService_GetMlsListingByListingGuid_0 = &utilities.DoubleArray{Encoding: map[string]int{"listing_guid": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
@@ -437,6 +471,10 @@ func local_request_MlsListingService_GetMlsListingByListingGuid_0(ctx context.Co
 
 }
 
+var (
+	filter_MlsListingService_GetMlsListingByListingGuid_1 = &utilities.DoubleArray{Encoding: map[string]int{"listing_guid": 0, "source_system_key": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+)
+
 func request_MlsListingService_GetMlsListingByListingGuid_1(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq GetMlsListingByListingGuidRequest
 	var metadata runtime.ServerMetadata
@@ -468,6 +506,13 @@ func request_MlsListingService_GetMlsListingByListingGuid_1(ctx context.Context,
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
 	}
 
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingByListingGuid_1); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	msg, err := client.GetMlsListingByListingGuid(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 
@@ -504,6 +549,13 @@ func local_request_MlsListingService_GetMlsListingByListingGuid_1(ctx context.Co
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
 	}
 
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingByListingGuid_1); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	msg, err := server.GetMlsListingByListingGuid(ctx, &protoReq)
 	return msg, metadata, err
 
@@ -1610,81 +1662,117 @@ func local_request_MlsListingService_GetMlsListingsBySubdivision_0(ctx context.C
 }
 
 var (
-	filter_MlsListingService_GetMlsListingsByCompanyMasterId_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_master_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_SearchMlsListingsByGeo_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
 )
 
-func request_MlsListingService_GetMlsListingsByCompanyMasterId_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyMasterIdRequest
+func request_MlsListingService_SearchMlsListingsByGeo_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsByGeoRequest
 	var metadata runtime.ServerMetadata
 
-	var (
-		val string
-		ok  bool
-		err error
-		_   = err
-	)
-
-	val, ok = pathParams["company_master_id"]
-	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_master_id")
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-
-	protoReq.CompanyMasterId, err = runtime.String(val)
-	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_master_id", err)
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListingsByGeo_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	msg, err := client.SearchMlsListingsByGeo(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_SearchMlsListingsByGeo_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsByGeoRequest
+	var metadata runtime.ServerMetadata
+
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyMasterId_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListingsByGeo_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := client.GetMlsListingsByCompanyMasterId(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := server.SearchMlsListingsByGeo(ctx, &protoReq)
 	return msg, metadata, err
 
 }
 
-func local_request_MlsListingService_GetMlsListingsByCompanyMasterId_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyMasterIdRequest
+func request_MlsListingService_SearchMlsListingsByGeo_1(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsByGeoRequest
 	var metadata runtime.ServerMetadata
 
-	var (
-		val string
-		ok  bool
-		err error
-		_   = err
-	)
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
-	val, ok = pathParams["company_master_id"]
-	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_master_id")
+	msg, err := client.SearchMlsListingsByGeo(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_SearchMlsListingsByGeo_1(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsByGeoRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	protoReq.CompanyMasterId, err = runtime.String(val)
-	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_master_id", err)
+	msg, err := server.SearchMlsListingsByGeo(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_GetUpcomingOpenHouses_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_MlsListingService_GetUpcomingOpenHouses_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetUpcomingOpenHousesRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetUpcomingOpenHouses_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	msg, err := client.GetUpcomingOpenHouses(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetUpcomingOpenHouses_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetUpcomingOpenHousesRequest
+	var metadata runtime.ServerMetadata
+
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyMasterId_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetUpcomingOpenHouses_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := server.GetMlsListingsByCompanyMasterId(ctx, &protoReq)
+	msg, err := server.GetUpcomingOpenHouses(ctx, &protoReq)
 	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_GetMlsListingsByCompanyStaffId_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_staff_master_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_ListAgents_0 = &utilities.DoubleArray{Encoding: map[string]int{"source_system_key": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyStaffIdRequest
+func request_MlsListingService_ListAgents_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListAgentsRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1694,30 +1782,30 @@ func request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx context.Cont
 		_   = err
 	)
 
-	val, ok = pathParams["company_staff_master_id"]
+	val, ok = pathParams["source_system_key"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_master_id")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
 	}
 
-	protoReq.CompanyStaffMasterId, err = runtime.String(val)
+	protoReq.SourceSystemKey, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_master_id", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffId_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_ListAgents_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := client.GetMlsListingsByCompanyStaffId(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := client.ListAgents(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 
 }
 
-func local_request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyStaffIdRequest
+func local_request_MlsListingService_ListAgents_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListAgentsRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1727,34 +1815,34 @@ func local_request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx contex
 		_   = err
 	)
 
-	val, ok = pathParams["company_staff_master_id"]
+	val, ok = pathParams["source_system_key"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_master_id")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
 	}
 
-	protoReq.CompanyStaffMasterId, err = runtime.String(val)
+	protoReq.SourceSystemKey, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_master_id", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffId_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_ListAgents_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := server.GetMlsListingsByCompanyStaffId(ctx, &protoReq)
+	msg, err := server.ListAgents(ctx, &protoReq)
 	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_staff_guid": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_GetAgent_0 = &utilities.DoubleArray{Encoding: map[string]int{"source_system_key": 0, "mls_id": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
 )
 
-func request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyStaffGuidRequest
+func request_MlsListingService_GetAgent_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAgentRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1764,30 +1852,40 @@ func request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx context.Co
 		_   = err
 	)
 
-	val, ok = pathParams["company_staff_guid"]
+	val, ok = pathParams["source_system_key"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_guid")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
 	}
 
-	protoReq.CompanyStaffGuid, err = runtime.String(val)
+	protoReq.SourceSystemKey, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_guid", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	val, ok = pathParams["mls_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "mls_id")
+	}
+
+	protoReq.MlsId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "mls_id", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetAgent_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := client.GetMlsListingsByCompanyStaffGuid(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := client.GetAgent(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 
 }
 
-func local_request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCompanyStaffGuidRequest
+func local_request_MlsListingService_GetAgent_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAgentRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1797,70 +1895,114 @@ func local_request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx cont
 		_   = err
 	)
 
-	val, ok = pathParams["company_staff_guid"]
+	val, ok = pathParams["source_system_key"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_guid")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
 	}
 
-	protoReq.CompanyStaffGuid, err = runtime.String(val)
+	protoReq.SourceSystemKey, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_guid", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	val, ok = pathParams["mls_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "mls_id")
+	}
+
+	protoReq.MlsId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "mls_id", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetAgent_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := server.GetMlsListingsByCompanyStaffGuid(ctx, &protoReq)
+	msg, err := server.GetAgent(ctx, &protoReq)
 	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_GetMlsSoldListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+	filter_MlsListingService_GetAgent_1 = &utilities.DoubleArray{Encoding: map[string]int{"master_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_GetMlsSoldListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsSoldListingsRequest
+func request_MlsListingService_GetAgent_1(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAgentRequest
 	var metadata runtime.ServerMetadata
 
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["master_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "master_id")
+	}
+
+	protoReq.MasterId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "master_id", err)
+	}
+
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsSoldListings_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetAgent_1); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := client.GetMlsSoldListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := client.GetAgent(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 
 }
 
-func local_request_MlsListingService_GetMlsSoldListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq GetMlsSoldListingsRequest
+func local_request_MlsListingService_GetAgent_1(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAgentRequest
 	var metadata runtime.ServerMetadata
 
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["master_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "master_id")
+	}
+
+	protoReq.MasterId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "master_id", err)
+	}
+
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsSoldListings_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetAgent_1); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := server.GetMlsSoldListings(ctx, &protoReq)
+	msg, err := server.GetAgent(ctx, &protoReq)
 	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_StreamMlsListingBySource_0 = &utilities.DoubleArray{Encoding: map[string]int{"source_system_key": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_ListOffices_0 = &utilities.DoubleArray{Encoding: map[string]int{"source_system_key": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_StreamMlsListingBySource_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingBySourceClient, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsBySourceRequest
+func request_MlsListingService_ListOffices_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListOfficesRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1883,29 +2025,17 @@ func request_MlsListingService_StreamMlsListingBySource_0(ctx context.Context, m
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingBySource_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_ListOffices_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingBySource(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
-	}
-	header, err := stream.Header()
-	if err != nil {
-		return nil, metadata, err
-	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+	msg, err := client.ListOffices(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
 
 }
 
-var (
-	filter_MlsListingService_StreamMlsListingByCity_0 = &utilities.DoubleArray{Encoding: map[string]int{"city": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
-)
-
-func request_MlsListingService_StreamMlsListingByCity_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByCityClient, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCityRequest
+func local_request_MlsListingService_ListOffices_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListOfficesRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1915,42 +2045,34 @@ func request_MlsListingService_StreamMlsListingByCity_0(ctx context.Context, mar
 		_   = err
 	)
 
-	val, ok = pathParams["city"]
+	val, ok = pathParams["source_system_key"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "city")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
 	}
 
-	protoReq.City, err = runtime.String(val)
+	protoReq.SourceSystemKey, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "city", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByCity_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_ListOffices_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingByCity(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
-	}
-	header, err := stream.Header()
-	if err != nil {
-		return nil, metadata, err
-	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+	msg, err := server.ListOffices(ctx, &protoReq)
+	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_StreamMlsListingByCity_1 = &utilities.DoubleArray{Encoding: map[string]int{"city": 0, "state": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+	filter_MlsListingService_GetMlsListingsByCompanyMasterId_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_master_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_StreamMlsListingByCity_1(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByCityClient, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByCityRequest
+func request_MlsListingService_GetMlsListingsByCompanyMasterId_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyMasterIdRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -1960,52 +2082,67 @@ func request_MlsListingService_StreamMlsListingByCity_1(ctx context.Context, mar
 		_   = err
 	)
 
-	val, ok = pathParams["city"]
+	val, ok = pathParams["company_master_id"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "city")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_master_id")
 	}
 
-	protoReq.City, err = runtime.String(val)
+	protoReq.CompanyMasterId, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "city", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_master_id", err)
 	}
 
-	val, ok = pathParams["state"]
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyMasterId_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.GetMlsListingsByCompanyMasterId(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetMlsListingsByCompanyMasterId_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyMasterIdRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["company_master_id"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "state")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_master_id")
 	}
 
-	protoReq.State, err = runtime.String(val)
+	protoReq.CompanyMasterId, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "state", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_master_id", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByCity_1); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyMasterId_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingByCity(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
-	}
-	header, err := stream.Header()
-	if err != nil {
-		return nil, metadata, err
-	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+	msg, err := server.GetMlsListingsByCompanyMasterId(ctx, &protoReq)
+	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_StreamMlsListingByState_0 = &utilities.DoubleArray{Encoding: map[string]int{"state": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_GetMlsListingsByCompanyStaffId_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_staff_master_id": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_StreamMlsListingByState_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByStateClient, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByStateRequest
+func request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyStaffIdRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -2015,42 +2152,67 @@ func request_MlsListingService_StreamMlsListingByState_0(ctx context.Context, ma
 		_   = err
 	)
 
-	val, ok = pathParams["state"]
+	val, ok = pathParams["company_staff_master_id"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "state")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_master_id")
 	}
 
-	protoReq.State, err = runtime.String(val)
+	protoReq.CompanyStaffMasterId, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "state", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_master_id", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByState_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffId_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingByState(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
+	msg, err := client.GetMlsListingsByCompanyStaffId(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetMlsListingsByCompanyStaffId_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyStaffIdRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["company_staff_master_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_master_id")
 	}
-	header, err := stream.Header()
+
+	protoReq.CompanyStaffMasterId, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, err
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_master_id", err)
 	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffId_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.GetMlsListingsByCompanyStaffId(ctx, &protoReq)
+	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_StreamMlsListingByPostalCode_0 = &utilities.DoubleArray{Encoding: map[string]int{"postal_code": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+	filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0 = &utilities.DoubleArray{Encoding: map[string]int{"company_staff_guid": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
 )
 
-func request_MlsListingService_StreamMlsListingByPostalCode_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByPostalCodeClient, runtime.ServerMetadata, error) {
-	var protoReq GetMlsListingsByPostalCodeRequest
+func request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyStaffGuidRequest
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -2060,161 +2222,2255 @@ func request_MlsListingService_StreamMlsListingByPostalCode_0(ctx context.Contex
 		_   = err
 	)
 
-	val, ok = pathParams["postal_code"]
+	val, ok = pathParams["company_staff_guid"]
 	if !ok {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "postal_code")
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_guid")
 	}
 
-	protoReq.PostalCode, err = runtime.String(val)
+	protoReq.CompanyStaffGuid, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "postal_code", err)
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_guid", err)
 	}
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByPostalCode_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingByPostalCode(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
+	msg, err := client.GetMlsListingsByCompanyStaffGuid(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCompanyStaffGuidRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["company_staff_guid"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "company_staff_guid")
 	}
-	header, err := stream.Header()
+
+	protoReq.CompanyStaffGuid, err = runtime.String(val)
 	if err != nil {
-		return nil, metadata, err
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "company_staff_guid", err)
 	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsListingsByCompanyStaffGuid_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.GetMlsListingsByCompanyStaffGuid(ctx, &protoReq)
+	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_StreamMlsListingEvent_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+	filter_MlsListingService_GetMlsSoldListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
 )
 
-func request_MlsListingService_StreamMlsListingEvent_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingEventClient, runtime.ServerMetadata, error) {
-	var protoReq StreamMlsListingEventRequest
+func request_MlsListingService_GetMlsSoldListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsSoldListingsRequest
 	var metadata runtime.ServerMetadata
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingEvent_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsSoldListings_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	stream, err := client.StreamMlsListingEvent(ctx, &protoReq)
-	if err != nil {
-		return nil, metadata, err
+	msg, err := client.GetMlsSoldListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetMlsSoldListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsSoldListingsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	header, err := stream.Header()
-	if err != nil {
-		return nil, metadata, err
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetMlsSoldListings_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	metadata.HeaderMD = header
-	return stream, metadata, nil
+
+	msg, err := server.GetMlsSoldListings(ctx, &protoReq)
+	return msg, metadata, err
 
 }
 
 var (
-	filter_MlsListingService_SearchMlsListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+	filter_MlsListingService_GetComparableSales_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
 )
 
-func request_MlsListingService_SearchMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq SearchMlsListingsRequest
+func request_MlsListingService_GetComparableSales_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetComparableSalesRequest
 	var metadata runtime.ServerMetadata
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListings_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetComparableSales_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := client.SearchMlsListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	msg, err := client.GetComparableSales(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 
 }
 
-func local_request_MlsListingService_SearchMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq SearchMlsListingsRequest
+func local_request_MlsListingService_GetComparableSales_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetComparableSalesRequest
 	var metadata runtime.ServerMetadata
 
 	if err := req.ParseForm(); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListings_0); err != nil {
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetComparableSales_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	msg, err := server.SearchMlsListings(ctx, &protoReq)
+	msg, err := server.GetComparableSales(ctx, &protoReq)
 	return msg, metadata, err
 
-}
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingBySource_0 = &utilities.DoubleArray{Encoding: map[string]int{"source_system_key": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
+func request_MlsListingService_StreamMlsListingBySource_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingBySourceClient, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsBySourceRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingBySource_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingBySource(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingByCity_0 = &utilities.DoubleArray{Encoding: map[string]int{"city": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
+func request_MlsListingService_StreamMlsListingByCity_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByCityClient, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCityRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["city"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "city")
+	}
+
+	protoReq.City, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "city", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByCity_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingByCity(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingByCity_1 = &utilities.DoubleArray{Encoding: map[string]int{"city": 0, "state": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+)
+
+func request_MlsListingService_StreamMlsListingByCity_1(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByCityClient, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByCityRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["city"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "city")
+	}
+
+	protoReq.City, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "city", err)
+	}
+
+	val, ok = pathParams["state"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "state")
+	}
+
+	protoReq.State, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "state", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByCity_1); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingByCity(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingByState_0 = &utilities.DoubleArray{Encoding: map[string]int{"state": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
+func request_MlsListingService_StreamMlsListingByState_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByStateClient, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByStateRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["state"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "state")
+	}
+
+	protoReq.State, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "state", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByState_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingByState(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingByPostalCode_0 = &utilities.DoubleArray{Encoding: map[string]int{"postal_code": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
+func request_MlsListingService_StreamMlsListingByPostalCode_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingByPostalCodeClient, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingsByPostalCodeRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["postal_code"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "postal_code")
+	}
+
+	protoReq.PostalCode, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "postal_code", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingByPostalCode_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingByPostalCode(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+var (
+	filter_MlsListingService_StreamMlsListingEvent_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_MlsListingService_StreamMlsListingEvent_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (MlsListingService_StreamMlsListingEventClient, runtime.ServerMetadata, error) {
+	var protoReq StreamMlsListingEventRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_StreamMlsListingEvent_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamMlsListingEvent(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
+func request_MlsListingService_AckMlsListingEvent_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq AckMlsListingEventRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.AckMlsListingEvent(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_AckMlsListingEvent_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq AckMlsListingEventRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.AckMlsListingEvent(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_SearchMlsListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_MlsListingService_SearchMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListings_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.SearchMlsListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_SearchMlsListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SearchMlsListingsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_SearchMlsListings_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.SearchMlsListings(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_GetRealogyListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_MlsListingService_GetRealogyListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RealogyListingsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetRealogyListings_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.GetRealogyListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetRealogyListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RealogyListingsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetRealogyListings_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.GetRealogyListings(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_GetMlsListingHistory_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingHistoryRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	msg, err := client.GetMlsListingHistory(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetMlsListingHistory_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetMlsListingHistoryRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	msg, err := server.GetMlsListingHistory(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_GetListingDuplicates_0 = &utilities.DoubleArray{Encoding: map[string]int{"listing_id": 0, "source_system_key": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+)
+
+func request_MlsListingService_GetListingDuplicates_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetListingDuplicatesRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetListingDuplicates_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.GetListingDuplicates(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetListingDuplicates_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetListingDuplicatesRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetListingDuplicates_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.GetListingDuplicates(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_GetStatusLifecycle_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetStatusLifecycleRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := client.GetStatusLifecycle(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_GetStatusLifecycle_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetStatusLifecycleRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := server.GetStatusLifecycle(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_CreateMlsListingWebhook_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq CreateMlsListingWebhookRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.CreateMlsListingWebhook(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_CreateMlsListingWebhook_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq CreateMlsListingWebhookRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.CreateMlsListingWebhook(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_ListMlsListingWebhooks_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListMlsListingWebhooksRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := client.ListMlsListingWebhooks(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_ListMlsListingWebhooks_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListMlsListingWebhooksRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := server.ListMlsListingWebhooks(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_PatchMlsListing_0 = &utilities.DoubleArray{Encoding: map[string]int{"mls_listing": 0, "listing_id": 1, "source_system_key": 2}, Base: []int{1, 1, 2, 3, 0, 0, 0}, Check: []int{0, 1, 1, 1, 2, 3, 4}}
+)
+
+func request_MlsListingService_PatchMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq PatchMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.MlsListing); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if protoReq.UpdateMask == nil || len(protoReq.UpdateMask.GetPaths()) == 0 {
+		if fieldMask, err := runtime.FieldMaskFromRequestBody(newReader(), protoReq.MlsListing); err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+		} else {
+			protoReq.UpdateMask = fieldMask
+		}
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_PatchMlsListing_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.PatchMlsListing(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_PatchMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq PatchMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.MlsListing); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if protoReq.UpdateMask == nil || len(protoReq.UpdateMask.GetPaths()) == 0 {
+		if fieldMask, err := runtime.FieldMaskFromRequestBody(newReader(), protoReq.MlsListing); err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+		} else {
+			protoReq.UpdateMask = fieldMask
+		}
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_PatchMlsListing_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.PatchMlsListing(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+var (
+	filter_MlsListingService_DeleteMlsListing_0 = &utilities.DoubleArray{Encoding: map[string]int{"listing_id": 0, "source_system_key": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+)
+
+func request_MlsListingService_DeleteMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq DeleteMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_DeleteMlsListing_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.DeleteMlsListing(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_DeleteMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq DeleteMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_DeleteMlsListing_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.DeleteMlsListing(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_RestoreMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RestoreMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	msg, err := client.RestoreMlsListing(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_RestoreMlsListing_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RestoreMlsListingRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["listing_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "listing_id")
+	}
+
+	protoReq.ListingId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "listing_id", err)
+	}
+
+	val, ok = pathParams["source_system_key"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "source_system_key")
+	}
+
+	protoReq.SourceSystemKey, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "source_system_key", err)
+	}
+
+	msg, err := server.RestoreMlsListing(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_DeleteMlsListingWebhook_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq DeleteMlsListingWebhookRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := client.DeleteMlsListingWebhook(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_DeleteMlsListingWebhook_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq DeleteMlsListingWebhookRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := server.DeleteMlsListingWebhook(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_ListMlsListingWebhookDeadLetters_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListMlsListingWebhookDeadLettersRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := client.ListMlsListingWebhookDeadLetters(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_ListMlsListingWebhookDeadLetters_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListMlsListingWebhookDeadLettersRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := server.ListMlsListingWebhookDeadLetters(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ReplayMlsListingWebhookDeadLettersRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := client.ReplayMlsListingWebhookDeadLetters(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ReplayMlsListingWebhookDeadLettersRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["webhook_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "webhook_id")
+	}
+
+	protoReq.WebhookId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "webhook_id", err)
+	}
+
+	msg, err := server.ReplayMlsListingWebhookDeadLetters(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_MlsListingService_HealthCheck_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq HealthRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := client.HealthCheck(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_MlsListingService_HealthCheck_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq HealthRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := server.HealthCheck(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+// RegisterMlsListingServiceHandlerServer registers the http handlers for service MlsListingService to "mux".
+// UnaryRPC     :call MlsListingServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterMlsListingServiceHandlerFromEndpoint instead.
+func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server MlsListingServiceServer) error {
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingByListingId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingByListingId_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingByListingId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("PUT", pattern_MlsListingService_UpdateMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/UpdateMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("POST", pattern_MlsListingService_AddMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AddMlsListings", runtime.WithHTTPPathPattern("/mls/rdm-source/{rdm_source_system_key}/listing/{listing_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_AddMlsListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_AddMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("POST", pattern_MlsListingService_BulkUpsertMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/BulkUpsertMlsListings", runtime.WithHTTPPathPattern("/mls/listings/bulk"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_BulkUpsertMlsListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_BulkUpsertMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}/source/{source_system_key}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingBySource_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}/dash/agent/{listing_agent_guid}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingBySource_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingBySource_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByCity_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByCity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}/state/{state}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByCity_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByCity_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByState", runtime.WithHTTPPathPattern("/mls/state/{state}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByState_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByState_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByPostalCode", runtime.WithHTTPPathPattern("/mls/postalcode/{postal_code}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}/source/{source_system_key}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentMasterId", runtime.WithHTTPPathPattern("/mls/agent/master/{list_agent_master_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByOfficeMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByOfficeMasterId", runtime.WithHTTPPathPattern("/mls/office/master/{list_office_master_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentGuid", runtime.WithHTTPPathPattern("/mls/dash/agent/{listing_agent_guid}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}/city/{city}/state/{state}/postalcode/{postal_code}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsBySubdivision_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsBySubdivision", runtime.WithHTTPPathPattern("/mls/subdivision/{subdivision_name}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_SearchMlsListingsByGeo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo", runtime.WithHTTPPathPattern("/mls/listings/geo"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_SearchMlsListingsByGeo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_SearchMlsListingsByGeo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("POST", pattern_MlsListingService_SearchMlsListingsByGeo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo", runtime.WithHTTPPathPattern("/mls/listings/geo"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_SearchMlsListingsByGeo_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_SearchMlsListingsByGeo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetUpcomingOpenHouses_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetUpcomingOpenHouses", runtime.WithHTTPPathPattern("/mls/openhouses"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetUpcomingOpenHouses_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetUpcomingOpenHouses_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_ListAgents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListAgents", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/agents"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_ListAgents_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_ListAgents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetAgent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetAgent", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/agents/{mls_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetAgent_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetAgent_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetAgent_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetAgent", runtime.WithHTTPPathPattern("/mls/roster/agents/master/{master_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetAgent_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetAgent_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_ListOffices_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListOffices", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/offices"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_ListOffices_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_ListOffices_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyMasterId", runtime.WithHTTPPathPattern("/mls/company/master/{company_master_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffId", runtime.WithHTTPPathPattern("/mls/staff/{company_staff_master_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffGuid", runtime.WithHTTPPathPattern("/mls/dash/staff/{company_staff_guid}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsSoldListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsSoldListings", runtime.WithHTTPPathPattern("/mls/listings/sold"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsSoldListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsSoldListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetComparableSales_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetComparableSales", runtime.WithHTTPPathPattern("/mls/listings/sold/comparables"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetComparableSales_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetComparableSales_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	mux.Handle("POST", pattern_MlsListingService_AckMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AckMlsListingEvent", runtime.WithHTTPPathPattern("/mls/changes/ack"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_AckMlsListingEvent_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_AckMlsListingEvent_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_SearchMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListings", runtime.WithHTTPPathPattern("/mls/listings/search"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_SearchMlsListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_SearchMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-var (
-	filter_MlsListingService_GetRealogyListings_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
-)
+	})
 
-func request_MlsListingService_GetRealogyListings_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RealogyListingsRequest
-	var metadata runtime.ServerMetadata
+	mux.Handle("GET", pattern_MlsListingService_GetRealogyListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetRealogyListings", runtime.WithHTTPPathPattern("/mls/listings/realogy"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetRealogyListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 
-	if err := req.ParseForm(); err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
-	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetRealogyListings_0); err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
-	}
+		forward_MlsListingService_GetRealogyListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-	msg, err := client.GetRealogyListings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
-	return msg, metadata, err
+	})
 
-}
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingHistory", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/history"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetMlsListingHistory_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 
-func local_request_MlsListingService_GetRealogyListings_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RealogyListingsRequest
-	var metadata runtime.ServerMetadata
+		forward_MlsListingService_GetMlsListingHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-	if err := req.ParseForm(); err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
-	}
-	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_MlsListingService_GetRealogyListings_0); err != nil {
-		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
-	}
+	})
 
-	msg, err := server.GetRealogyListings(ctx, &protoReq)
-	return msg, metadata, err
+	mux.Handle("GET", pattern_MlsListingService_GetListingDuplicates_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetListingDuplicates", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/duplicates"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetListingDuplicates_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 
-}
+		forward_MlsListingService_GetListingDuplicates_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-func request_MlsListingService_HealthCheck_0(ctx context.Context, marshaler runtime.Marshaler, client MlsListingServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq HealthRequest
-	var metadata runtime.ServerMetadata
+	})
 
-	msg, err := client.HealthCheck(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
-	return msg, metadata, err
+	mux.Handle("GET", pattern_MlsListingService_GetStatusLifecycle_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetStatusLifecycle", runtime.WithHTTPPathPattern("/mls/listings/status/lifecycle"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_GetStatusLifecycle_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 
-}
+		forward_MlsListingService_GetStatusLifecycle_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-func local_request_MlsListingService_HealthCheck_0(ctx context.Context, marshaler runtime.Marshaler, server MlsListingServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq HealthRequest
-	var metadata runtime.ServerMetadata
+	})
 
-	msg, err := server.HealthCheck(ctx, &protoReq)
-	return msg, metadata, err
+	mux.Handle("POST", pattern_MlsListingService_CreateMlsListingWebhook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/CreateMlsListingWebhook", runtime.WithHTTPPathPattern("/mls/webhooks"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_MlsListingService_CreateMlsListingWebhook_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 
-}
+		forward_MlsListingService_CreateMlsListingWebhook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-// RegisterMlsListingServiceHandlerServer registers the http handlers for service MlsListingService to "mux".
-// UnaryRPC     :call MlsListingServiceServer directly.
-// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
-// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterMlsListingServiceHandlerFromEndpoint instead.
-func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server MlsListingServiceServer) error {
+	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListMlsListingWebhooks_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2222,12 +4478,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhooks", runtime.WithHTTPPathPattern("/mls/webhooks"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingByListingId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_ListMlsListingWebhooks_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2235,11 +4491,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListMlsListingWebhooks_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("PATCH", pattern_MlsListingService_PatchMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2247,12 +4503,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/PatchMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingByListingId_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_PatchMlsListing_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2260,11 +4516,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_PatchMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("PUT", pattern_MlsListingService_UpdateMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("DELETE", pattern_MlsListingService_DeleteMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2272,12 +4528,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/UpdateMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_DeleteMlsListing_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2285,11 +4541,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_DeleteMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("POST", pattern_MlsListingService_AddMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_RestoreMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2297,12 +4553,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AddMlsListings", runtime.WithHTTPPathPattern("/mls/rdm-source/{rdm_source_system_key}/listing/{listing_id}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/RestoreMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/restore"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_AddMlsListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_RestoreMlsListing_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2310,11 +4566,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_AddMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_RestoreMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("DELETE", pattern_MlsListingService_DeleteMlsListingWebhook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2322,12 +4578,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListingWebhook", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_DeleteMlsListingWebhook_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2335,11 +4591,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_DeleteMlsListingWebhook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListMlsListingWebhookDeadLetters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2347,12 +4603,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhookDeadLetters", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}/dead-letters"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_ListMlsListingWebhookDeadLetters_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2360,11 +4616,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListMlsListingWebhookDeadLetters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_ReplayMlsListingWebhookDeadLetters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2372,12 +4628,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ReplayMlsListingWebhookDeadLetters", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}/replay"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingBySource_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2385,11 +4641,11 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_HealthCheck_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
@@ -2397,12 +4653,12 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}/dash/agent/{listing_agent_guid}"))
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/HealthCheck", runtime.WithHTTPPathPattern("/internal/health"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingBySource_1(annotatedContext, inboundMarshaler, server, req, pathParams)
+		resp, md, err := local_request_MlsListingService_HealthCheck_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
@@ -2410,1269 +4666,1214 @@ func RegisterMlsListingServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingBySource_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_HealthCheck_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	return nil
+}
+
+// RegisterMlsListingServiceHandlerFromEndpoint is same as RegisterMlsListingServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterMlsListingServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterMlsListingServiceHandler(ctx, mux, conn)
+}
+
+// RegisterMlsListingServiceHandler registers the http handlers for service MlsListingService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterMlsListingServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterMlsListingServiceHandlerClient(ctx, mux, NewMlsListingServiceClient(conn))
+}
+
+// RegisterMlsListingServiceHandlerClient registers the http handlers for service MlsListingService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "MlsListingServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "MlsListingServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "MlsListingServiceClient" to call the correct interceptors.
+func RegisterMlsListingServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client MlsListingServiceClient) error {
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_MlsListingService_GetMlsListingByListingId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_MlsListingService_GetMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByCity_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingByListingId_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingByListingId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("PUT", pattern_MlsListingService_UpdateMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/UpdateMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByCity_1(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCity_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_AddMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByState", runtime.WithHTTPPathPattern("/mls/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AddMlsListings", runtime.WithHTTPPathPattern("/mls/rdm-source/{rdm_source_system_key}/listing/{listing_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByState_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_AddMlsListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByState_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_AddMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_BulkUpsertMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByPostalCode", runtime.WithHTTPPathPattern("/mls/postalcode/{postal_code}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/BulkUpsertMlsListings", runtime.WithHTTPPathPattern("/mls/listings/bulk"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_BulkUpsertMlsListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_BulkUpsertMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentMasterId", runtime.WithHTTPPathPattern("/mls/agent/master/{list_agent_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingBySource_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByOfficeMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByOfficeMasterId", runtime.WithHTTPPathPattern("/mls/office/master/{list_office_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}/dash/agent/{listing_agent_guid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingBySource_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingBySource_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentGuid", runtime.WithHTTPPathPattern("/mls/dash/agent/{listing_agent_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByCity_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByCity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}/state/{state}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByCity_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByCity_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}/city/{city}/state/{state}/postalcode/{postal_code}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByState", runtime.WithHTTPPathPattern("/mls/state/{state}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByState_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByState_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsBySubdivision_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsBySubdivision", runtime.WithHTTPPathPattern("/mls/subdivision/{subdivision_name}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByPostalCode", runtime.WithHTTPPathPattern("/mls/postalcode/{postal_code}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyMasterId", runtime.WithHTTPPathPattern("/mls/company/master/{company_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffId", runtime.WithHTTPPathPattern("/mls/staff/{company_staff_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffGuid", runtime.WithHTTPPathPattern("/mls/dash/staff/{company_staff_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentMasterId", runtime.WithHTTPPathPattern("/mls/agent/master/{list_agent_master_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsSoldListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByOfficeMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsSoldListings", runtime.WithHTTPPathPattern("/mls/listings/sold"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByOfficeMasterId", runtime.WithHTTPPathPattern("/mls/office/master/{list_office_master_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetMlsSoldListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsSoldListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-
-	})
-
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
-	})
-
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
-	})
-
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
-	})
-
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
-	})
-
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
-	})
+		forward_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
-		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
-		return
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_SearchMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListings", runtime.WithHTTPPathPattern("/mls/listings/search"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentGuid", runtime.WithHTTPPathPattern("/mls/dash/agent/{listing_agent_guid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_SearchMlsListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_SearchMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetRealogyListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetRealogyListings", runtime.WithHTTPPathPattern("/mls/listings/realogy"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_GetRealogyListings_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetRealogyListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_HealthCheck_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
-		var stream runtime.ServerTransportStream
-		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/HealthCheck", runtime.WithHTTPPathPattern("/internal/health"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}/city/{city}/state/{state}/postalcode/{postal_code}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := local_request_MlsListingService_HealthCheck_0(annotatedContext, inboundMarshaler, server, req, pathParams)
-		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		resp, md, err := request_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_HealthCheck_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	return nil
-}
-
-// RegisterMlsListingServiceHandlerFromEndpoint is same as RegisterMlsListingServiceHandler but
-// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
-func RegisterMlsListingServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
-	conn, err := grpc.Dial(endpoint, opts...)
-	if err != nil {
-		return err
-	}
-	defer func() {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsBySubdivision_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsBySubdivision", runtime.WithHTTPPathPattern("/mls/subdivision/{subdivision_name}"))
 		if err != nil {
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
-			}
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		go func() {
-			<-ctx.Done()
-			if cerr := conn.Close(); cerr != nil {
-				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
-			}
-		}()
-	}()
-
-	return RegisterMlsListingServiceHandler(ctx, mux, conn)
-}
 
-// RegisterMlsListingServiceHandler registers the http handlers for service MlsListingService to "mux".
-// The handlers forward requests to the grpc endpoint over "conn".
-func RegisterMlsListingServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-	return RegisterMlsListingServiceHandlerClient(ctx, mux, NewMlsListingServiceClient(conn))
-}
+		forward_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
-// RegisterMlsListingServiceHandlerClient registers the http handlers for service MlsListingService
-// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "MlsListingServiceClient".
-// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "MlsListingServiceClient"
-// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
-// "MlsListingServiceClient" to call the correct interceptors.
-func RegisterMlsListingServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client MlsListingServiceClient) error {
+	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_SearchMlsListingsByGeo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo", runtime.WithHTTPPathPattern("/mls/listings/geo"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingByListingId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_SearchMlsListingsByGeo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_SearchMlsListingsByGeo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_SearchMlsListingsByGeo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo", runtime.WithHTTPPathPattern("/mls/listings/geo"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingByListingId_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_SearchMlsListingsByGeo_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_SearchMlsListingsByGeo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("PUT", pattern_MlsListingService_UpdateMlsListingByListingId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetUpcomingOpenHouses_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/UpdateMlsListingByListingId", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetUpcomingOpenHouses", runtime.WithHTTPPathPattern("/mls/openhouses"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetUpcomingOpenHouses_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_UpdateMlsListingByListingId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetUpcomingOpenHouses_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("POST", pattern_MlsListingService_AddMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListAgents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AddMlsListings", runtime.WithHTTPPathPattern("/mls/rdm-source/{rdm_source_system_key}/listing/{listing_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListAgents", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/agents"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_AddMlsListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_ListAgents_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_AddMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListAgents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetAgent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetAgent", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/agents/{mls_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetAgent_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetAgent_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingByListingGuid_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetAgent_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", runtime.WithHTTPPathPattern("/mls/dash/listing/{listing_guid}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetAgent", runtime.WithHTTPPathPattern("/mls/roster/agents/master/{master_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetAgent_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingByListingGuid_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetAgent_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListOffices_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListOffices", runtime.WithHTTPPathPattern("/mls/roster/source/{source_system_key}/offices"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingBySource_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_ListOffices_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListOffices_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingBySource_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingBySource", runtime.WithHTTPPathPattern("/mls/source/{source_system_key}/dash/agent/{listing_agent_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyMasterId", runtime.WithHTTPPathPattern("/mls/company/master/{company_master_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingBySource_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingBySource_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffId", runtime.WithHTTPPathPattern("/mls/staff/{company_staff_master_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByCity_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCity", runtime.WithHTTPPathPattern("/mls/city/{city}/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffGuid", runtime.WithHTTPPathPattern("/mls/dash/staff/{company_staff_guid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByCity_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCity_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsSoldListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByState", runtime.WithHTTPPathPattern("/mls/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsSoldListings", runtime.WithHTTPPathPattern("/mls/listings/sold"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByState_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetMlsSoldListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByState_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsSoldListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetComparableSales_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByPostalCode", runtime.WithHTTPPathPattern("/mls/postalcode/{postal_code}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetComparableSales", runtime.WithHTTPPathPattern("/mls/listings/sold/comparables"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetComparableSales_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetComparableSales_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingBySource", runtime.WithHTTPPathPattern("/mls/stream/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingBySource_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentId_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentId", runtime.WithHTTPPathPattern("/mls/agent/{list_agent_mls_id}/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByCity", runtime.WithHTTPPathPattern("/mls/stream/city/{city}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingByCity_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentId_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingByCity_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentMasterId", runtime.WithHTTPPathPattern("/mls/agent/master/{list_agent_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByCity", runtime.WithHTTPPathPattern("/mls/stream/city/{city}/state/{state}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingByCity_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingByCity_1(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByOfficeMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByOfficeMasterId", runtime.WithHTTPPathPattern("/mls/office/master/{list_office_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByState", runtime.WithHTTPPathPattern("/mls/stream/state/{state}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingByState_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByOfficeMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingByState_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAgentGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAgentGuid", runtime.WithHTTPPathPattern("/mls/dash/agent/{listing_agent_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByPostalCode", runtime.WithHTTPPathPattern("/mls/stream/postalcode/{postal_code}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingByPostalCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAgentGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingEvent", runtime.WithHTTPPathPattern("/mls/changes"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_StreamMlsListingEvent_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAddress_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_StreamMlsListingEvent_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByAddress_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_AckMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByAddress", runtime.WithHTTPPathPattern("/mls/address/{unparsed_address}/city/{city}/state/{state}/postalcode/{postal_code}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/AckMlsListingEvent", runtime.WithHTTPPathPattern("/mls/changes/ack"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_AckMlsListingEvent_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByAddress_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_AckMlsListingEvent_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsBySubdivision_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_SearchMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsBySubdivision", runtime.WithHTTPPathPattern("/mls/subdivision/{subdivision_name}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListings", runtime.WithHTTPPathPattern("/mls/listings/search"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_SearchMlsListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsBySubdivision_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_SearchMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyMasterId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetRealogyListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyMasterId", runtime.WithHTTPPathPattern("/mls/company/master/{company_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetRealogyListings", runtime.WithHTTPPathPattern("/mls/listings/realogy"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetRealogyListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyMasterId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetRealogyListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffId_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetMlsListingHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffId", runtime.WithHTTPPathPattern("/mls/staff/{company_staff_master_id}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingHistory", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/history"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetMlsListingHistory_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyStaffId_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetMlsListingHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsListingsByCompanyStaffGuid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetListingDuplicates_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyStaffGuid", runtime.WithHTTPPathPattern("/mls/dash/staff/{company_staff_guid}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetListingDuplicates", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/duplicates"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetListingDuplicates_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsListingsByCompanyStaffGuid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetListingDuplicates_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetMlsSoldListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_GetStatusLifecycle_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetMlsSoldListings", runtime.WithHTTPPathPattern("/mls/listings/sold"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetStatusLifecycle", runtime.WithHTTPPathPattern("/mls/listings/status/lifecycle"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetMlsSoldListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_GetStatusLifecycle_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetMlsSoldListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_GetStatusLifecycle_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingBySource_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_CreateMlsListingWebhook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingBySource", runtime.WithHTTPPathPattern("/mls/stream/source/{source_system_key}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/CreateMlsListingWebhook", runtime.WithHTTPPathPattern("/mls/webhooks"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingBySource_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_CreateMlsListingWebhook_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingBySource_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_CreateMlsListingWebhook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListMlsListingWebhooks_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByCity", runtime.WithHTTPPathPattern("/mls/stream/city/{city}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhooks", runtime.WithHTTPPathPattern("/mls/webhooks"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingByCity_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_ListMlsListingWebhooks_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingByCity_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListMlsListingWebhooks_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByCity_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("PATCH", pattern_MlsListingService_PatchMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByCity", runtime.WithHTTPPathPattern("/mls/stream/city/{city}/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/PatchMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingByCity_1(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_PatchMlsListing_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingByCity_1(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_PatchMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByState_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("DELETE", pattern_MlsListingService_DeleteMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByState", runtime.WithHTTPPathPattern("/mls/stream/state/{state}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingByState_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_DeleteMlsListing_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingByState_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_DeleteMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingByPostalCode_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_RestoreMlsListing_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingByPostalCode", runtime.WithHTTPPathPattern("/mls/stream/postalcode/{postal_code}"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/RestoreMlsListing", runtime.WithHTTPPathPattern("/mls/listing/{listing_id}/source/{source_system_key}/restore"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingByPostalCode_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_RestoreMlsListing_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingByPostalCode_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_RestoreMlsListing_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_StreamMlsListingEvent_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("DELETE", pattern_MlsListingService_DeleteMlsListingWebhook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/StreamMlsListingEvent", runtime.WithHTTPPathPattern("/mls/changes"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListingWebhook", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_StreamMlsListingEvent_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_DeleteMlsListingWebhook_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_StreamMlsListingEvent_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_DeleteMlsListingWebhook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_SearchMlsListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("GET", pattern_MlsListingService_ListMlsListingWebhookDeadLetters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/SearchMlsListings", runtime.WithHTTPPathPattern("/mls/listings/search"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhookDeadLetters", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}/dead-letters"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_SearchMlsListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_ListMlsListingWebhookDeadLetters_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_SearchMlsListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ListMlsListingWebhookDeadLetters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
-	mux.Handle("GET", pattern_MlsListingService_GetRealogyListings_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	mux.Handle("POST", pattern_MlsListingService_ReplayMlsListingWebhookDeadLetters_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		var err error
 		var annotatedContext context.Context
-		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/GetRealogyListings", runtime.WithHTTPPathPattern("/mls/listings/realogy"))
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/realogy.api.mls.v1.MlsListingService/ReplayMlsListingWebhookDeadLetters", runtime.WithHTTPPathPattern("/mls/webhooks/{webhook_id}/replay"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		resp, md, err := request_MlsListingService_GetRealogyListings_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		resp, md, err := request_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
 			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 
-		forward_MlsListingService_GetRealogyListings_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		forward_MlsListingService_ReplayMlsListingWebhookDeadLetters_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 
 	})
 
@@ -3710,6 +5911,8 @@ var (
 
 	pattern_MlsListingService_AddMlsListings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"mls", "rdm-source", "rdm_source_system_key", "listing", "listing_id"}, ""))
 
+	pattern_MlsListingService_BulkUpsertMlsListings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "bulk"}, ""))
+
 	pattern_MlsListingService_GetMlsListingByListingGuid_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"mls", "dash", "listing", "listing_guid"}, ""))
 
 	pattern_MlsListingService_GetMlsListingByListingGuid_1 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"mls", "dash", "listing", "listing_guid", "source", "source_system_key"}, ""))
@@ -3742,6 +5945,20 @@ var (
 
 	pattern_MlsListingService_GetMlsListingsBySubdivision_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"mls", "subdivision", "subdivision_name"}, ""))
 
+	pattern_MlsListingService_SearchMlsListingsByGeo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "geo"}, ""))
+
+	pattern_MlsListingService_SearchMlsListingsByGeo_1 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "geo"}, ""))
+
+	pattern_MlsListingService_GetUpcomingOpenHouses_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"mls", "openhouses"}, ""))
+
+	pattern_MlsListingService_ListAgents_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"mls", "roster", "source", "source_system_key", "agents"}, ""))
+
+	pattern_MlsListingService_GetAgent_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 1, 0, 4, 1, 5, 5}, []string{"mls", "roster", "source", "source_system_key", "agents", "mls_id"}, ""))
+
+	pattern_MlsListingService_GetAgent_1 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"mls", "roster", "agents", "master", "master_id"}, ""))
+
+	pattern_MlsListingService_ListOffices_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"mls", "roster", "source", "source_system_key", "offices"}, ""))
+
 	pattern_MlsListingService_GetMlsListingsByCompanyMasterId_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"mls", "company", "master", "company_master_id"}, ""))
 
 	pattern_MlsListingService_GetMlsListingsByCompanyStaffId_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"mls", "staff", "company_staff_master_id"}, ""))
@@ -3750,6 +5967,8 @@ var (
 
 	pattern_MlsListingService_GetMlsSoldListings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "sold"}, ""))
 
+	pattern_MlsListingService_GetComparableSales_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"mls", "listings", "sold", "comparables"}, ""))
+
 	pattern_MlsListingService_StreamMlsListingBySource_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"mls", "stream", "source", "source_system_key"}, ""))
 
 	pattern_MlsListingService_StreamMlsListingByCity_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 2}, []string{"mls", "stream", "city"}, ""))
@@ -3762,10 +5981,34 @@ var (
 
 	pattern_MlsListingService_StreamMlsListingEvent_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"mls", "changes"}, ""))
 
+	pattern_MlsListingService_AckMlsListingEvent_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "changes", "ack"}, ""))
+
 	pattern_MlsListingService_SearchMlsListings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "search"}, ""))
 
 	pattern_MlsListingService_GetRealogyListings_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"mls", "listings", "realogy"}, ""))
 
+	pattern_MlsListingService_GetMlsListingHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"mls", "listing", "listing_id", "source", "source_system_key", "history"}, ""))
+
+	pattern_MlsListingService_GetListingDuplicates_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"mls", "listing", "listing_id", "source", "source_system_key", "duplicates"}, ""))
+
+	pattern_MlsListingService_GetStatusLifecycle_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"mls", "listings", "status", "lifecycle"}, ""))
+
+	pattern_MlsListingService_CreateMlsListingWebhook_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"mls", "webhooks"}, ""))
+
+	pattern_MlsListingService_ListMlsListingWebhooks_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"mls", "webhooks"}, ""))
+
+	pattern_MlsListingService_PatchMlsListing_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"mls", "listing", "listing_id", "source", "source_system_key"}, ""))
+
+	pattern_MlsListingService_DeleteMlsListing_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"mls", "listing", "listing_id", "source", "source_system_key"}, ""))
+
+	pattern_MlsListingService_RestoreMlsListing_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"mls", "listing", "listing_id", "source", "source_system_key", "restore"}, ""))
+
+	pattern_MlsListingService_DeleteMlsListingWebhook_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"mls", "webhooks", "webhook_id"}, ""))
+
+	pattern_MlsListingService_ListMlsListingWebhookDeadLetters_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"mls", "webhooks", "webhook_id", "dead-letters"}, ""))
+
+	pattern_MlsListingService_ReplayMlsListingWebhookDeadLetters_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"mls", "webhooks", "webhook_id", "replay"}, ""))
+
 	pattern_MlsListingService_HealthCheck_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"internal", "health"}, ""))
 )
 
@@ -3778,6 +6021,8 @@ var (
 
 	forward_MlsListingService_AddMlsListings_0 = runtime.ForwardResponseMessage
 
+	forward_MlsListingService_BulkUpsertMlsListings_0 = runtime.ForwardResponseMessage
+
 	forward_MlsListingService_GetMlsListingByListingGuid_0 = runtime.ForwardResponseMessage
 
 	forward_MlsListingService_GetMlsListingByListingGuid_1 = runtime.ForwardResponseMessage
@@ -3810,6 +6055,20 @@ var (
 
 	forward_MlsListingService_GetMlsListingsBySubdivision_0 = runtime.ForwardResponseMessage
 
+	forward_MlsListingService_SearchMlsListingsByGeo_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_SearchMlsListingsByGeo_1 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_GetUpcomingOpenHouses_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_ListAgents_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_GetAgent_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_GetAgent_1 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_ListOffices_0 = runtime.ForwardResponseMessage
+
 	forward_MlsListingService_GetMlsListingsByCompanyMasterId_0 = runtime.ForwardResponseMessage
 
 	forward_MlsListingService_GetMlsListingsByCompanyStaffId_0 = runtime.ForwardResponseMessage
@@ -3818,6 +6077,8 @@ var (
 
 	forward_MlsListingService_GetMlsSoldListings_0 = runtime.ForwardResponseMessage
 
+	forward_MlsListingService_GetComparableSales_0 = runtime.ForwardResponseMessage
+
 	forward_MlsListingService_StreamMlsListingBySource_0 = runtime.ForwardResponseStream
 
 	forward_MlsListingService_StreamMlsListingByCity_0 = runtime.ForwardResponseStream
@@ -3830,9 +6091,33 @@ var (
 
 	forward_MlsListingService_StreamMlsListingEvent_0 = runtime.ForwardResponseStream
 
+	forward_MlsListingService_AckMlsListingEvent_0 = runtime.ForwardResponseMessage
+
 	forward_MlsListingService_SearchMlsListings_0 = runtime.ForwardResponseMessage
 
 	forward_MlsListingService_GetRealogyListings_0 = runtime.ForwardResponseMessage
 
+	forward_MlsListingService_GetMlsListingHistory_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_GetListingDuplicates_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_GetStatusLifecycle_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_CreateMlsListingWebhook_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_ListMlsListingWebhooks_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_PatchMlsListing_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_DeleteMlsListing_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_RestoreMlsListing_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_DeleteMlsListingWebhook_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_ListMlsListingWebhookDeadLetters_0 = runtime.ForwardResponseMessage
+
+	forward_MlsListingService_ReplayMlsListingWebhookDeadLetters_0 = runtime.ForwardResponseMessage
+
 	forward_MlsListingService_HealthCheck_0 = runtime.ForwardResponseMessage
 )
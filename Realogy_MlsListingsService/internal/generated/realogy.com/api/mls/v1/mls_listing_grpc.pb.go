@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -33,6 +34,9 @@ type MlsListingServiceClient interface {
 	UpdateMlsListingByListingId(ctx context.Context, in *UpdateMlsListingByListingIdRequest, opts ...grpc.CallOption) (*UpdateMlsListingByListingIdResponse, error)
 	// POST endpoint to allow authorized clients to create a listing. This API creates listing for a listing id if the listing associated to rdmSourceSystemKey .
 	AddMlsListings(ctx context.Context, in *MlsListingInput, opts ...grpc.CallOption) (*AddListingsResponse, error)
+	// POST endpoint to allow authorized clients to create or replace listings in bulk, e.g. ELL/SOLO backfills. Each listing is validated as by AddMlsListings
+	//and the valid ones are written in a single unordered batch. A result is returned per listing, in request order: "inserted", "updated" or "rejected" with the reason.
+	BulkUpsertMlsListings(ctx context.Context, in *BulkUpsertMlsListingsRequest, opts ...grpc.CallOption) (*BulkUpsertMlsListingsResponse, error)
 	// Get Listings for a given Listing Guid (Dash specific unique id for a listing). This API may return multiple listings for a listing id if there are multiple mls sources associated.
 	//Use "source" in the request path to narrow the result to particular mls source.
 	GetMlsListingByListingGuid(ctx context.Context, in *GetMlsListingByListingGuidRequest, opts ...grpc.CallOption) (*GetMlsListingByListingGuidResponse, error)
@@ -68,6 +72,27 @@ type MlsListingServiceClient interface {
 	// Get Listings for a given Subdivision. By default this api returns maximum of 20 listings. Use "offset & limit" in the request as query parameter to return more listings.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsListingsBySubdivision(ctx context.Context, in *GetMlsListingsBySubdivisionRequest, opts ...grpc.CallOption) (*GetMlsListingsBySubdivisionResponse, error)
+	// Get Listings within a geographic area. The area is either a point with a radius (in meters), a bounding box or a GeoJSON polygon.
+	//Listings are ordered by distance from the point, or from the center of the bounding box or polygon when no point is given. Use "filter" to narrow the result.
+	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
+	SearchMlsListingsByGeo(ctx context.Context, in *SearchMlsListingsByGeoRequest, opts ...grpc.CallOption) (*SearchMlsListingsByGeoResponse, error)
+	// Get the open houses, in person and live streamed, starting within a time window in an area. The area is a city, a postal code,
+	//a point with a radius (in meters) or an mls source, at least one is required. The window defaults to the next 7 days and can not be longer than 31.
+	//Canceled open houses are excluded. Each open house is returned on its own, linked to its listing, ordered by start time.
+	//Offset is the point at which the open houses should be returned and limit is the size of the open houses to be returned. Maximum limit is 250.
+	GetUpcomingOpenHouses(ctx context.Context, in *GetUpcomingOpenHousesRequest, opts ...grpc.CallOption) (*GetUpcomingOpenHousesResponse, error)
+	// Get the agents of an mls source, from the roster of agents named by its listings as listing, co-listing, buyer or co-buyer agent.
+	//Agents are keyed by their mls id within the source. Contact fields are the latest known from their listings, and the counts and
+	//last activity time cover the listings not deleted. Agents are ordered by name.
+	//Offset is the point at which the agents should be returned and limit is the size of the agents to be returned. Maximum limit is 250.
+	ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error)
+	// Get an agent of the roster by its mls id within a source, or by its master id. An agent with a master id in several sources
+	//is returned from the source of its most recent activity.
+	GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error)
+	// Get the offices of an mls source, from the roster of offices named by its listings as listing, co-listing, buyer or co-buyer office.
+	//Offices are ordered by name.
+	//Offset is the point at which the offices should be returned and limit is the size of the offices to be returned. Maximum limit is 250.
+	ListOffices(ctx context.Context, in *ListOfficesRequest, opts ...grpc.CallOption) (*ListOfficesResponse, error)
 	// Get Listings for a given List Company Master Id.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsListingsByCompanyMasterId(ctx context.Context, in *GetMlsListingsByCompanyMasterIdRequest, opts ...grpc.CallOption) (*GetMlsListingsByCompanyMasterIdResponse, error)
@@ -81,6 +106,12 @@ type MlsListingServiceClient interface {
 	//startDate and endDate are optional. If the startDate exists and the endDate is not provided, the api will consider end date to be 6 months from the start date.
 	//Offset is the point at which the sold listings should be returned and limit is the size of the sold listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsSoldListings(ctx context.Context, in *GetMlsSoldListingsRequest, opts ...grpc.CallOption) (*GetMlsSoldListingsResponse, error)
+	// Get the sold listings most similar to a subject property, for a comparative market analysis. The subject is a listing, by its listing id
+	//and source, or a point or an address with its structure attributes. Attributes given in the request override those of the subject listing.
+	//Comparable sales closed within "soldWithinDays" (default 180) and "radiusMeters" (default 1 mile) of the subject. They are scored on
+	//property type, bedrooms, bathrooms, building area and year built, and returned most similar first with their price per square foot
+	//adjusted to the subject. Limit is the number of comparable sales to return, 10 by default.
+	GetComparableSales(ctx context.Context, in *GetComparableSalesRequest, opts ...grpc.CallOption) (*GetComparableSalesResponse, error)
 	// Stream Listings for a given mls source name. This api is a streaming variation of listings by source.
 	//Use http2 to stream the response. Default idle timeout is 120 seconds.
 	StreamMlsListingBySource(ctx context.Context, in *GetMlsListingsBySourceRequest, opts ...grpc.CallOption) (MlsListingService_StreamMlsListingBySourceClient, error)
@@ -93,13 +124,26 @@ type MlsListingServiceClient interface {
 	// Stream Listings for a given Postal Code. This api is a streaming variation of listings by postal code.
 	//Use http2 to stream the response. Default idle timeout is 120 seconds.
 	StreamMlsListingByPostalCode(ctx context.Context, in *GetMlsListingsByPostalCodeRequest, opts ...grpc.CallOption) (MlsListingService_StreamMlsListingByPostalCodeClient, error)
+	// Export of the listings for a given mls source as NDJSON or CSV rows, for data pipelines. Rows are streamed from the query, one per listing,
+	//with the nested fields flattened into columns named by their path, e.g. "property.listing.price.list_price". Use "fields" to choose the columns,
+	//a message selects all the fields under it. Repeated fields are JSON arrays. The chunks concatenate to the file, gzip compressed when "gzip" is set.
+	//The gateway serves the export as a file download at "/mls/export/source/{source_system_key}", it has no REST route of its own.
+	ExportMlsListings(ctx context.Context, in *ExportMlsListingsRequest, opts ...grpc.CallOption) (MlsListingService_ExportMlsListingsClient, error)
 	// Listings changes or events streaming API. By default, this api streams all the events related to mls listings in real time using http2.
 	//Reponse of this api encloses mls listings with event meta data(mlsChange) with attributes,
 	//1. "marker" - Unique id for an event. This id can be used as query param in the request(marker=<marker id of previous successful change>) to resume the changes in case of failure,
 	//2. "changeType" - MLS Change Type (insert, update, replace, delete),
 	//3. "changeTime" - MLS Change Time.
+	//Clients can instead name a server managed "subscription". The server checkpoints the marker of the last event the client acknowledged with AckMlsListingEvent,
+	//and a client reconnecting with just the name resumes after it. When the marker is no longer in the oplog, the listings changed since the last change date
+	//of the listing of the checkpoint are sent first with change type "catchup".
+	//Streams with the same filters and fields share a change stream. A client that does not keep up with the events is disconnected with RESOURCE_EXHAUSTED,
+	//and with UNAVAILABLE when the shared change stream is interrupted, it should reconnect with the marker of the last event received.
 	//Default idle timeout is 120 seconds.
 	StreamMlsListingEvent(ctx context.Context, in *StreamMlsListingEventRequest, opts ...grpc.CallOption) (MlsListingService_StreamMlsListingEventClient, error)
+	// Acknowledges the events of a subscription up to a marker, once the client has processed them. The checkpoint of the subscription advances to the
+	//acknowledged marker when the stream that sent it saves its checkpoint, events sent but not acknowledged are sent again on reconnection.
+	AckMlsListingEvent(ctx context.Context, in *AckMlsListingEventRequest, opts ...grpc.CallOption) (*AckMlsListingEventResponse, error)
 	// Search listings endpoint can be used to lookup listings using various attributes. Use "offset & limit" to paginate result.
 	//lastChangeTimestamp can be used to get listings delta changes. Timestamp has to be in UTC format. For ex: 2021-09-09T00:00:00.000Z. Endpoint ignores nano seconds in the timestamp.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned.
@@ -110,6 +154,44 @@ type MlsListingServiceClient interface {
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned.
 	//Default offset is 0 and default limit is 20 listings. Maximum limit in a request is 250.
 	GetRealogyListings(ctx context.Context, in *RealogyListingsRequest, opts ...grpc.CallOption) (*RealogyListingsResponse, error)
+	// Price and status timeline of a listing, oldest change first. A change is recorded when the list price or the standard status of a listing changes,
+	//whether by UpdateMlsListingByListingId or by the feeds. The summary counts the price changes and the days spent in each status.
+	GetMlsListingHistory(ctx context.Context, in *GetMlsListingHistoryRequest, opts ...grpc.CallOption) (*GetMlsListingHistoryResponse, error)
+	// The cluster of duplicate listings of a listing, the listings of the same property from other sources. Listings are clustered when they have
+	//the same property master id, the same normalized address, or are within a few meters of each other with the same street and unit number.
+	//The canonical listing of a cluster is the one of the source of the highest priority, the others are left out of queries with the
+	//"collapseDuplicates" filter. A listing without duplicates is returned alone as its canonical listing.
+	GetListingDuplicates(ctx context.Context, in *GetListingDuplicatesRequest, opts ...grpc.CallOption) (*GetListingDuplicatesResponse, error)
+	// Lifecycle of the listing standard status: for each status, the statuses a listing may change to, the fields a listing needs to be in it and
+	//the dates that can not be earlier than others. Updates, patches and bulk upserts of listings are validated against it.
+	GetStatusLifecycle(ctx context.Context, in *GetStatusLifecycleRequest, opts ...grpc.CallOption) (*GetStatusLifecycleResponse, error)
+	// Query of the listings as the RESO Web API "Property" resource, it backs the OData endpoint "/odata/Property" of the gateway and has no REST route of its own.
+	//The query options are those of OData: "filter" ($filter), "select" ($select), "order_by" ($orderby), "top" ($top), "skip" ($skip) and "count" ($count),
+	//on the fields of the "/odata/$metadata" document.
+	QueryMlsListings(ctx context.Context, in *QueryMlsListingsRequest, opts ...grpc.CallOption) (*QueryMlsListingsResponse, error)
+	// Registers a webhook for listing change events. Events matching the filters of the webhook are POSTed to its url as JSON batches (MlsListingWebhookBatch),
+	//with the headers "X-Mls-Timestamp" (unix seconds) and "X-Mls-Signature" ("sha256=" hex HMAC-SHA256 of "<timestamp>.<body>" keyed by the webhook secret).
+	//The secret is only returned by this call. Failed deliveries are retried with backoff and kept as dead letters once the attempts are exhausted.
+	CreateMlsListingWebhook(ctx context.Context, in *CreateMlsListingWebhookRequest, opts ...grpc.CallOption) (*MlsListingWebhook, error)
+	// Registered webhooks, without their secrets.
+	ListMlsListingWebhooks(ctx context.Context, in *ListMlsListingWebhooksRequest, opts ...grpc.CallOption) (*ListMlsListingWebhooksResponse, error)
+	// Update the fields of a listing named by "update_mask", e.g. "property.listing.remarks.public_remarks", to their values in "mls_listing".
+	//Masked fields without a value in "mls_listing" are cleared. Paths must be writable by the listing source, see api.patch of the service config.
+	//The gateway masks the fields of the request body when "update_mask" is not set. "expected_version" or the If-Match header
+	//make the update conditional on the listing version, as for UpdateMlsListingByListingId.
+	PatchMlsListing(ctx context.Context, in *PatchMlsListingRequest, opts ...grpc.CallOption) (*PatchMlsListingResponse, error)
+	// Delete a listing of an internal source (SOLO, ELL, LC). Listings are soft deleted unless "hard" is set: they are kept as tombstones,
+	//excluded from reads and sent to listing event streams as "delete" events. Hard deleted listings are removed and can not be restored.
+	DeleteMlsListing(ctx context.Context, in *DeleteMlsListingRequest, opts ...grpc.CallOption) (*DeleteMlsListingResponse, error)
+	// Restore a soft deleted listing of an internal source. Restored listings are sent to listing event streams as "insert" events.
+	RestoreMlsListing(ctx context.Context, in *RestoreMlsListingRequest, opts ...grpc.CallOption) (*RestoreMlsListingResponse, error)
+	// Removes a webhook along with its dead letters.
+	DeleteMlsListingWebhook(ctx context.Context, in *DeleteMlsListingWebhookRequest, opts ...grpc.CallOption) (*DeleteMlsListingWebhookResponse, error)
+	// Batches that could not be delivered to a webhook, oldest first.
+	ListMlsListingWebhookDeadLetters(ctx context.Context, in *ListMlsListingWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListMlsListingWebhookDeadLettersResponse, error)
+	// Delivers the dead letters of a webhook again, all of them or the given ones. Delivered dead letters are removed, the others are kept with the latest error.
+	//Replayed batches keep their body and are signed with a new timestamp.
+	ReplayMlsListingWebhookDeadLetters(ctx context.Context, in *ReplayMlsListingWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ReplayMlsListingWebhookDeadLettersResponse, error)
 	// Health Check.
 	HealthCheck(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 }
@@ -149,6 +231,15 @@ func (c *mlsListingServiceClient) AddMlsListings(ctx context.Context, in *MlsLis
 	return out, nil
 }
 
+func (c *mlsListingServiceClient) BulkUpsertMlsListings(ctx context.Context, in *BulkUpsertMlsListingsRequest, opts ...grpc.CallOption) (*BulkUpsertMlsListingsResponse, error) {
+	out := new(BulkUpsertMlsListingsResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/BulkUpsertMlsListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mlsListingServiceClient) GetMlsListingByListingGuid(ctx context.Context, in *GetMlsListingByListingGuidRequest, opts ...grpc.CallOption) (*GetMlsListingByListingGuidResponse, error) {
 	out := new(GetMlsListingByListingGuidResponse)
 	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetMlsListingByListingGuid", in, out, opts...)
@@ -248,6 +339,51 @@ func (c *mlsListingServiceClient) GetMlsListingsBySubdivision(ctx context.Contex
 	return out, nil
 }
 
+func (c *mlsListingServiceClient) SearchMlsListingsByGeo(ctx context.Context, in *SearchMlsListingsByGeoRequest, opts ...grpc.CallOption) (*SearchMlsListingsByGeoResponse, error) {
+	out := new(SearchMlsListingsByGeoResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) GetUpcomingOpenHouses(ctx context.Context, in *GetUpcomingOpenHousesRequest, opts ...grpc.CallOption) (*GetUpcomingOpenHousesResponse, error) {
+	out := new(GetUpcomingOpenHousesResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetUpcomingOpenHouses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error) {
+	out := new(ListAgentsResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/ListAgents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error) {
+	out := new(GetAgentResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) ListOffices(ctx context.Context, in *ListOfficesRequest, opts ...grpc.CallOption) (*ListOfficesResponse, error) {
+	out := new(ListOfficesResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/ListOffices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mlsListingServiceClient) GetMlsListingsByCompanyMasterId(ctx context.Context, in *GetMlsListingsByCompanyMasterIdRequest, opts ...grpc.CallOption) (*GetMlsListingsByCompanyMasterIdResponse, error) {
 	out := new(GetMlsListingsByCompanyMasterIdResponse)
 	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetMlsListingsByCompanyMasterId", in, out, opts...)
@@ -284,6 +420,15 @@ func (c *mlsListingServiceClient) GetMlsSoldListings(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *mlsListingServiceClient) GetComparableSales(ctx context.Context, in *GetComparableSalesRequest, opts ...grpc.CallOption) (*GetComparableSalesResponse, error) {
+	out := new(GetComparableSalesResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetComparableSales", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mlsListingServiceClient) StreamMlsListingBySource(ctx context.Context, in *GetMlsListingsBySourceRequest, opts ...grpc.CallOption) (MlsListingService_StreamMlsListingBySourceClient, error) {
 	stream, err := c.cc.NewStream(ctx, &MlsListingService_ServiceDesc.Streams[0], "/realogy.api.mls.v1.MlsListingService/StreamMlsListingBySource", opts...)
 	if err != nil {
@@ -412,8 +557,40 @@ func (x *mlsListingServiceStreamMlsListingByPostalCodeClient) Recv() (*MlsListin
 	return m, nil
 }
 
+func (c *mlsListingServiceClient) ExportMlsListings(ctx context.Context, in *ExportMlsListingsRequest, opts ...grpc.CallOption) (MlsListingService_ExportMlsListingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MlsListingService_ServiceDesc.Streams[4], "/realogy.api.mls.v1.MlsListingService/ExportMlsListings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mlsListingServiceExportMlsListingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MlsListingService_ExportMlsListingsClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type mlsListingServiceExportMlsListingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mlsListingServiceExportMlsListingsClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *mlsListingServiceClient) StreamMlsListingEvent(ctx context.Context, in *StreamMlsListingEventRequest, opts ...grpc.CallOption) (MlsListingService_StreamMlsListingEventClient, error) {
-	stream, err := c.cc.NewStream(ctx, &MlsListingService_ServiceDesc.Streams[4], "/realogy.api.mls.v1.MlsListingService/StreamMlsListingEvent", opts...)
+	stream, err := c.cc.NewStream(ctx, &MlsListingService_ServiceDesc.Streams[5], "/realogy.api.mls.v1.MlsListingService/StreamMlsListingEvent", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -444,6 +621,15 @@ func (x *mlsListingServiceStreamMlsListingEventClient) Recv() (*StreamMlsListing
 	return m, nil
 }
 
+func (c *mlsListingServiceClient) AckMlsListingEvent(ctx context.Context, in *AckMlsListingEventRequest, opts ...grpc.CallOption) (*AckMlsListingEventResponse, error) {
+	out := new(AckMlsListingEventResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/AckMlsListingEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mlsListingServiceClient) SearchMlsListings(ctx context.Context, in *SearchMlsListingsRequest, opts ...grpc.CallOption) (*SearchMlsListingsResponse, error) {
 	out := new(SearchMlsListingsResponse)
 	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/SearchMlsListings", in, out, opts...)
@@ -462,6 +648,114 @@ func (c *mlsListingServiceClient) GetRealogyListings(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *mlsListingServiceClient) GetMlsListingHistory(ctx context.Context, in *GetMlsListingHistoryRequest, opts ...grpc.CallOption) (*GetMlsListingHistoryResponse, error) {
+	out := new(GetMlsListingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetMlsListingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) GetListingDuplicates(ctx context.Context, in *GetListingDuplicatesRequest, opts ...grpc.CallOption) (*GetListingDuplicatesResponse, error) {
+	out := new(GetListingDuplicatesResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetListingDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) GetStatusLifecycle(ctx context.Context, in *GetStatusLifecycleRequest, opts ...grpc.CallOption) (*GetStatusLifecycleResponse, error) {
+	out := new(GetStatusLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/GetStatusLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) QueryMlsListings(ctx context.Context, in *QueryMlsListingsRequest, opts ...grpc.CallOption) (*QueryMlsListingsResponse, error) {
+	out := new(QueryMlsListingsResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/QueryMlsListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) CreateMlsListingWebhook(ctx context.Context, in *CreateMlsListingWebhookRequest, opts ...grpc.CallOption) (*MlsListingWebhook, error) {
+	out := new(MlsListingWebhook)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/CreateMlsListingWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) ListMlsListingWebhooks(ctx context.Context, in *ListMlsListingWebhooksRequest, opts ...grpc.CallOption) (*ListMlsListingWebhooksResponse, error) {
+	out := new(ListMlsListingWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) PatchMlsListing(ctx context.Context, in *PatchMlsListingRequest, opts ...grpc.CallOption) (*PatchMlsListingResponse, error) {
+	out := new(PatchMlsListingResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/PatchMlsListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) DeleteMlsListing(ctx context.Context, in *DeleteMlsListingRequest, opts ...grpc.CallOption) (*DeleteMlsListingResponse, error) {
+	out := new(DeleteMlsListingResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) RestoreMlsListing(ctx context.Context, in *RestoreMlsListingRequest, opts ...grpc.CallOption) (*RestoreMlsListingResponse, error) {
+	out := new(RestoreMlsListingResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/RestoreMlsListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) DeleteMlsListingWebhook(ctx context.Context, in *DeleteMlsListingWebhookRequest, opts ...grpc.CallOption) (*DeleteMlsListingWebhookResponse, error) {
+	out := new(DeleteMlsListingWebhookResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/DeleteMlsListingWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) ListMlsListingWebhookDeadLetters(ctx context.Context, in *ListMlsListingWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ListMlsListingWebhookDeadLettersResponse, error) {
+	out := new(ListMlsListingWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingServiceClient) ReplayMlsListingWebhookDeadLetters(ctx context.Context, in *ReplayMlsListingWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ReplayMlsListingWebhookDeadLettersResponse, error) {
+	out := new(ReplayMlsListingWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/ReplayMlsListingWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mlsListingServiceClient) HealthCheck(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
 	out := new(HealthResponse)
 	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingService/HealthCheck", in, out, opts...)
@@ -486,6 +780,9 @@ type MlsListingServiceServer interface {
 	UpdateMlsListingByListingId(context.Context, *UpdateMlsListingByListingIdRequest) (*UpdateMlsListingByListingIdResponse, error)
 	// POST endpoint to allow authorized clients to create a listing. This API creates listing for a listing id if the listing associated to rdmSourceSystemKey .
 	AddMlsListings(context.Context, *MlsListingInput) (*AddListingsResponse, error)
+	// POST endpoint to allow authorized clients to create or replace listings in bulk, e.g. ELL/SOLO backfills. Each listing is validated as by AddMlsListings
+	//and the valid ones are written in a single unordered batch. A result is returned per listing, in request order: "inserted", "updated" or "rejected" with the reason.
+	BulkUpsertMlsListings(context.Context, *BulkUpsertMlsListingsRequest) (*BulkUpsertMlsListingsResponse, error)
 	// Get Listings for a given Listing Guid (Dash specific unique id for a listing). This API may return multiple listings for a listing id if there are multiple mls sources associated.
 	//Use "source" in the request path to narrow the result to particular mls source.
 	GetMlsListingByListingGuid(context.Context, *GetMlsListingByListingGuidRequest) (*GetMlsListingByListingGuidResponse, error)
@@ -521,6 +818,27 @@ type MlsListingServiceServer interface {
 	// Get Listings for a given Subdivision. By default this api returns maximum of 20 listings. Use "offset & limit" in the request as query parameter to return more listings.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsListingsBySubdivision(context.Context, *GetMlsListingsBySubdivisionRequest) (*GetMlsListingsBySubdivisionResponse, error)
+	// Get Listings within a geographic area. The area is either a point with a radius (in meters), a bounding box or a GeoJSON polygon.
+	//Listings are ordered by distance from the point, or from the center of the bounding box or polygon when no point is given. Use "filter" to narrow the result.
+	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
+	SearchMlsListingsByGeo(context.Context, *SearchMlsListingsByGeoRequest) (*SearchMlsListingsByGeoResponse, error)
+	// Get the open houses, in person and live streamed, starting within a time window in an area. The area is a city, a postal code,
+	//a point with a radius (in meters) or an mls source, at least one is required. The window defaults to the next 7 days and can not be longer than 31.
+	//Canceled open houses are excluded. Each open house is returned on its own, linked to its listing, ordered by start time.
+	//Offset is the point at which the open houses should be returned and limit is the size of the open houses to be returned. Maximum limit is 250.
+	GetUpcomingOpenHouses(context.Context, *GetUpcomingOpenHousesRequest) (*GetUpcomingOpenHousesResponse, error)
+	// Get the agents of an mls source, from the roster of agents named by its listings as listing, co-listing, buyer or co-buyer agent.
+	//Agents are keyed by their mls id within the source. Contact fields are the latest known from their listings, and the counts and
+	//last activity time cover the listings not deleted. Agents are ordered by name.
+	//Offset is the point at which the agents should be returned and limit is the size of the agents to be returned. Maximum limit is 250.
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	// Get an agent of the roster by its mls id within a source, or by its master id. An agent with a master id in several sources
+	//is returned from the source of its most recent activity.
+	GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error)
+	// Get the offices of an mls source, from the roster of offices named by its listings as listing, co-listing, buyer or co-buyer office.
+	//Offices are ordered by name.
+	//Offset is the point at which the offices should be returned and limit is the size of the offices to be returned. Maximum limit is 250.
+	ListOffices(context.Context, *ListOfficesRequest) (*ListOfficesResponse, error)
 	// Get Listings for a given List Company Master Id.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsListingsByCompanyMasterId(context.Context, *GetMlsListingsByCompanyMasterIdRequest) (*GetMlsListingsByCompanyMasterIdResponse, error)
@@ -534,6 +852,12 @@ type MlsListingServiceServer interface {
 	//startDate and endDate are optional. If the startDate exists and the endDate is not provided, the api will consider end date to be 6 months from the start date.
 	//Offset is the point at which the sold listings should be returned and limit is the size of the sold listings to be returned. Maximum limit is 250. Resets to max limit if the input is over the allowed max limit.
 	GetMlsSoldListings(context.Context, *GetMlsSoldListingsRequest) (*GetMlsSoldListingsResponse, error)
+	// Get the sold listings most similar to a subject property, for a comparative market analysis. The subject is a listing, by its listing id
+	//and source, or a point or an address with its structure attributes. Attributes given in the request override those of the subject listing.
+	//Comparable sales closed within "soldWithinDays" (default 180) and "radiusMeters" (default 1 mile) of the subject. They are scored on
+	//property type, bedrooms, bathrooms, building area and year built, and returned most similar first with their price per square foot
+	//adjusted to the subject. Limit is the number of comparable sales to return, 10 by default.
+	GetComparableSales(context.Context, *GetComparableSalesRequest) (*GetComparableSalesResponse, error)
 	// Stream Listings for a given mls source name. This api is a streaming variation of listings by source.
 	//Use http2 to stream the response. Default idle timeout is 120 seconds.
 	StreamMlsListingBySource(*GetMlsListingsBySourceRequest, MlsListingService_StreamMlsListingBySourceServer) error
@@ -546,13 +870,26 @@ type MlsListingServiceServer interface {
 	// Stream Listings for a given Postal Code. This api is a streaming variation of listings by postal code.
 	//Use http2 to stream the response. Default idle timeout is 120 seconds.
 	StreamMlsListingByPostalCode(*GetMlsListingsByPostalCodeRequest, MlsListingService_StreamMlsListingByPostalCodeServer) error
+	// Export of the listings for a given mls source as NDJSON or CSV rows, for data pipelines. Rows are streamed from the query, one per listing,
+	//with the nested fields flattened into columns named by their path, e.g. "property.listing.price.list_price". Use "fields" to choose the columns,
+	//a message selects all the fields under it. Repeated fields are JSON arrays. The chunks concatenate to the file, gzip compressed when "gzip" is set.
+	//The gateway serves the export as a file download at "/mls/export/source/{source_system_key}", it has no REST route of its own.
+	ExportMlsListings(*ExportMlsListingsRequest, MlsListingService_ExportMlsListingsServer) error
 	// Listings changes or events streaming API. By default, this api streams all the events related to mls listings in real time using http2.
 	//Reponse of this api encloses mls listings with event meta data(mlsChange) with attributes,
 	//1. "marker" - Unique id for an event. This id can be used as query param in the request(marker=<marker id of previous successful change>) to resume the changes in case of failure,
 	//2. "changeType" - MLS Change Type (insert, update, replace, delete),
 	//3. "changeTime" - MLS Change Time.
+	//Clients can instead name a server managed "subscription". The server checkpoints the marker of the last event the client acknowledged with AckMlsListingEvent,
+	//and a client reconnecting with just the name resumes after it. When the marker is no longer in the oplog, the listings changed since the last change date
+	//of the listing of the checkpoint are sent first with change type "catchup".
+	//Streams with the same filters and fields share a change stream. A client that does not keep up with the events is disconnected with RESOURCE_EXHAUSTED,
+	//and with UNAVAILABLE when the shared change stream is interrupted, it should reconnect with the marker of the last event received.
 	//Default idle timeout is 120 seconds.
 	StreamMlsListingEvent(*StreamMlsListingEventRequest, MlsListingService_StreamMlsListingEventServer) error
+	// Acknowledges the events of a subscription up to a marker, once the client has processed them. The checkpoint of the subscription advances to the
+	//acknowledged marker when the stream that sent it saves its checkpoint, events sent but not acknowledged are sent again on reconnection.
+	AckMlsListingEvent(context.Context, *AckMlsListingEventRequest) (*AckMlsListingEventResponse, error)
 	// Search listings endpoint can be used to lookup listings using various attributes. Use "offset & limit" to paginate result.
 	//lastChangeTimestamp can be used to get listings delta changes. Timestamp has to be in UTC format. For ex: 2021-09-09T00:00:00.000Z. Endpoint ignores nano seconds in the timestamp.
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned.
@@ -563,6 +900,44 @@ type MlsListingServiceServer interface {
 	//Offset is the point at which the mls listings should be returned and limit is the size of the mls listings to be returned.
 	//Default offset is 0 and default limit is 20 listings. Maximum limit in a request is 250.
 	GetRealogyListings(context.Context, *RealogyListingsRequest) (*RealogyListingsResponse, error)
+	// Price and status timeline of a listing, oldest change first. A change is recorded when the list price or the standard status of a listing changes,
+	//whether by UpdateMlsListingByListingId or by the feeds. The summary counts the price changes and the days spent in each status.
+	GetMlsListingHistory(context.Context, *GetMlsListingHistoryRequest) (*GetMlsListingHistoryResponse, error)
+	// The cluster of duplicate listings of a listing, the listings of the same property from other sources. Listings are clustered when they have
+	//the same property master id, the same normalized address, or are within a few meters of each other with the same street and unit number.
+	//The canonical listing of a cluster is the one of the source of the highest priority, the others are left out of queries with the
+	//"collapseDuplicates" filter. A listing without duplicates is returned alone as its canonical listing.
+	GetListingDuplicates(context.Context, *GetListingDuplicatesRequest) (*GetListingDuplicatesResponse, error)
+	// Lifecycle of the listing standard status: for each status, the statuses a listing may change to, the fields a listing needs to be in it and
+	//the dates that can not be earlier than others. Updates, patches and bulk upserts of listings are validated against it.
+	GetStatusLifecycle(context.Context, *GetStatusLifecycleRequest) (*GetStatusLifecycleResponse, error)
+	// Query of the listings as the RESO Web API "Property" resource, it backs the OData endpoint "/odata/Property" of the gateway and has no REST route of its own.
+	//The query options are those of OData: "filter" ($filter), "select" ($select), "order_by" ($orderby), "top" ($top), "skip" ($skip) and "count" ($count),
+	//on the fields of the "/odata/$metadata" document.
+	QueryMlsListings(context.Context, *QueryMlsListingsRequest) (*QueryMlsListingsResponse, error)
+	// Registers a webhook for listing change events. Events matching the filters of the webhook are POSTed to its url as JSON batches (MlsListingWebhookBatch),
+	//with the headers "X-Mls-Timestamp" (unix seconds) and "X-Mls-Signature" ("sha256=" hex HMAC-SHA256 of "<timestamp>.<body>" keyed by the webhook secret).
+	//The secret is only returned by this call. Failed deliveries are retried with backoff and kept as dead letters once the attempts are exhausted.
+	CreateMlsListingWebhook(context.Context, *CreateMlsListingWebhookRequest) (*MlsListingWebhook, error)
+	// Registered webhooks, without their secrets.
+	ListMlsListingWebhooks(context.Context, *ListMlsListingWebhooksRequest) (*ListMlsListingWebhooksResponse, error)
+	// Update the fields of a listing named by "update_mask", e.g. "property.listing.remarks.public_remarks", to their values in "mls_listing".
+	//Masked fields without a value in "mls_listing" are cleared. Paths must be writable by the listing source, see api.patch of the service config.
+	//The gateway masks the fields of the request body when "update_mask" is not set. "expected_version" or the If-Match header
+	//make the update conditional on the listing version, as for UpdateMlsListingByListingId.
+	PatchMlsListing(context.Context, *PatchMlsListingRequest) (*PatchMlsListingResponse, error)
+	// Delete a listing of an internal source (SOLO, ELL, LC). Listings are soft deleted unless "hard" is set: they are kept as tombstones,
+	//excluded from reads and sent to listing event streams as "delete" events. Hard deleted listings are removed and can not be restored.
+	DeleteMlsListing(context.Context, *DeleteMlsListingRequest) (*DeleteMlsListingResponse, error)
+	// Restore a soft deleted listing of an internal source. Restored listings are sent to listing event streams as "insert" events.
+	RestoreMlsListing(context.Context, *RestoreMlsListingRequest) (*RestoreMlsListingResponse, error)
+	// Removes a webhook along with its dead letters.
+	DeleteMlsListingWebhook(context.Context, *DeleteMlsListingWebhookRequest) (*DeleteMlsListingWebhookResponse, error)
+	// Batches that could not be delivered to a webhook, oldest first.
+	ListMlsListingWebhookDeadLetters(context.Context, *ListMlsListingWebhookDeadLettersRequest) (*ListMlsListingWebhookDeadLettersResponse, error)
+	// Delivers the dead letters of a webhook again, all of them or the given ones. Delivered dead letters are removed, the others are kept with the latest error.
+	//Replayed batches keep their body and are signed with a new timestamp.
+	ReplayMlsListingWebhookDeadLetters(context.Context, *ReplayMlsListingWebhookDeadLettersRequest) (*ReplayMlsListingWebhookDeadLettersResponse, error)
 	// Health Check.
 	HealthCheck(context.Context, *HealthRequest) (*HealthResponse, error)
 	mustEmbedUnimplementedMlsListingServiceServer()
@@ -581,6 +956,9 @@ func (UnimplementedMlsListingServiceServer) UpdateMlsListingByListingId(context.
 func (UnimplementedMlsListingServiceServer) AddMlsListings(context.Context, *MlsListingInput) (*AddListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddMlsListings not implemented")
 }
+func (UnimplementedMlsListingServiceServer) BulkUpsertMlsListings(context.Context, *BulkUpsertMlsListingsRequest) (*BulkUpsertMlsListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpsertMlsListings not implemented")
+}
 func (UnimplementedMlsListingServiceServer) GetMlsListingByListingGuid(context.Context, *GetMlsListingByListingGuidRequest) (*GetMlsListingByListingGuidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingByListingGuid not implemented")
 }
@@ -614,6 +992,21 @@ func (UnimplementedMlsListingServiceServer) GetMlsListingsByAddress(context.Cont
 func (UnimplementedMlsListingServiceServer) GetMlsListingsBySubdivision(context.Context, *GetMlsListingsBySubdivisionRequest) (*GetMlsListingsBySubdivisionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingsBySubdivision not implemented")
 }
+func (UnimplementedMlsListingServiceServer) SearchMlsListingsByGeo(context.Context, *SearchMlsListingsByGeoRequest) (*SearchMlsListingsByGeoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMlsListingsByGeo not implemented")
+}
+func (UnimplementedMlsListingServiceServer) GetUpcomingOpenHouses(context.Context, *GetUpcomingOpenHousesRequest) (*GetUpcomingOpenHousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingOpenHouses not implemented")
+}
+func (UnimplementedMlsListingServiceServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedMlsListingServiceServer) GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgent not implemented")
+}
+func (UnimplementedMlsListingServiceServer) ListOffices(context.Context, *ListOfficesRequest) (*ListOfficesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffices not implemented")
+}
 func (UnimplementedMlsListingServiceServer) GetMlsListingsByCompanyMasterId(context.Context, *GetMlsListingsByCompanyMasterIdRequest) (*GetMlsListingsByCompanyMasterIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingsByCompanyMasterId not implemented")
 }
@@ -626,6 +1019,9 @@ func (UnimplementedMlsListingServiceServer) GetMlsListingsByCompanyStaffGuid(con
 func (UnimplementedMlsListingServiceServer) GetMlsSoldListings(context.Context, *GetMlsSoldListingsRequest) (*GetMlsSoldListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMlsSoldListings not implemented")
 }
+func (UnimplementedMlsListingServiceServer) GetComparableSales(context.Context, *GetComparableSalesRequest) (*GetComparableSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComparableSales not implemented")
+}
 func (UnimplementedMlsListingServiceServer) StreamMlsListingBySource(*GetMlsListingsBySourceRequest, MlsListingService_StreamMlsListingBySourceServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamMlsListingBySource not implemented")
 }
@@ -638,15 +1034,57 @@ func (UnimplementedMlsListingServiceServer) StreamMlsListingByState(*GetMlsListi
 func (UnimplementedMlsListingServiceServer) StreamMlsListingByPostalCode(*GetMlsListingsByPostalCodeRequest, MlsListingService_StreamMlsListingByPostalCodeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamMlsListingByPostalCode not implemented")
 }
+func (UnimplementedMlsListingServiceServer) ExportMlsListings(*ExportMlsListingsRequest, MlsListingService_ExportMlsListingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMlsListings not implemented")
+}
 func (UnimplementedMlsListingServiceServer) StreamMlsListingEvent(*StreamMlsListingEventRequest, MlsListingService_StreamMlsListingEventServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamMlsListingEvent not implemented")
 }
+func (UnimplementedMlsListingServiceServer) AckMlsListingEvent(context.Context, *AckMlsListingEventRequest) (*AckMlsListingEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckMlsListingEvent not implemented")
+}
 func (UnimplementedMlsListingServiceServer) SearchMlsListings(context.Context, *SearchMlsListingsRequest) (*SearchMlsListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMlsListings not implemented")
 }
 func (UnimplementedMlsListingServiceServer) GetRealogyListings(context.Context, *RealogyListingsRequest) (*RealogyListingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRealogyListings not implemented")
 }
+func (UnimplementedMlsListingServiceServer) GetMlsListingHistory(context.Context, *GetMlsListingHistoryRequest) (*GetMlsListingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingHistory not implemented")
+}
+func (UnimplementedMlsListingServiceServer) GetListingDuplicates(context.Context, *GetListingDuplicatesRequest) (*GetListingDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListingDuplicates not implemented")
+}
+func (UnimplementedMlsListingServiceServer) GetStatusLifecycle(context.Context, *GetStatusLifecycleRequest) (*GetStatusLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusLifecycle not implemented")
+}
+func (UnimplementedMlsListingServiceServer) QueryMlsListings(context.Context, *QueryMlsListingsRequest) (*QueryMlsListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMlsListings not implemented")
+}
+func (UnimplementedMlsListingServiceServer) CreateMlsListingWebhook(context.Context, *CreateMlsListingWebhookRequest) (*MlsListingWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMlsListingWebhook not implemented")
+}
+func (UnimplementedMlsListingServiceServer) ListMlsListingWebhooks(context.Context, *ListMlsListingWebhooksRequest) (*ListMlsListingWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMlsListingWebhooks not implemented")
+}
+func (UnimplementedMlsListingServiceServer) PatchMlsListing(context.Context, *PatchMlsListingRequest) (*PatchMlsListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchMlsListing not implemented")
+}
+func (UnimplementedMlsListingServiceServer) DeleteMlsListing(context.Context, *DeleteMlsListingRequest) (*DeleteMlsListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMlsListing not implemented")
+}
+func (UnimplementedMlsListingServiceServer) RestoreMlsListing(context.Context, *RestoreMlsListingRequest) (*RestoreMlsListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMlsListing not implemented")
+}
+func (UnimplementedMlsListingServiceServer) DeleteMlsListingWebhook(context.Context, *DeleteMlsListingWebhookRequest) (*DeleteMlsListingWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMlsListingWebhook not implemented")
+}
+func (UnimplementedMlsListingServiceServer) ListMlsListingWebhookDeadLetters(context.Context, *ListMlsListingWebhookDeadLettersRequest) (*ListMlsListingWebhookDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMlsListingWebhookDeadLetters not implemented")
+}
+func (UnimplementedMlsListingServiceServer) ReplayMlsListingWebhookDeadLetters(context.Context, *ReplayMlsListingWebhookDeadLettersRequest) (*ReplayMlsListingWebhookDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayMlsListingWebhookDeadLetters not implemented")
+}
 func (UnimplementedMlsListingServiceServer) HealthCheck(context.Context, *HealthRequest) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -717,6 +1155,24 @@ func _MlsListingService_AddMlsListings_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MlsListingService_BulkUpsertMlsListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpsertMlsListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).BulkUpsertMlsListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/BulkUpsertMlsListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).BulkUpsertMlsListings(ctx, req.(*BulkUpsertMlsListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MlsListingService_GetMlsListingByListingGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMlsListingByListingGuidRequest)
 	if err := dec(in); err != nil {
@@ -915,6 +1371,96 @@ func _MlsListingService_GetMlsListingsBySubdivision_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MlsListingService_SearchMlsListingsByGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMlsListingsByGeoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).SearchMlsListingsByGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/SearchMlsListingsByGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).SearchMlsListingsByGeo(ctx, req.(*SearchMlsListingsByGeoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_GetUpcomingOpenHouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpcomingOpenHousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetUpcomingOpenHouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetUpcomingOpenHouses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetUpcomingOpenHouses(ctx, req.(*GetUpcomingOpenHousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).ListAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/ListAgents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).ListAgents(ctx, req.(*ListAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_GetAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetAgent(ctx, req.(*GetAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_ListOffices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOfficesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).ListOffices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/ListOffices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).ListOffices(ctx, req.(*ListOfficesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MlsListingService_GetMlsListingsByCompanyMasterId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMlsListingsByCompanyMasterIdRequest)
 	if err := dec(in); err != nil {
@@ -987,6 +1533,24 @@ func _MlsListingService_GetMlsSoldListings_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MlsListingService_GetComparableSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComparableSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetComparableSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetComparableSales",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetComparableSales(ctx, req.(*GetComparableSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MlsListingService_StreamMlsListingBySource_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetMlsListingsBySourceRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1071,6 +1635,27 @@ func (x *mlsListingServiceStreamMlsListingByPostalCodeServer) Send(m *MlsListing
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MlsListingService_ExportMlsListings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMlsListingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MlsListingServiceServer).ExportMlsListings(m, &mlsListingServiceExportMlsListingsServer{stream})
+}
+
+type MlsListingService_ExportMlsListingsServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type mlsListingServiceExportMlsListingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mlsListingServiceExportMlsListingsServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MlsListingService_StreamMlsListingEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamMlsListingEventRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1092,6 +1677,24 @@ func (x *mlsListingServiceStreamMlsListingEventServer) Send(m *StreamMlsListingE
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MlsListingService_AckMlsListingEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMlsListingEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).AckMlsListingEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/AckMlsListingEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).AckMlsListingEvent(ctx, req.(*AckMlsListingEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MlsListingService_SearchMlsListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchMlsListingsRequest)
 	if err := dec(in); err != nil {
@@ -1128,6 +1731,222 @@ func _MlsListingService_GetRealogyListings_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MlsListingService_GetMlsListingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMlsListingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetMlsListingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetMlsListingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetMlsListingHistory(ctx, req.(*GetMlsListingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_GetListingDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetListingDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetListingDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetListingDuplicates(ctx, req.(*GetListingDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_GetStatusLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).GetStatusLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/GetStatusLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).GetStatusLifecycle(ctx, req.(*GetStatusLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_QueryMlsListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMlsListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).QueryMlsListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/QueryMlsListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).QueryMlsListings(ctx, req.(*QueryMlsListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_CreateMlsListingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMlsListingWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).CreateMlsListingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/CreateMlsListingWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).CreateMlsListingWebhook(ctx, req.(*CreateMlsListingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_ListMlsListingWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMlsListingWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).ListMlsListingWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).ListMlsListingWebhooks(ctx, req.(*ListMlsListingWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_PatchMlsListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchMlsListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).PatchMlsListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/PatchMlsListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).PatchMlsListing(ctx, req.(*PatchMlsListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_DeleteMlsListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMlsListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).DeleteMlsListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/DeleteMlsListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).DeleteMlsListing(ctx, req.(*DeleteMlsListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_RestoreMlsListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMlsListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).RestoreMlsListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/RestoreMlsListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).RestoreMlsListing(ctx, req.(*RestoreMlsListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_DeleteMlsListingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMlsListingWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).DeleteMlsListingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/DeleteMlsListingWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).DeleteMlsListingWebhook(ctx, req.(*DeleteMlsListingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_ListMlsListingWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMlsListingWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).ListMlsListingWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/ListMlsListingWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).ListMlsListingWebhookDeadLetters(ctx, req.(*ListMlsListingWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingService_ReplayMlsListingWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayMlsListingWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingServiceServer).ReplayMlsListingWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingService/ReplayMlsListingWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingServiceServer).ReplayMlsListingWebhookDeadLetters(ctx, req.(*ReplayMlsListingWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MlsListingService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthRequest)
 	if err := dec(in); err != nil {
@@ -1165,6 +1984,10 @@ var MlsListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddMlsListings",
 			Handler:    _MlsListingService_AddMlsListings_Handler,
 		},
+		{
+			MethodName: "BulkUpsertMlsListings",
+			Handler:    _MlsListingService_BulkUpsertMlsListings_Handler,
+		},
 		{
 			MethodName: "GetMlsListingByListingGuid",
 			Handler:    _MlsListingService_GetMlsListingByListingGuid_Handler,
@@ -1209,6 +2032,26 @@ var MlsListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMlsListingsBySubdivision",
 			Handler:    _MlsListingService_GetMlsListingsBySubdivision_Handler,
 		},
+		{
+			MethodName: "SearchMlsListingsByGeo",
+			Handler:    _MlsListingService_SearchMlsListingsByGeo_Handler,
+		},
+		{
+			MethodName: "GetUpcomingOpenHouses",
+			Handler:    _MlsListingService_GetUpcomingOpenHouses_Handler,
+		},
+		{
+			MethodName: "ListAgents",
+			Handler:    _MlsListingService_ListAgents_Handler,
+		},
+		{
+			MethodName: "GetAgent",
+			Handler:    _MlsListingService_GetAgent_Handler,
+		},
+		{
+			MethodName: "ListOffices",
+			Handler:    _MlsListingService_ListOffices_Handler,
+		},
 		{
 			MethodName: "GetMlsListingsByCompanyMasterId",
 			Handler:    _MlsListingService_GetMlsListingsByCompanyMasterId_Handler,
@@ -1225,6 +2068,14 @@ var MlsListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMlsSoldListings",
 			Handler:    _MlsListingService_GetMlsSoldListings_Handler,
 		},
+		{
+			MethodName: "GetComparableSales",
+			Handler:    _MlsListingService_GetComparableSales_Handler,
+		},
+		{
+			MethodName: "AckMlsListingEvent",
+			Handler:    _MlsListingService_AckMlsListingEvent_Handler,
+		},
 		{
 			MethodName: "SearchMlsListings",
 			Handler:    _MlsListingService_SearchMlsListings_Handler,
@@ -1233,6 +2084,54 @@ var MlsListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRealogyListings",
 			Handler:    _MlsListingService_GetRealogyListings_Handler,
 		},
+		{
+			MethodName: "GetMlsListingHistory",
+			Handler:    _MlsListingService_GetMlsListingHistory_Handler,
+		},
+		{
+			MethodName: "GetListingDuplicates",
+			Handler:    _MlsListingService_GetListingDuplicates_Handler,
+		},
+		{
+			MethodName: "GetStatusLifecycle",
+			Handler:    _MlsListingService_GetStatusLifecycle_Handler,
+		},
+		{
+			MethodName: "QueryMlsListings",
+			Handler:    _MlsListingService_QueryMlsListings_Handler,
+		},
+		{
+			MethodName: "CreateMlsListingWebhook",
+			Handler:    _MlsListingService_CreateMlsListingWebhook_Handler,
+		},
+		{
+			MethodName: "ListMlsListingWebhooks",
+			Handler:    _MlsListingService_ListMlsListingWebhooks_Handler,
+		},
+		{
+			MethodName: "PatchMlsListing",
+			Handler:    _MlsListingService_PatchMlsListing_Handler,
+		},
+		{
+			MethodName: "DeleteMlsListing",
+			Handler:    _MlsListingService_DeleteMlsListing_Handler,
+		},
+		{
+			MethodName: "RestoreMlsListing",
+			Handler:    _MlsListingService_RestoreMlsListing_Handler,
+		},
+		{
+			MethodName: "DeleteMlsListingWebhook",
+			Handler:    _MlsListingService_DeleteMlsListingWebhook_Handler,
+		},
+		{
+			MethodName: "ListMlsListingWebhookDeadLetters",
+			Handler:    _MlsListingService_ListMlsListingWebhookDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayMlsListingWebhookDeadLetters",
+			Handler:    _MlsListingService_ReplayMlsListingWebhookDeadLetters_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _MlsListingService_HealthCheck_Handler,
@@ -1259,6 +2158,11 @@ var MlsListingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MlsListingService_StreamMlsListingByPostalCode_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportMlsListings",
+			Handler:       _MlsListingService_ExportMlsListings_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamMlsListingEvent",
 			Handler:       _MlsListingService_StreamMlsListingEvent_Handler,
@@ -0,0 +1,1567 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: realogy/api/mls/v1/mls_listings_stats.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The kind of areas of market statistics.
+type MarketAreaType int32
+
+const (
+	MarketAreaType_POSTAL_CODE    MarketAreaType = 0
+	MarketAreaType_CITY           MarketAreaType = 1
+	MarketAreaType_SUBDIVISION    MarketAreaType = 2
+	MarketAreaType_MLS_AREA_MAJOR MarketAreaType = 3
+)
+
+// Enum value maps for MarketAreaType.
+var (
+	MarketAreaType_name = map[int32]string{
+		0: "POSTAL_CODE",
+		1: "CITY",
+		2: "SUBDIVISION",
+		3: "MLS_AREA_MAJOR",
+	}
+	MarketAreaType_value = map[string]int32{
+		"POSTAL_CODE":    0,
+		"CITY":           1,
+		"SUBDIVISION":    2,
+		"MLS_AREA_MAJOR": 3,
+	}
+)
+
+func (x MarketAreaType) Enum() *MarketAreaType {
+	p := new(MarketAreaType)
+	*p = x
+	return p
+}
+
+func (x MarketAreaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketAreaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketAreaType) Type() protoreflect.EnumType {
+	return &file_realogy_api_mls_v1_mls_listings_stats_proto_enumTypes[0]
+}
+
+func (x MarketAreaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketAreaType.Descriptor instead.
+func (MarketAreaType) EnumDescriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{0}
+}
+
+// Request message for Mls Listings Stats for listing agent guid.
+type GetMlsListingsStatsByAgentGuidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingAgentGuid string `protobuf:"bytes,1,opt,name=listing_agent_guid,json=listingAgentGuid,proto3" json:"listing_agent_guid,omitempty" graphql:"listingAgentGuid,optional" bson:"listing_agent_guid"`
+}
+
+func (x *GetMlsListingsStatsByAgentGuidRequest) Reset() {
+	*x = GetMlsListingsStatsByAgentGuidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMlsListingsStatsByAgentGuidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMlsListingsStatsByAgentGuidRequest) ProtoMessage() {}
+
+func (x *GetMlsListingsStatsByAgentGuidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMlsListingsStatsByAgentGuidRequest.ProtoReflect.Descriptor instead.
+func (*GetMlsListingsStatsByAgentGuidRequest) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMlsListingsStatsByAgentGuidRequest) GetListingAgentGuid() string {
+	if x != nil {
+		return x.ListingAgentGuid
+	}
+	return ""
+}
+
+// Response message for Mls Listings Stats for listing agent guid.
+type GetMlsListingsStatsByAgentGuidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalListings int64 `protobuf:"varint,1,opt,name=total_listings,json=totalListings,proto3" json:"total_listings,omitempty" graphql:"totalListings,optional" bson:"total_listings"`
+}
+
+func (x *GetMlsListingsStatsByAgentGuidResponse) Reset() {
+	*x = GetMlsListingsStatsByAgentGuidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMlsListingsStatsByAgentGuidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMlsListingsStatsByAgentGuidResponse) ProtoMessage() {}
+
+func (x *GetMlsListingsStatsByAgentGuidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMlsListingsStatsByAgentGuidResponse.ProtoReflect.Descriptor instead.
+func (*GetMlsListingsStatsByAgentGuidResponse) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMlsListingsStatsByAgentGuidResponse) GetTotalListings() int64 {
+	if x != nil {
+		return x.TotalListings
+	}
+	return 0
+}
+
+// Request message for Mls Listings Statistics by mls source.
+type GetMlsListingsStatsBySourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceSystemKey string `protobuf:"bytes,1,opt,name=source_system_key,json=sourceSystemKey,proto3" json:"source_system_key,omitempty"`
+}
+
+func (x *GetMlsListingsStatsBySourceRequest) Reset() {
+	*x = GetMlsListingsStatsBySourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMlsListingsStatsBySourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMlsListingsStatsBySourceRequest) ProtoMessage() {}
+
+func (x *GetMlsListingsStatsBySourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMlsListingsStatsBySourceRequest.ProtoReflect.Descriptor instead.
+func (*GetMlsListingsStatsBySourceRequest) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMlsListingsStatsBySourceRequest) GetSourceSystemKey() string {
+	if x != nil {
+		return x.SourceSystemKey
+	}
+	return ""
+}
+
+// Response message for Mls Listings Statistics.
+type GetMlsListingsStatsBySourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MlsListingsStats *MlsListingsStats `protobuf:"bytes,1,opt,name=mls_listings_stats,json=mlsListingsStats,proto3" json:"mls_listings_stats,omitempty"`
+}
+
+func (x *GetMlsListingsStatsBySourceResponse) Reset() {
+	*x = GetMlsListingsStatsBySourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMlsListingsStatsBySourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMlsListingsStatsBySourceResponse) ProtoMessage() {}
+
+func (x *GetMlsListingsStatsBySourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMlsListingsStatsBySourceResponse.ProtoReflect.Descriptor instead.
+func (*GetMlsListingsStatsBySourceResponse) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMlsListingsStatsBySourceResponse) GetMlsListingsStats() *MlsListingsStats {
+	if x != nil {
+		return x.MlsListingsStats
+	}
+	return nil
+}
+
+// Reserved message type for healthcheck.
+type StatsHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsHealthRequest) Reset() {
+	*x = StatsHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsHealthRequest) ProtoMessage() {}
+
+func (x *StatsHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsHealthRequest.ProtoReflect.Descriptor instead.
+func (*StatsHealthRequest) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{4}
+}
+
+// Response message type for health check.
+type HealthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *HealthReply) Reset() {
+	*x = HealthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthReply) ProtoMessage() {}
+
+func (x *HealthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthReply.ProtoReflect.Descriptor instead.
+func (*HealthReply) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HealthReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Total listings for each property type
+type ActiveListingsByPropertyType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyType string `protobuf:"bytes,1,opt,name=property_type,json=propertyType,proto3" json:"propertyType" graphql:"propertyType,optional" bson:"property_type"`
+	Count        int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count" graphql:"count,optional" bson:"count"`
+}
+
+func (x *ActiveListingsByPropertyType) Reset() {
+	*x = ActiveListingsByPropertyType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveListingsByPropertyType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveListingsByPropertyType) ProtoMessage() {}
+
+func (x *ActiveListingsByPropertyType) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveListingsByPropertyType.ProtoReflect.Descriptor instead.
+func (*ActiveListingsByPropertyType) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActiveListingsByPropertyType) GetPropertyType() string {
+	if x != nil {
+		return x.PropertyType
+	}
+	return ""
+}
+
+func (x *ActiveListingsByPropertyType) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// MLS Listings Statistics Data.
+type MlsListingsStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source                       string                          `protobuf:"bytes,1,opt,name=source,proto3" json:"source" graphql:"source,optional" bson:"source"`
+	TotalActiveListings          int32                           `protobuf:"varint,2,opt,name=total_active_listings,json=totalActiveListings,proto3" json:"totalActiveListings" graphql:"totalActiveListings,optional" bson:"total_active_listings"`
+	ActiveListingsByPropertyType []*ActiveListingsByPropertyType `protobuf:"bytes,3,rep,name=active_listings_by_property_type,json=activeListingsByPropertyType,proto3" json:"activeListingsByPropertyType" graphql:"activeListingsByPropertyType,optional" bson:"active_listings_by_property_type"`
+	ActiveListingsWithPhotos     int32                           `protobuf:"varint,4,opt,name=active_listings_with_photos,json=activeListingsWithPhotos,proto3" json:"activeListingsWithPhotos" graphql:"activeListingsWithPhotos,optional" bson:"active_listings_with_photos"`
+	ActiveListingsWithOpenHomes  int32                           `protobuf:"varint,5,opt,name=active_listings_with_open_homes,json=activeListingsWithOpenHomes,proto3" json:"activeListingsWithOpenHomes" graphql:"activeListingsWithOpenHomes,optional" bson:"active_listings_with_open_homes"`
+	ListingsLastUpdateTime       *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=listings_last_update_time,json=listingsLastUpdateTime,proto3" json:"listingsLastUpdateTime" graphql:"listingsLastUpdateTime,optional" bson:"listings_last_update_time"`
+	PhotosLastUpdateTime         *timestamppb.Timestamp          `protobuf:"bytes,7,opt,name=photos_last_update_time,json=photosLastUpdateTime,proto3" json:"photosLastUpdateTime" graphql:"photosLastUpdateTime,optional" bson:"photos_last_update_time"`
+	OpenHomesLastUpdateTime      *timestamppb.Timestamp          `protobuf:"bytes,8,opt,name=open_homes_last_update_time,json=openHomesLastUpdateTime,proto3" json:"openHomesLastUpdateTime" graphql:"openHomesLastUpdateTime,optional" bson:"open_homes_last_update_time"`
+}
+
+func (x *MlsListingsStats) Reset() {
+	*x = MlsListingsStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MlsListingsStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MlsListingsStats) ProtoMessage() {}
+
+func (x *MlsListingsStats) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MlsListingsStats.ProtoReflect.Descriptor instead.
+func (*MlsListingsStats) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MlsListingsStats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MlsListingsStats) GetTotalActiveListings() int32 {
+	if x != nil {
+		return x.TotalActiveListings
+	}
+	return 0
+}
+
+func (x *MlsListingsStats) GetActiveListingsByPropertyType() []*ActiveListingsByPropertyType {
+	if x != nil {
+		return x.ActiveListingsByPropertyType
+	}
+	return nil
+}
+
+func (x *MlsListingsStats) GetActiveListingsWithPhotos() int32 {
+	if x != nil {
+		return x.ActiveListingsWithPhotos
+	}
+	return 0
+}
+
+func (x *MlsListingsStats) GetActiveListingsWithOpenHomes() int32 {
+	if x != nil {
+		return x.ActiveListingsWithOpenHomes
+	}
+	return 0
+}
+
+func (x *MlsListingsStats) GetListingsLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListingsLastUpdateTime
+	}
+	return nil
+}
+
+func (x *MlsListingsStats) GetPhotosLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PhotosLastUpdateTime
+	}
+	return nil
+}
+
+func (x *MlsListingsStats) GetOpenHomesLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenHomesLastUpdateTime
+	}
+	return nil
+}
+
+// Request message for the market statistics of areas.
+type GetMarketStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AreaType MarketAreaType `protobuf:"varint,1,opt,name=area_type,json=areaType,proto3,enum=realogy.api.mls.v1.MarketAreaType" json:"areaType" graphql:"areaType,optional" bson:"area_type"`
+	// The areas, at least one. Also accepted as repeated "areas" query parameters.
+	Areas []string `protobuf:"bytes,2,rep,name=areas,proto3" json:"areas" graphql:"areas,optional" bson:"areas"`
+	// Narrows the areas to a state or province, e.g. for cities.
+	State           string `protobuf:"bytes,3,opt,name=state,proto3" json:"state" graphql:"state,optional" bson:"state"`
+	SourceSystemKey string `protobuf:"bytes,4,opt,name=source_system_key,json=sourceSystemKey,proto3" json:"sourceSystemKey" graphql:"sourceSystemKey,optional" bson:"source_system_key"`
+	// Only the listings of these property types.
+	PropertyTypes []string `protobuf:"bytes,5,rep,name=property_types,json=propertyTypes,proto3" json:"propertyTypes" graphql:"propertyTypes,optional" bson:"property_types"`
+	// The start of the window. Defaults to 30 days before the end time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"startTime" graphql:"startTime,optional" bson:"start_time"`
+	// The end of the window. Defaults to now.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"endTime" graphql:"endTime,optional" bson:"end_time"`
+}
+
+func (x *GetMarketStatsRequest) Reset() {
+	*x = GetMarketStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatsRequest) ProtoMessage() {}
+
+func (x *GetMarketStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketStatsRequest) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMarketStatsRequest) GetAreaType() MarketAreaType {
+	if x != nil {
+		return x.AreaType
+	}
+	return MarketAreaType_POSTAL_CODE
+}
+
+func (x *GetMarketStatsRequest) GetAreas() []string {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *GetMarketStatsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetMarketStatsRequest) GetSourceSystemKey() string {
+	if x != nil {
+		return x.SourceSystemKey
+	}
+	return ""
+}
+
+func (x *GetMarketStatsRequest) GetPropertyTypes() []string {
+	if x != nil {
+		return x.PropertyTypes
+	}
+	return nil
+}
+
+func (x *GetMarketStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetMarketStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// Market statistics of an area, for a property type or all of them.
+type MarketStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the statistics of all the property types of the area.
+	PropertyType string `protobuf:"bytes,1,opt,name=property_type,json=propertyType,proto3" json:"propertyType" graphql:"propertyType,optional" bson:"property_type"`
+	// Listings with an ACTIVE status.
+	ActiveInventory int32 `protobuf:"varint,2,opt,name=active_inventory,json=activeInventory,proto3" json:"activeInventory" graphql:"activeInventory,optional" bson:"active_inventory"`
+	// Listings with a listing contract date in the window.
+	NewListings int32 `protobuf:"varint,3,opt,name=new_listings,json=newListings,proto3" json:"newListings" graphql:"newListings,optional" bson:"new_listings"`
+	// Listings with a PENDING status.
+	PendingCount int32 `protobuf:"varint,4,opt,name=pending_count,json=pendingCount,proto3" json:"pendingCount" graphql:"pendingCount,optional" bson:"pending_count"`
+	// Listings with a SOLD status and a close date in the window.
+	SoldCount int32 `protobuf:"varint,5,opt,name=sold_count,json=soldCount,proto3" json:"soldCount" graphql:"soldCount,optional" bson:"sold_count"`
+	// The list prices of the active inventory.
+	MedianListPrice  float64 `protobuf:"fixed64,6,opt,name=median_list_price,json=medianListPrice,proto3" json:"medianListPrice" graphql:"medianListPrice,optional" bson:"median_list_price"`
+	AverageListPrice float64 `protobuf:"fixed64,7,opt,name=average_list_price,json=averageListPrice,proto3" json:"averageListPrice" graphql:"averageListPrice,optional" bson:"average_list_price"`
+	// The close prices of the sales.
+	MedianClosePrice  float64 `protobuf:"fixed64,8,opt,name=median_close_price,json=medianClosePrice,proto3" json:"medianClosePrice" graphql:"medianClosePrice,optional" bson:"median_close_price"`
+	AverageClosePrice float64 `protobuf:"fixed64,9,opt,name=average_close_price,json=averageClosePrice,proto3" json:"averageClosePrice" graphql:"averageClosePrice,optional" bson:"average_close_price"`
+	// The days on market of the sales.
+	MedianDaysOnMarket float64 `protobuf:"fixed64,10,opt,name=median_days_on_market,json=medianDaysOnMarket,proto3" json:"medianDaysOnMarket" graphql:"medianDaysOnMarket,optional" bson:"median_days_on_market"`
+	// The months the active inventory would take to sell at the monthly sales of the window. 0 without sales.
+	MonthsOfSupply float64 `protobuf:"fixed64,11,opt,name=months_of_supply,json=monthsOfSupply,proto3" json:"monthsOfSupply" graphql:"monthsOfSupply,optional" bson:"months_of_supply"`
+	// The average ratio of the close price to the list price of the sales, e.g. 0.98.
+	ListToSaleRatio float64 `protobuf:"fixed64,12,opt,name=list_to_sale_ratio,json=listToSaleRatio,proto3" json:"listToSaleRatio" graphql:"listToSaleRatio,optional" bson:"list_to_sale_ratio"`
+}
+
+func (x *MarketStats) Reset() {
+	*x = MarketStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStats) ProtoMessage() {}
+
+func (x *MarketStats) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStats.ProtoReflect.Descriptor instead.
+func (*MarketStats) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarketStats) GetPropertyType() string {
+	if x != nil {
+		return x.PropertyType
+	}
+	return ""
+}
+
+func (x *MarketStats) GetActiveInventory() int32 {
+	if x != nil {
+		return x.ActiveInventory
+	}
+	return 0
+}
+
+func (x *MarketStats) GetNewListings() int32 {
+	if x != nil {
+		return x.NewListings
+	}
+	return 0
+}
+
+func (x *MarketStats) GetPendingCount() int32 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *MarketStats) GetSoldCount() int32 {
+	if x != nil {
+		return x.SoldCount
+	}
+	return 0
+}
+
+func (x *MarketStats) GetMedianListPrice() float64 {
+	if x != nil {
+		return x.MedianListPrice
+	}
+	return 0
+}
+
+func (x *MarketStats) GetAverageListPrice() float64 {
+	if x != nil {
+		return x.AverageListPrice
+	}
+	return 0
+}
+
+func (x *MarketStats) GetMedianClosePrice() float64 {
+	if x != nil {
+		return x.MedianClosePrice
+	}
+	return 0
+}
+
+func (x *MarketStats) GetAverageClosePrice() float64 {
+	if x != nil {
+		return x.AverageClosePrice
+	}
+	return 0
+}
+
+func (x *MarketStats) GetMedianDaysOnMarket() float64 {
+	if x != nil {
+		return x.MedianDaysOnMarket
+	}
+	return 0
+}
+
+func (x *MarketStats) GetMonthsOfSupply() float64 {
+	if x != nil {
+		return x.MonthsOfSupply
+	}
+	return 0
+}
+
+func (x *MarketStats) GetListToSaleRatio() float64 {
+	if x != nil {
+		return x.ListToSaleRatio
+	}
+	return 0
+}
+
+// Market statistics of an area.
+type MarketAreaStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area           string         `protobuf:"bytes,1,opt,name=area,proto3" json:"area" graphql:"area,optional" bson:"area"`
+	Total          *MarketStats   `protobuf:"bytes,2,opt,name=total,proto3" json:"total" graphql:"total,optional" bson:"total"`
+	ByPropertyType []*MarketStats `protobuf:"bytes,3,rep,name=by_property_type,json=byPropertyType,proto3" json:"byPropertyType" graphql:"byPropertyType,optional" bson:"by_property_type"`
+}
+
+func (x *MarketAreaStats) Reset() {
+	*x = MarketAreaStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketAreaStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAreaStats) ProtoMessage() {}
+
+func (x *MarketAreaStats) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketAreaStats.ProtoReflect.Descriptor instead.
+func (*MarketAreaStats) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarketAreaStats) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *MarketAreaStats) GetTotal() *MarketStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *MarketAreaStats) GetByPropertyType() []*MarketStats {
+	if x != nil {
+		return x.ByPropertyType
+	}
+	return nil
+}
+
+// Response message for the market statistics of areas.
+type GetMarketStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AreaType  MarketAreaType         `protobuf:"varint,1,opt,name=area_type,json=areaType,proto3,enum=realogy.api.mls.v1.MarketAreaType" json:"areaType" graphql:"areaType,optional" bson:"area_type"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"startTime" graphql:"startTime,optional" bson:"start_time"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"endTime" graphql:"endTime,optional" bson:"end_time"`
+	// The areas with listings, in the order of the request.
+	Areas []*MarketAreaStats `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas" graphql:"areas,optional" bson:"areas"`
+	// When the statistics were computed, they may be served from the cache until their time to live.
+	GeneratedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_time,json=generatedTime,proto3" json:"generatedTime" graphql:"generatedTime,optional" bson:"generated_time"`
+}
+
+func (x *GetMarketStatsResponse) Reset() {
+	*x = GetMarketStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatsResponse) ProtoMessage() {}
+
+func (x *GetMarketStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketStatsResponse) Descriptor() ([]byte, []int) {
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMarketStatsResponse) GetAreaType() MarketAreaType {
+	if x != nil {
+		return x.AreaType
+	}
+	return MarketAreaType_POSTAL_CODE
+}
+
+func (x *GetMarketStatsResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetMarketStatsResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetMarketStatsResponse) GetAreas() []*MarketAreaStats {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *GetMarketStatsResponse) GetGeneratedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedTime
+	}
+	return nil
+}
+
+var File_realogy_api_mls_v1_mls_listings_stats_proto protoreflect.FileDescriptor
+
+var file_realogy_api_mls_v1_mls_listings_stats_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6c,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x72,
+	0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6c, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x99, 0x01, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x70, 0x0a, 0x12, 0x6c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x9a, 0x84, 0x9e, 0x03, 0x3d, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x22, 0x52, 0x10, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x22, 0x8c, 0x01,
+	0x0a, 0x26, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x3b, 0x9a, 0x84, 0x9e, 0x03, 0x36, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x50, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x79,
+	0x0a, 0x23, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x6d, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x6d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x25, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x1c, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x72, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d,
+	0x9a, 0x84, 0x9e, 0x03, 0x48, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x37, 0x9a, 0x84, 0x9e, 0x03,
+	0x32, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcb, 0x0b, 0x0a, 0x10, 0x4d,
+	0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x52, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3a, 0x9a, 0x84, 0x9e, 0x03, 0x35, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x20, 0x62, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x63, 0x9a, 0x84, 0x9e, 0x03, 0x5e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22,
+	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xfb, 0x01,
+	0x0a, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f,
+	0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x80, 0x01, 0x9a, 0x84, 0x9e,
+	0x03, 0x7b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x1c, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0xb2, 0x01, 0x0a, 0x1b,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x73, 0x9a, 0x84, 0x9e, 0x03, 0x6e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a,
+	0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0x52, 0x18, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73,
+	0x12, 0xc3, 0x01, 0x0a, 0x1f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x68,
+	0x6f, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x7d, 0x9a, 0x84, 0x9e, 0x03,
+	0x78, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x65, 0x6e,
+	0x48, 0x6f, 0x6d, 0x65, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x6f, 0x6d, 0x65,
+	0x73, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x1b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x65,
+	0x6e, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0xc4, 0x01, 0x0a, 0x19, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x6d, 0x9a, 0x84, 0x9e, 0x03, 0x68, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0xba, 0x01,
+	0x0a, 0x17, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x67, 0x9a, 0x84, 0x9e,
+	0x03, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x73, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x2c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x52, 0x14, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x4c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0xcb, 0x01, 0x0a, 0x1b, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x71, 0x9a, 0x84,
+	0x9e, 0x03, 0x6c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x6e,
+	0x48, 0x6f, 0x6d, 0x65, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x4c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52,
+	0x17, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x6f, 0x6d, 0x65, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb1, 0x06, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x09, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x42, 0x41, 0x9a, 0x84, 0x9e, 0x03,
+	0x3c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0x9a, 0x84, 0x9e, 0x03, 0x32, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61,
+	0x73, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x73, 0x22, 0x52,
+	0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x9a, 0x84, 0x9e, 0x03, 0x32, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x57, 0x9a, 0x84, 0x9e, 0x03, 0x52, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a,
+	0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4b, 0x65, 0x79,
+	0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4b, 0x65, 0x79,
+	0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x77, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x50, 0x9a, 0x84, 0x9e, 0x03, 0x4b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x20,
+	0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x7f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x44, 0x9a, 0x84, 0x9e, 0x03, 0x3f, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x75, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x3e, 0x9a, 0x84, 0x9e, 0x03, 0x39, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x98, 0x0c, 0x0a,
+	0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x72, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4d, 0x9a, 0x84, 0x9e, 0x03, 0x48, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x2c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x20, 0x62, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x81, 0x01, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x56, 0x9a, 0x84, 0x9e,
+	0x03, 0x51, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x6d, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x4a, 0x9a, 0x84, 0x9e, 0x03,
+	0x45, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x72, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x4d, 0x9a, 0x84, 0x9e, 0x03,
+	0x48, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22,
+	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x0a, 0x73, 0x6f, 0x6c, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x44, 0x9a, 0x84, 0x9e,
+	0x03, 0x3f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x73, 0x6f, 0x6c, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20,
+	0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x83, 0x01, 0x0a,
+	0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x57, 0x9a, 0x84, 0x9e, 0x03, 0x52, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x52, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x5a, 0x9a, 0x84, 0x9e, 0x03, 0x55, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x52, 0x10, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01,
+	0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x5a, 0x9a, 0x84, 0x9e, 0x03,
+	0x55, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x52, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x13, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x5d, 0x9a, 0x84, 0x9e, 0x03, 0x58, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x15, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x61, 0x9a, 0x84, 0x9e, 0x03, 0x5c, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x61,
+	0x79, 0x73, 0x4f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x44, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x20, 0x62,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
+	0x7e, 0x0a, 0x10, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x54, 0x9a, 0x84, 0x9e, 0x03, 0x4f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x4f,
+	0x66, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x4f, 0x66,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x52,
+	0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x4f, 0x66, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x85, 0x01, 0x0a, 0x12, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x61, 0x6c, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x58, 0x9a, 0x84,
+	0x9e, 0x03, 0x53, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x2c, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x53, 0x61, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x20, 0x62, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x53, 0x61,
+	0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x9a, 0x84, 0x9e, 0x03, 0x2f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x2c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72,
+	0x65, 0x61, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x22, 0x52,
+	0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x6e, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x37, 0x9a, 0x84, 0x9e, 0x03, 0x32, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x42, 0x54, 0x9a, 0x84, 0x9e, 0x03, 0x4f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a,
+	0x22, 0x62, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x2c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x62, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x20,
+	0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9f, 0x05, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x09, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x42, 0x41, 0x9a, 0x84, 0x9e, 0x03,
+	0x3c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x7f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x44, 0x9a, 0x84, 0x9e, 0x03, 0x3f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x75, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x3e, 0x9a, 0x84, 0x9e, 0x03, 0x39, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x72, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x42, 0x37, 0x9a, 0x84, 0x9e, 0x03, 0x32, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x73, 0x22, 0x20,
+	0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x72, 0x65, 0x61, 0x73, 0x22, 0x52, 0x05, 0x61, 0x72,
+	0x65, 0x61, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x50, 0x9a, 0x84, 0x9e, 0x03, 0x4b, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x20, 0x62, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x50, 0x0a, 0x0e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x4f, 0x53, 0x54, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x44, 0x49, 0x56,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4c, 0x53, 0x5f, 0x41,
+	0x52, 0x45, 0x41, 0x5f, 0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x10, 0x03, 0x32, 0xbf, 0x05, 0x0a, 0x17,
+	0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d,
+	0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x36, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x12, 0x2e, 0x2f, 0x6d, 0x6c, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x7b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x7d,
+	0x12, 0xd4, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x47,
+	0x75, 0x69, 0x64, 0x12, 0x39, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6c, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x79, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x47, 0x75,
+	0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x35, 0x12, 0x33, 0x2f, 0x6d, 0x6c, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x75,
+	0x69, 0x64, 0x2f, 0x7b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6d, 0x6c, 0x73, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x76, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x32, 0x0a,
+	0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x16, 0x72, 0x65, 0x61, 0x6c, 0x6f,
+	0x67, 0x79, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6c, 0x73, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescOnce sync.Once
+	file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescData = file_realogy_api_mls_v1_mls_listings_stats_proto_rawDesc
+)
+
+func file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescGZIP() []byte {
+	file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescOnce.Do(func() {
+		file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescData)
+	})
+	return file_realogy_api_mls_v1_mls_listings_stats_proto_rawDescData
+}
+
+var file_realogy_api_mls_v1_mls_listings_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_realogy_api_mls_v1_mls_listings_stats_proto_goTypes = []interface{}{
+	(MarketAreaType)(0),                            // 0: realogy.api.mls.v1.MarketAreaType
+	(*GetMlsListingsStatsByAgentGuidRequest)(nil),  // 1: realogy.api.mls.v1.GetMlsListingsStatsByAgentGuidRequest
+	(*GetMlsListingsStatsByAgentGuidResponse)(nil), // 2: realogy.api.mls.v1.GetMlsListingsStatsByAgentGuidResponse
+	(*GetMlsListingsStatsBySourceRequest)(nil),     // 3: realogy.api.mls.v1.GetMlsListingsStatsBySourceRequest
+	(*GetMlsListingsStatsBySourceResponse)(nil),    // 4: realogy.api.mls.v1.GetMlsListingsStatsBySourceResponse
+	(*StatsHealthRequest)(nil),                     // 5: realogy.api.mls.v1.StatsHealthRequest
+	(*HealthReply)(nil),                            // 6: realogy.api.mls.v1.HealthReply
+	(*ActiveListingsByPropertyType)(nil),           // 7: realogy.api.mls.v1.ActiveListingsByPropertyType
+	(*MlsListingsStats)(nil),                       // 8: realogy.api.mls.v1.MlsListingsStats
+	(*GetMarketStatsRequest)(nil),                  // 9: realogy.api.mls.v1.GetMarketStatsRequest
+	(*MarketStats)(nil),                            // 10: realogy.api.mls.v1.MarketStats
+	(*MarketAreaStats)(nil),                        // 11: realogy.api.mls.v1.MarketAreaStats
+	(*GetMarketStatsResponse)(nil),                 // 12: realogy.api.mls.v1.GetMarketStatsResponse
+	(*timestamppb.Timestamp)(nil),                  // 13: google.protobuf.Timestamp
+}
+var file_realogy_api_mls_v1_mls_listings_stats_proto_depIdxs = []int32{
+	8,  // 0: realogy.api.mls.v1.GetMlsListingsStatsBySourceResponse.mls_listings_stats:type_name -> realogy.api.mls.v1.MlsListingsStats
+	7,  // 1: realogy.api.mls.v1.MlsListingsStats.active_listings_by_property_type:type_name -> realogy.api.mls.v1.ActiveListingsByPropertyType
+	13, // 2: realogy.api.mls.v1.MlsListingsStats.listings_last_update_time:type_name -> google.protobuf.Timestamp
+	13, // 3: realogy.api.mls.v1.MlsListingsStats.photos_last_update_time:type_name -> google.protobuf.Timestamp
+	13, // 4: realogy.api.mls.v1.MlsListingsStats.open_homes_last_update_time:type_name -> google.protobuf.Timestamp
+	0,  // 5: realogy.api.mls.v1.GetMarketStatsRequest.area_type:type_name -> realogy.api.mls.v1.MarketAreaType
+	13, // 6: realogy.api.mls.v1.GetMarketStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	13, // 7: realogy.api.mls.v1.GetMarketStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	10, // 8: realogy.api.mls.v1.MarketAreaStats.total:type_name -> realogy.api.mls.v1.MarketStats
+	10, // 9: realogy.api.mls.v1.MarketAreaStats.by_property_type:type_name -> realogy.api.mls.v1.MarketStats
+	0,  // 10: realogy.api.mls.v1.GetMarketStatsResponse.area_type:type_name -> realogy.api.mls.v1.MarketAreaType
+	13, // 11: realogy.api.mls.v1.GetMarketStatsResponse.start_time:type_name -> google.protobuf.Timestamp
+	13, // 12: realogy.api.mls.v1.GetMarketStatsResponse.end_time:type_name -> google.protobuf.Timestamp
+	11, // 13: realogy.api.mls.v1.GetMarketStatsResponse.areas:type_name -> realogy.api.mls.v1.MarketAreaStats
+	13, // 14: realogy.api.mls.v1.GetMarketStatsResponse.generated_time:type_name -> google.protobuf.Timestamp
+	3,  // 15: realogy.api.mls.v1.MlsListingsStatsService.GetMlsListingsStatsBySource:input_type -> realogy.api.mls.v1.GetMlsListingsStatsBySourceRequest
+	1,  // 16: realogy.api.mls.v1.MlsListingsStatsService.GetMlsListingsStatsByAgentGuid:input_type -> realogy.api.mls.v1.GetMlsListingsStatsByAgentGuidRequest
+	9,  // 17: realogy.api.mls.v1.MlsListingsStatsService.GetMarketStats:input_type -> realogy.api.mls.v1.GetMarketStatsRequest
+	5,  // 18: realogy.api.mls.v1.MlsListingsStatsService.HealthCheck:input_type -> realogy.api.mls.v1.StatsHealthRequest
+	4,  // 19: realogy.api.mls.v1.MlsListingsStatsService.GetMlsListingsStatsBySource:output_type -> realogy.api.mls.v1.GetMlsListingsStatsBySourceResponse
+	2,  // 20: realogy.api.mls.v1.MlsListingsStatsService.GetMlsListingsStatsByAgentGuid:output_type -> realogy.api.mls.v1.GetMlsListingsStatsByAgentGuidResponse
+	12, // 21: realogy.api.mls.v1.MlsListingsStatsService.GetMarketStats:output_type -> realogy.api.mls.v1.GetMarketStatsResponse
+	6,  // 22: realogy.api.mls.v1.MlsListingsStatsService.HealthCheck:output_type -> realogy.api.mls.v1.HealthReply
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_realogy_api_mls_v1_mls_listings_stats_proto_init() }
+func file_realogy_api_mls_v1_mls_listings_stats_proto_init() {
+	if File_realogy_api_mls_v1_mls_listings_stats_proto != nil {
+		return
+	}
+	file_realogy_api_mls_v1_tagger_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMlsListingsStatsByAgentGuidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMlsListingsStatsByAgentGuidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMlsListingsStatsBySourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMlsListingsStatsBySourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveListingsByPropertyType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MlsListingsStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAreaStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_realogy_api_mls_v1_mls_listings_stats_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_realogy_api_mls_v1_mls_listings_stats_proto_goTypes,
+		DependencyIndexes: file_realogy_api_mls_v1_mls_listings_stats_proto_depIdxs,
+		EnumInfos:         file_realogy_api_mls_v1_mls_listings_stats_proto_enumTypes,
+		MessageInfos:      file_realogy_api_mls_v1_mls_listings_stats_proto_msgTypes,
+	}.Build()
+	File_realogy_api_mls_v1_mls_listings_stats_proto = out.File
+	file_realogy_api_mls_v1_mls_listings_stats_proto_rawDesc = nil
+	file_realogy_api_mls_v1_mls_listings_stats_proto_goTypes = nil
+	file_realogy_api_mls_v1_mls_listings_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: realogy/api/mls/v1/mls_listings_stats.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MlsListingsStatsServiceClient is the client API for MlsListingsStatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MlsListingsStatsServiceClient interface {
+	// Get Mls Listings Statistics for a given mls source.
+	GetMlsListingsStatsBySource(ctx context.Context, in *GetMlsListingsStatsBySourceRequest, opts ...grpc.CallOption) (*GetMlsListingsStatsBySourceResponse, error)
+	// Get Mls Listings Statistics for a given listing agent guid.
+	GetMlsListingsStatsByAgentGuid(ctx context.Context, in *GetMlsListingsStatsByAgentGuidRequest, opts ...grpc.CallOption) (*GetMlsListingsStatsByAgentGuidResponse, error)
+	// Get the market statistics of areas, postal codes by default, over a time window, by property type and in total.
+	//The window defaults to the 30 days before now and can not be longer than 366 days. Active inventory and pending counts are the
+	//listings with that status now, new listings are those with a listing contract date in the window, and sales those closed in the window.
+	//Statistics are cached, for the time given by the Cache-Control header of the response.
+	GetMarketStats(ctx context.Context, in *GetMarketStatsRequest, opts ...grpc.CallOption) (*GetMarketStatsResponse, error)
+	// Health Check Mls Listings Statistics API.
+	HealthCheck(ctx context.Context, in *StatsHealthRequest, opts ...grpc.CallOption) (*HealthReply, error)
+}
+
+type mlsListingsStatsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMlsListingsStatsServiceClient(cc grpc.ClientConnInterface) MlsListingsStatsServiceClient {
+	return &mlsListingsStatsServiceClient{cc}
+}
+
+func (c *mlsListingsStatsServiceClient) GetMlsListingsStatsBySource(ctx context.Context, in *GetMlsListingsStatsBySourceRequest, opts ...grpc.CallOption) (*GetMlsListingsStatsBySourceResponse, error) {
+	out := new(GetMlsListingsStatsBySourceResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingsStatsService/GetMlsListingsStatsBySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingsStatsServiceClient) GetMlsListingsStatsByAgentGuid(ctx context.Context, in *GetMlsListingsStatsByAgentGuidRequest, opts ...grpc.CallOption) (*GetMlsListingsStatsByAgentGuidResponse, error) {
+	out := new(GetMlsListingsStatsByAgentGuidResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingsStatsService/GetMlsListingsStatsByAgentGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingsStatsServiceClient) GetMarketStats(ctx context.Context, in *GetMarketStatsRequest, opts ...grpc.CallOption) (*GetMarketStatsResponse, error) {
+	out := new(GetMarketStatsResponse)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingsStatsService/GetMarketStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mlsListingsStatsServiceClient) HealthCheck(ctx context.Context, in *StatsHealthRequest, opts ...grpc.CallOption) (*HealthReply, error) {
+	out := new(HealthReply)
+	err := c.cc.Invoke(ctx, "/realogy.api.mls.v1.MlsListingsStatsService/HealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MlsListingsStatsServiceServer is the server API for MlsListingsStatsService service.
+// All implementations must embed UnimplementedMlsListingsStatsServiceServer
+// for forward compatibility
+type MlsListingsStatsServiceServer interface {
+	// Get Mls Listings Statistics for a given mls source.
+	GetMlsListingsStatsBySource(context.Context, *GetMlsListingsStatsBySourceRequest) (*GetMlsListingsStatsBySourceResponse, error)
+	// Get Mls Listings Statistics for a given listing agent guid.
+	GetMlsListingsStatsByAgentGuid(context.Context, *GetMlsListingsStatsByAgentGuidRequest) (*GetMlsListingsStatsByAgentGuidResponse, error)
+	// Get the market statistics of areas, postal codes by default, over a time window, by property type and in total.
+	//The window defaults to the 30 days before now and can not be longer than 366 days. Active inventory and pending counts are the
+	//listings with that status now, new listings are those with a listing contract date in the window, and sales those closed in the window.
+	//Statistics are cached, for the time given by the Cache-Control header of the response.
+	GetMarketStats(context.Context, *GetMarketStatsRequest) (*GetMarketStatsResponse, error)
+	// Health Check Mls Listings Statistics API.
+	HealthCheck(context.Context, *StatsHealthRequest) (*HealthReply, error)
+	mustEmbedUnimplementedMlsListingsStatsServiceServer()
+}
+
+// UnimplementedMlsListingsStatsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMlsListingsStatsServiceServer struct {
+}
+
+func (UnimplementedMlsListingsStatsServiceServer) GetMlsListingsStatsBySource(context.Context, *GetMlsListingsStatsBySourceRequest) (*GetMlsListingsStatsBySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingsStatsBySource not implemented")
+}
+func (UnimplementedMlsListingsStatsServiceServer) GetMlsListingsStatsByAgentGuid(context.Context, *GetMlsListingsStatsByAgentGuidRequest) (*GetMlsListingsStatsByAgentGuidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMlsListingsStatsByAgentGuid not implemented")
+}
+func (UnimplementedMlsListingsStatsServiceServer) GetMarketStats(context.Context, *GetMarketStatsRequest) (*GetMarketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketStats not implemented")
+}
+func (UnimplementedMlsListingsStatsServiceServer) HealthCheck(context.Context, *StatsHealthRequest) (*HealthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedMlsListingsStatsServiceServer) mustEmbedUnimplementedMlsListingsStatsServiceServer() {
+}
+
+// UnsafeMlsListingsStatsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MlsListingsStatsServiceServer will
+// result in compilation errors.
+type UnsafeMlsListingsStatsServiceServer interface {
+	mustEmbedUnimplementedMlsListingsStatsServiceServer()
+}
+
+func RegisterMlsListingsStatsServiceServer(s grpc.ServiceRegistrar, srv MlsListingsStatsServiceServer) {
+	s.RegisterService(&MlsListingsStatsService_ServiceDesc, srv)
+}
+
+func _MlsListingsStatsService_GetMlsListingsStatsBySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMlsListingsStatsBySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingsStatsServiceServer).GetMlsListingsStatsBySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingsStatsService/GetMlsListingsStatsBySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingsStatsServiceServer).GetMlsListingsStatsBySource(ctx, req.(*GetMlsListingsStatsBySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingsStatsService_GetMlsListingsStatsByAgentGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMlsListingsStatsByAgentGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingsStatsServiceServer).GetMlsListingsStatsByAgentGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingsStatsService/GetMlsListingsStatsByAgentGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingsStatsServiceServer).GetMlsListingsStatsByAgentGuid(ctx, req.(*GetMlsListingsStatsByAgentGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingsStatsService_GetMarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingsStatsServiceServer).GetMarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingsStatsService/GetMarketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingsStatsServiceServer).GetMarketStats(ctx, req.(*GetMarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MlsListingsStatsService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MlsListingsStatsServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realogy.api.mls.v1.MlsListingsStatsService/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MlsListingsStatsServiceServer).HealthCheck(ctx, req.(*StatsHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MlsListingsStatsService_ServiceDesc is the grpc.ServiceDesc for MlsListingsStatsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MlsListingsStatsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "realogy.api.mls.v1.MlsListingsStatsService",
+	HandlerType: (*MlsListingsStatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMlsListingsStatsBySource",
+			Handler:    _MlsListingsStatsService_GetMlsListingsStatsBySource_Handler,
+		},
+		{
+			MethodName: "GetMlsListingsStatsByAgentGuid",
+			Handler:    _MlsListingsStatsService_GetMlsListingsStatsByAgentGuid_Handler,
+		},
+		{
+			MethodName: "GetMarketStats",
+			Handler:    _MlsListingsStatsService_GetMarketStats_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _MlsListingsStatsService_HealthCheck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "realogy/api/mls/v1/mls_listings_stats.proto",
+}
@@ -0,0 +1,78 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func listing(price float64, standardStatus string, lastChangeDate time.Time) *listingFields {
+	l := &listingFields{Id: "1", ListingId: "100095", SourceSystemKey: "AR_TMLS", LastChangeDate: lastChangeDate}
+	l.Property.Listing.Price.ListPrice = price
+	l.Property.Listing.StandardStatus = standardStatus
+	return l
+}
+
+func TestNewEntryFirst(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	entry := newEntry(nil, listing(100, "ACTIVE", time.Time{}), "insert", now)
+
+	assert.Equal(t, "100095", entry.ListingId)
+	assert.Equal(t, "AR_TMLS", entry.SourceSystemKey)
+	assert.Empty(t, entry.Changes)
+	assert.Equal(t, now, entry.ChangeTime)
+	assert.Equal(t, float64(100), entry.ListPrice)
+	assert.Equal(t, "ACTIVE", entry.StandardStatus)
+}
+
+func TestNewEntryUnchanged(t *testing.T) {
+	last := &Entry{ListPrice: 100, StandardStatus: "ACTIVE"}
+	assert.Nil(t, newEntry(last, listing(100, "ACTIVE", time.Now()), "update", time.Now()))
+}
+
+func TestNewEntryChanged(t *testing.T) {
+	changed := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	last := &Entry{ListPrice: 100, StandardStatus: "ACTIVE"}
+
+	entry := newEntry(last, listing(90, "ACTIVE", changed), "update", time.Now())
+	assert.Equal(t, []string{ListPriceChange}, entry.Changes)
+	assert.Equal(t, float64(100), entry.PreviousListPrice)
+	assert.Equal(t, changed, entry.ChangeTime)
+
+	entry = newEntry(last, listing(90, "PENDING", changed), "replace", time.Now())
+	assert.Equal(t, []string{ListPriceChange, StandardStatusChange}, entry.Changes)
+	assert.Equal(t, "ACTIVE", entry.PreviousStandardStatus)
+	assert.Equal(t, "replace", entry.ChangeType)
+}
+
+func TestSummarize(t *testing.T) {
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2022, 3, d, 0, 0, 0, 0, time.UTC))
+	}
+	entries := []*pb.MlsListingHistoryEntry{
+		{ChangeTime: day(1), ListPrice: 100, StandardStatus: "ACTIVE"},
+		{ChangeTime: day(3), Changes: []string{ListPriceChange}, ListPrice: 90, PreviousListPrice: 100, StandardStatus: "ACTIVE"},
+		{ChangeTime: day(5), Changes: []string{ListPriceChange}, ListPrice: 95, PreviousListPrice: 90, StandardStatus: "ACTIVE"},
+		{ChangeTime: day(6), Changes: []string{StandardStatusChange}, ListPrice: 95, StandardStatus: "PENDING", PreviousStandardStatus: "ACTIVE"},
+		{ChangeTime: day(8), Changes: []string{ListPriceChange, StandardStatusChange}, ListPrice: 85, PreviousListPrice: 95, StandardStatus: "ACTIVE", PreviousStandardStatus: "PENDING"},
+	}
+	summary := Summarize(entries, day(10).AsTime())
+
+	assert.Equal(t, int32(3), summary.PriceChangeCount)
+	assert.Equal(t, int32(2), summary.PriceReductionCount)
+	assert.Len(t, summary.DaysInStatus, 2)
+	assert.Equal(t, "ACTIVE", summary.DaysInStatus[0].StandardStatus)
+	assert.Equal(t, float64(7), summary.DaysInStatus[0].Days)
+	assert.Equal(t, "PENDING", summary.DaysInStatus[1].StandardStatus)
+	assert.Equal(t, float64(2), summary.DaysInStatus[1].Days)
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	summary := Summarize(nil, time.Now())
+	assert.Zero(t, summary.PriceChangeCount)
+	assert.Empty(t, summary.DaysInStatus)
+}
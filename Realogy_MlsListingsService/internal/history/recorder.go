@@ -0,0 +1,209 @@
+package history
+
+import (
+	"context"
+	"mlslisting/internal/derived"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ListPriceChange      = "list_price"
+	StandardStatusChange = "standard_status"
+
+	retryDelay = 10 * time.Second
+)
+
+// Entry is a price or status change of a listing as stored in the history collection.
+// Entries are decoded as pb.MlsListingHistoryEntry when read, the bson names of both must match.
+type Entry struct {
+	Id                     primitive.ObjectID `bson:"_id,omitempty"`
+	MlsId                  string             `bson:"mls_id"`
+	ListingId              string             `bson:"listing_id"`
+	SourceSystemKey        string             `bson:"source_system_key"`
+	ChangeTime             time.Time          `bson:"change_time"`
+	Changes                []string           `bson:"changes"`
+	ListPrice              float64            `bson:"list_price"`
+	PreviousListPrice      float64            `bson:"previous_list_price"`
+	StandardStatus         string             `bson:"standard_status"`
+	PreviousStandardStatus string             `bson:"previous_standard_status"`
+	ChangeType             string             `bson:"change_type"`
+	// change stream resume token of the listing event the entry was recorded from.
+	EventId    string    `bson:"event_id"`
+	RecordedAt time.Time `bson:"recorded_at"`
+}
+
+// listingEvent is a listing change event, projected to the fields the history is recorded from.
+type listingEvent struct {
+	EventData struct {
+		EventId string `bson:"_data"`
+	} `bson:"_id"`
+	EventType string         `bson:"operationType"`
+	Listing   *listingFields `bson:"fullDocument"`
+}
+
+type listingFields struct {
+	Id              string    `bson:"_id"`
+	ListingId       string    `bson:"listing_id"`
+	SourceSystemKey string    `bson:"source_system_key"`
+	LastChangeDate  time.Time `bson:"last_change_date"`
+	Property        struct {
+		Listing struct {
+			StandardStatus string `bson:"standard_status"`
+			Price          struct {
+				ListPrice float64 `bson:"list_price"`
+			} `bson:"price"`
+		} `bson:"listing"`
+	} `bson:"property"`
+}
+
+// Recorder records the price and status changes of listings from the change stream of the listings collection.
+// Every instance of the service may run a recorder, an event is recorded once (see the unique "event_id" index in db/mongodb_index.js).
+type Recorder struct {
+	listings *mongo.Collection
+	history  *mongo.Collection
+	now      func() time.Time
+}
+
+func NewRecorder(listings *mongo.Collection, history *mongo.Collection) *Recorder {
+	return &Recorder{listings: listings, history: history, now: time.Now}
+}
+
+// Run records listing changes until ctx is done. The change stream is resumed after the last recorded event,
+// or started from now when that event is no longer in the oplog.
+func (r *Recorder) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := r.watch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Listing history change stream failed, restarting. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (r *Recorder) watch(ctx context.Context) error {
+	pipeline := mongo.Pipeline{
+		derived.ChangeStage(),
+		bson.D{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}}}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "operationType", Value: 1},
+			{Key: "fullDocument._id", Value: 1},
+			{Key: "fullDocument.listing_id", Value: 1},
+			{Key: "fullDocument.source_system_key", Value: 1},
+			{Key: "fullDocument.last_change_date", Value: 1},
+			{Key: "fullDocument.property.listing.standard_status", Value: 1},
+			{Key: "fullDocument.property.listing.price.list_price", Value: 1},
+		}}},
+	}
+	changeStreamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+
+	resumeAfter, err := r.lastEventId(ctx)
+	if err != nil {
+		return err
+	}
+	if resumeAfter != "" {
+		changeStreamOptions.SetResumeAfter(bson.D{{Key: "_data", Value: resumeAfter}})
+	}
+	cs, err := r.listings.Watch(ctx, pipeline, changeStreamOptions)
+	if err != nil && resumeAfter != "" {
+		log.Warnf("Unable to resume listing history after %s, changes until now are not recorded. %v", resumeAfter, err)
+		cs, err = r.listings.Watch(ctx, pipeline, changeStreamOptions.SetResumeAfter(nil))
+	}
+	if err != nil {
+		return err
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var event listingEvent
+		if err := cs.Decode(&event); err != nil {
+			log.Errorf("Unable to decode listing event for history. %v", err)
+			continue
+		}
+		if err := r.record(ctx, &event); err != nil {
+			return err
+		}
+	}
+	return cs.Err()
+}
+
+// lastEventId returns the event the last entry was recorded from, empty when nothing is recorded yet.
+func (r *Recorder) lastEventId(ctx context.Context) (string, error) {
+	var last Entry
+	err := r.history.FindOne(ctx, bson.D{}, options.FindOne().SetSort(bson.D{{Key: "recorded_at", Value: -1}, {Key: "_id", Value: -1}})).Decode(&last)
+	if err == mongo.ErrNoDocuments {
+		return "", nil
+	}
+	return last.EventId, err
+}
+
+// record adds an entry when the price or status of the listing differs from its last entry.
+func (r *Recorder) record(ctx context.Context, event *listingEvent) error {
+	listing := event.Listing
+	if listing == nil { // the listing is deleted by the time the update is looked up.
+		return nil
+	}
+	var last *Entry
+	previous := Entry{}
+	err := r.history.FindOne(ctx,
+		bson.D{{Key: "listing_id", Value: listing.ListingId}, {Key: "source_system_key", Value: listing.SourceSystemKey}},
+		options.FindOne().SetSort(bson.D{{Key: "change_time", Value: -1}, {Key: "_id", Value: -1}})).Decode(&previous)
+	if err == nil {
+		last = &previous
+	} else if err != mongo.ErrNoDocuments {
+		return err
+	}
+
+	entry := newEntry(last, listing, event.EventType, r.now())
+	if entry == nil {
+		return nil
+	}
+	entry.EventId = event.EventData.EventId
+	if _, err := r.history.InsertOne(ctx, entry); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
+
+// newEntry returns the entry recording a listing against its last entry, nil when neither price nor status changed.
+func newEntry(last *Entry, listing *listingFields, changeType string, now time.Time) *Entry {
+	price := listing.Property.Listing.Price.ListPrice
+	standardStatus := listing.Property.Listing.StandardStatus
+
+	entry := &Entry{
+		MlsId:           listing.Id,
+		ListingId:       listing.ListingId,
+		SourceSystemKey: listing.SourceSystemKey,
+		ChangeTime:      listing.LastChangeDate,
+		Changes:         []string{},
+		ListPrice:       price,
+		StandardStatus:  standardStatus,
+		ChangeType:      changeType,
+		RecordedAt:      now,
+	}
+	if entry.ChangeTime.IsZero() {
+		entry.ChangeTime = now
+	}
+	if last == nil {
+		return entry
+	}
+	if last.ListPrice != price {
+		entry.Changes = append(entry.Changes, ListPriceChange)
+	}
+	if last.StandardStatus != standardStatus {
+		entry.Changes = append(entry.Changes, StandardStatusChange)
+	}
+	if len(entry.Changes) == 0 {
+		return nil
+	}
+	entry.PreviousListPrice = last.ListPrice
+	entry.PreviousStandardStatus = last.StandardStatus
+	return entry
+}
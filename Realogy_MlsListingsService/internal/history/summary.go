@@ -0,0 +1,47 @@
+package history
+
+import (
+	"time"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Summarize counts the price changes of a listing and the days it spent in each status. Entries are oldest first,
+// the current status counts until now.
+func Summarize(entries []*pb.MlsListingHistoryEntry, now time.Time) *pb.MlsListingHistorySummary {
+	summary := &pb.MlsListingHistorySummary{DaysInStatus: []*pb.MlsListingStatusDuration{}}
+	durations := map[string]*pb.MlsListingStatusDuration{}
+
+	for i, entry := range entries {
+		for _, change := range entry.Changes {
+			if change != ListPriceChange {
+				continue
+			}
+			summary.PriceChangeCount++
+			if entry.ListPrice < entry.PreviousListPrice {
+				summary.PriceReductionCount++
+			}
+		}
+
+		if i > 0 && entry.StandardStatus == entries[i-1].StandardStatus {
+			continue // the status did not change, its time is counted from the entry it changed at.
+		}
+		until := now
+		for _, next := range entries[i+1:] {
+			if next.StandardStatus != entry.StandardStatus {
+				until = next.ChangeTime.AsTime()
+				break
+			}
+		}
+		duration, ok := durations[entry.StandardStatus]
+		if !ok {
+			duration = &pb.MlsListingStatusDuration{StandardStatus: entry.StandardStatus}
+			durations[entry.StandardStatus] = duration
+			summary.DaysInStatus = append(summary.DaysInStatus, duration)
+		}
+		if days := until.Sub(entry.ChangeTime.AsTime()).Hours() / 24; days > 0 {
+			duration.Days += days
+		}
+	}
+	return summary
+}
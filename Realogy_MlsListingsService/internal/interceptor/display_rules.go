@@ -0,0 +1,169 @@
+package interceptor
+
+import (
+	"context"
+	"mlslisting/internal/displayrules"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// ApplyDisplayRulesHeader opts a request in to the display rules, as does the apply_display_rules field of a request.
+const ApplyDisplayRulesHeader = "apply-display-rules"
+
+var mlsListingName = (&pb.MlsListing{}).ProtoReflect().Descriptor().FullName()
+
+// DisplayRulesInterceptor redacts the listings of responses by the display rules of their MLS when a request opts in.
+type DisplayRulesInterceptor struct {
+	// nil when the display rules service is not configured.
+	rules *displayrules.Cache
+}
+
+func NewDisplayRulesInterceptor(rules *displayrules.Cache) *DisplayRulesInterceptor {
+	return &DisplayRulesInterceptor{rules: rules}
+}
+
+// UnaryDisplayRulesInterceptor redacts the listings of a unary response.
+func (i *DisplayRulesInterceptor) UnaryDisplayRulesInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	m, ok := req.(proto.Message)
+	if !ok || !applyDisplayRules(ctx, m) {
+		return handler(ctx, req)
+	}
+	if i.rules == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Display rules are not available.")
+	}
+	includeSourceSystemKey(m)
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		return res, err
+	}
+	if m, ok := res.(proto.Message); ok {
+		if err := i.redact(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// StreamDisplayRulesInterceptor redacts the listings of every streamed response.
+func (i *DisplayRulesInterceptor) StreamDisplayRulesInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &displayRulesStream{ServerStream: ss, interceptor: i})
+}
+
+type displayRulesStream struct {
+	grpc.ServerStream
+	interceptor *DisplayRulesInterceptor
+	apply       bool
+}
+
+func (s *displayRulesStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if req, ok := m.(proto.Message); ok && applyDisplayRules(s.Context(), req) {
+		if s.interceptor.rules == nil {
+			return status.Errorf(codes.FailedPrecondition, "Display rules are not available.")
+		}
+		includeSourceSystemKey(req)
+		s.apply = true
+	}
+	return nil
+}
+
+func (s *displayRulesStream) SendMsg(m interface{}) error {
+	if _, ok := m.(*httpbody.HttpBody); ok && s.apply {
+		// exports are sent as formatted rows, their listings can not be redacted.
+		return status.Errorf(codes.FailedPrecondition, "Display rules can not be applied to exports.")
+	}
+	if res, ok := m.(proto.Message); ok && s.apply {
+		if err := s.interceptor.redact(s.Context(), res); err != nil {
+			return err
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// redact applies the display rules to the listings of a response, wherever they are nested in it, e.g. the listings of
+// comparable sales. Listings are not returned when the rules can not be looked up.
+func (i *DisplayRulesInterceptor) redact(ctx context.Context, res proto.Message) error {
+	return i.redactMessage(ctx, res.ProtoReflect())
+}
+
+func (i *DisplayRulesInterceptor) redactMessage(ctx context.Context, m protoreflect.Message) error {
+	if m.Descriptor().FullName() == mlsListingName {
+		return i.redactListing(ctx, m.Interface().(*pb.MlsListing))
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for j := 0; j < list.Len() && err == nil; j++ {
+				err = i.redactMessage(ctx, list.Get(j).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					err = i.redactMessage(ctx, v.Message())
+					return err == nil
+				})
+			}
+		default:
+			err = i.redactMessage(ctx, v.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+func (i *DisplayRulesInterceptor) redactListing(ctx context.Context, listing *pb.MlsListing) error {
+	source := listing.GetProperty().GetListing().GetSourceSystemKey()
+	rules, err := i.rules.Rules(ctx, source)
+	if err != nil {
+		log.Errorf("Unable to get display rules of %s. %v", source, err)
+		return status.Errorf(codes.Unavailable, "Display rules are not available.")
+	}
+	displayrules.Redact(listing, rules)
+	return nil
+}
+
+// applyDisplayRules tells whether a request opted in to the display rules, by header or by its apply_display_rules field.
+func applyDisplayRules(ctx context.Context, req proto.Message) bool {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get(ApplyDisplayRulesHeader) {
+			if strings.EqualFold(v, "true") {
+				return true
+			}
+		}
+	}
+	m := req.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("apply_display_rules")
+	return fd != nil && fd.Kind() == protoreflect.BoolKind && m.Get(fd).Bool()
+}
+
+// includeSourceSystemKey adds the source system key to the field mask of a request, if any,
+// since the display rules of a listing are looked up by it.
+func includeSourceSystemKey(req proto.Message) {
+	m := req.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("fields")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != "google.protobuf.FieldMask" || !m.Has(fd) {
+		return
+	}
+	mask, ok := m.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask)
+	if !ok || len(mask.Paths) == 0 {
+		return
+	}
+	mask.Paths = append(mask.Paths, displayrules.SourceSystemKeyPath)
+}
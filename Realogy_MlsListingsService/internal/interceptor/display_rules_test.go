@@ -0,0 +1,52 @@
+package interceptor
+
+import (
+	"context"
+	"mlslisting/internal/displayrules"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// rulesClient returns the display rules of BRIGHTMLS, which hide the mls number.
+type rulesClient struct {
+	drpb.MlsDisplayRulesServiceClient
+}
+
+func (rulesClient) GetMlsDisplayRulesBySource(ctx context.Context, in *drpb.GetMlsDisplayRulesBySourceRequest, opts ...grpc.CallOption) (*drpb.GetMlsDisplayRulesBySourceResponse, error) {
+	return &drpb.GetMlsDisplayRulesBySourceResponse{MlsDisplayRules: &drpb.MlsDisplayRules{ShowMlsNumber: false}}, nil
+}
+
+func listing() *pb.MlsListing {
+	return &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{SourceSystemKey: "BRIGHTMLS", MlsListingId: "MDBC2000001"}}}
+}
+
+func TestRedactNestedListings(t *testing.T) {
+	i := NewDisplayRulesInterceptor(displayrules.NewCache(rulesClient{}, time.Minute))
+	unary := func(req interface{}, res interface{}) interface{} {
+		redacted, err := i.UnaryDisplayRulesInterceptor(context.Background(), req, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return res, nil })
+		assert.Nil(t, err)
+		return redacted
+	}
+
+	sales := unary(&pb.GetComparableSalesRequest{ApplyDisplayRules: true},
+		&pb.GetComparableSalesResponse{ComparableSales: []*pb.ComparableSale{{MlsListing: listing()}, {MlsListing: listing()}}}).(*pb.GetComparableSalesResponse)
+	for _, sale := range sales.ComparableSales {
+		assert.Empty(t, sale.MlsListing.Property.Listing.MlsListingId)
+	}
+
+	duplicates := unary(&pb.GetListingDuplicatesRequest{ApplyDisplayRules: true},
+		&pb.GetListingDuplicatesResponse{Duplicates: []*pb.ListingDuplicate{{MlsId: "BRIGHTMLS_1", MlsListing: listing()}}}).(*pb.GetListingDuplicatesResponse)
+	assert.Empty(t, duplicates.Duplicates[0].MlsListing.Property.Listing.MlsListingId)
+
+	// without opting in, listings are returned as is.
+	sales = unary(&pb.GetComparableSalesRequest{},
+		&pb.GetComparableSalesResponse{ComparableSales: []*pb.ComparableSale{{MlsListing: listing()}}}).(*pb.GetComparableSalesResponse)
+	assert.Equal(t, "MDBC2000001", sales.ComparableSales[0].MlsListing.Property.Listing.MlsListingId)
+}
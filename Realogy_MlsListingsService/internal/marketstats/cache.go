@@ -0,0 +1,76 @@
+package marketstats
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Cache keeps the market statistics of requests for a time to live. Expired entries are dropped as new ones are added.
+type Cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]entry
+
+	now func() time.Time
+}
+
+type entry struct {
+	stats   *pb.GetMarketStatsResponse
+	expires time.Time
+}
+
+// NewCache returns a cache of market statistics, which caches nothing when ttl is not positive.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[string]entry{}, now: time.Now}
+}
+
+// TTL is the time statistics are cached for.
+func (c *Cache) TTL() time.Duration {
+	return c.ttl
+}
+
+// Get returns the cached statistics of a request, and whether they were found.
+func (c *Cache) Get(in *pb.GetMarketStatsRequest) (*pb.GetMarketStatsResponse, bool) {
+	key, ok := cacheKey(in)
+	if !ok {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expires) {
+		return nil, false
+	}
+	return e.stats, true
+}
+
+// Put caches the statistics of a request.
+func (c *Cache) Put(in *pb.GetMarketStatsRequest, stats *pb.GetMarketStatsResponse) {
+	key, ok := cacheKey(in)
+	if !ok || c.ttl <= 0 {
+		return
+	}
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = entry{stats: stats, expires: now.Add(c.ttl)}
+}
+
+// cacheKey is the deterministic encoding of a request, identical requests have the same key.
+func cacheKey(in *pb.GetMarketStatsRequest) (string, bool) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
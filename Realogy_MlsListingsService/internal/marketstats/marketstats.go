@@ -0,0 +1,196 @@
+package marketstats
+
+import (
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+	"mlslisting/internal/mlsvalidation"
+	"mlslisting/internal/tombstone"
+)
+
+// daysPerMonth converts the window to months for the months of supply.
+const daysPerMonth = 30.4375
+
+// AreaFields are the listing fields of each kind of area.
+var AreaFields = map[pb.MarketAreaType]string{
+	pb.MarketAreaType_POSTAL_CODE:    "property.location.address.postal_code",
+	pb.MarketAreaType_CITY:           "property.location.address.city",
+	pb.MarketAreaType_SUBDIVISION:    "property.location.area.subdivision_name",
+	pb.MarketAreaType_MLS_AREA_MAJOR: "property.location.area.mls_area_major",
+}
+
+// Group is the aggregation of the listings of an area and property type, with the values the medians and averages are computed from.
+type Group struct {
+	Id struct {
+		Area         string `bson:"area"`
+		PropertyType string `bson:"property_type"`
+	} `bson:"_id"`
+	Active       int32     `bson:"active"`
+	New          int32     `bson:"new"`
+	Pending      int32     `bson:"pending"`
+	Sold         int32     `bson:"sold"`
+	ListPrices   []float64 `bson:"list_prices"`
+	ClosePrices  []float64 `bson:"close_prices"`
+	DaysOnMarket []float64 `bson:"days_on_market"`
+	SaleToList   []float64 `bson:"sale_to_list"`
+}
+
+// Pipeline aggregates the listings of the areas into a Group per area and property type. The listings are those active or pending
+// now, and those listed or sold within the window [start, end).
+func Pipeline(areaType pb.MarketAreaType, areas []string, filter primitive.D, start time.Time, end time.Time) mongo.Pipeline {
+	area := AreaFields[areaType]
+	window := bson.D{{Key: "$gte", Value: start}, {Key: "$lt", Value: end}}
+	active, pending, sold := mlsvalidation.Active.String(), mlsvalidation.Pending.String(), mlsvalidation.Sold.String()
+
+	match := append(primitive.D{{Key: area, Value: bson.D{{Key: "$in", Value: areas}}}}, filter...)
+	match = append(match, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "property.listing.standard_status", Value: bson.D{{Key: "$in", Value: bson.A{active, pending}}}}},
+		bson.D{{Key: "property.listing.standard_status", Value: sold}, {Key: "property.listing.dates.close_date", Value: window}},
+		bson.D{{Key: "property.listing.dates.listing_contract_date", Value: window}},
+	}})
+
+	within := func(field string) bson.D {
+		return bson.D{{Key: "$and", Value: bson.A{
+			bson.D{{Key: "$gte", Value: bson.A{field, start}}},
+			bson.D{{Key: "$lt", Value: bson.A{field, end}}},
+		}}}
+	}
+	isSold := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "$eq", Value: bson.A{"$property.listing.standard_status", sold}}},
+		within("$property.listing.dates.close_date"),
+	}}}
+	isActive := bson.D{{Key: "$eq", Value: bson.A{"$property.listing.standard_status", active}}}
+	count := func(condition interface{}) bson.D {
+		return bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{condition, 1, 0}}}}}
+	}
+	// values are pushed as null when they do not apply and filtered out after the group.
+	push := func(condition interface{}, value interface{}) bson.D {
+		return bson.D{{Key: "$push", Value: bson.D{{Key: "$cond", Value: bson.A{condition, value, nil}}}}}
+	}
+	positive := func(field string) bson.D {
+		return bson.D{{Key: "$gt", Value: bson.A{field, 0}}}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: tombstone.Exclude(match)}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "area", Value: "$" + area}, {Key: "property_type", Value: "$property.property_type"}}},
+			{Key: "active", Value: count(isActive)},
+			{Key: "new", Value: count(within("$property.listing.dates.listing_contract_date"))},
+			{Key: "pending", Value: count(bson.D{{Key: "$eq", Value: bson.A{"$property.listing.standard_status", pending}}})},
+			{Key: "sold", Value: count(isSold)},
+			{Key: "list_prices", Value: push(bson.D{{Key: "$and", Value: bson.A{isActive, positive("$property.listing.price.list_price")}}}, "$property.listing.price.list_price")},
+			{Key: "close_prices", Value: push(bson.D{{Key: "$and", Value: bson.A{isSold, positive("$property.listing.price.close_price")}}}, "$property.listing.price.close_price")},
+			{Key: "days_on_market", Value: push(bson.D{{Key: "$and", Value: bson.A{isSold, positive("$property.listing.days_on_market")}}}, "$property.listing.days_on_market")},
+			{Key: "sale_to_list", Value: push(
+				bson.D{{Key: "$and", Value: bson.A{isSold, positive("$property.listing.price.close_price"), positive("$property.listing.price.list_price")}}},
+				bson.D{{Key: "$divide", Value: bson.A{"$property.listing.price.close_price", "$property.listing.price.list_price"}}})},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "active", Value: 1},
+			{Key: "new", Value: 1},
+			{Key: "pending", Value: 1},
+			{Key: "sold", Value: 1},
+			{Key: "list_prices", Value: nonNull("$list_prices")},
+			{Key: "close_prices", Value: nonNull("$close_prices")},
+			{Key: "days_on_market", Value: nonNull("$days_on_market")},
+			{Key: "sale_to_list", Value: nonNull("$sale_to_list")},
+		}}},
+	}
+}
+
+func nonNull(array string) bson.D {
+	return bson.D{{Key: "$filter", Value: bson.D{
+		{Key: "input", Value: array},
+		{Key: "cond", Value: bson.D{{Key: "$ne", Value: bson.A{"$$this", nil}}}},
+	}}}
+}
+
+// Summarize computes the statistics of the groups by area, in the order of the areas, with the total of each area over its property types.
+// Areas without listings are left out.
+func Summarize(groups []Group, areas []string, start time.Time, end time.Time) []*pb.MarketAreaStats {
+	months := end.Sub(start).Hours() / 24 / daysPerMonth
+	byArea := map[string][]Group{}
+	for _, group := range groups {
+		byArea[group.Id.Area] = append(byArea[group.Id.Area], group)
+	}
+
+	var stats []*pb.MarketAreaStats
+	seen := map[string]bool{}
+	for _, area := range areas {
+		groups, ok := byArea[area]
+		if !ok || seen[area] {
+			continue
+		}
+		seen[area] = true
+		sort.Slice(groups, func(i, j int) bool { return groups[i].Id.PropertyType < groups[j].Id.PropertyType })
+
+		total := Group{}
+		areaStats := &pb.MarketAreaStats{Area: area}
+		for _, group := range groups {
+			areaStats.ByPropertyType = append(areaStats.ByPropertyType, group.stats(months))
+			total.Active += group.Active
+			total.New += group.New
+			total.Pending += group.Pending
+			total.Sold += group.Sold
+			total.ListPrices = append(total.ListPrices, group.ListPrices...)
+			total.ClosePrices = append(total.ClosePrices, group.ClosePrices...)
+			total.DaysOnMarket = append(total.DaysOnMarket, group.DaysOnMarket...)
+			total.SaleToList = append(total.SaleToList, group.SaleToList...)
+		}
+		areaStats.Total = total.stats(months)
+		stats = append(stats, areaStats)
+	}
+	return stats
+}
+
+func (g Group) stats(months float64) *pb.MarketStats {
+	stats := &pb.MarketStats{
+		PropertyType:       g.Id.PropertyType,
+		ActiveInventory:    g.Active,
+		NewListings:        g.New,
+		PendingCount:       g.Pending,
+		SoldCount:          g.Sold,
+		MedianListPrice:    Median(g.ListPrices),
+		AverageListPrice:   Average(g.ListPrices),
+		MedianClosePrice:   Median(g.ClosePrices),
+		AverageClosePrice:  Average(g.ClosePrices),
+		MedianDaysOnMarket: Median(g.DaysOnMarket),
+		ListToSaleRatio:    Average(g.SaleToList),
+	}
+	if g.Sold > 0 && months > 0 {
+		stats.MonthsOfSupply = float64(g.Active) / (float64(g.Sold) / months)
+	}
+	return stats
+}
+
+// Median is the middle value, or the average of the two middle values, 0 without values.
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// Average is the mean of the values, 0 without values.
+func Average(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,104 @@
+package marketstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestMedianAverage(t *testing.T) {
+	assert.Equal(t, 0.0, Median(nil))
+	assert.Equal(t, 2.0, Median([]float64{3, 1, 2}))
+	assert.Equal(t, 2.5, Median([]float64{4, 1, 3, 2}))
+	assert.Equal(t, 0.0, Average(nil))
+	assert.Equal(t, 2.5, Average([]float64{4, 1, 3, 2}))
+}
+
+func TestPipeline(t *testing.T) {
+	start := time.Date(2026, 9, 17, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	pipeline := Pipeline(pb.MarketAreaType_CITY, []string{"Irvine"}, primitive.D{{Key: "source_system_key", Value: "CRMLS"}}, start, end)
+
+	match := pipeline[0][0].Value.(primitive.D)
+	assert.Equal(t, bson.E{Key: "property.location.address.city", Value: bson.D{{Key: "$in", Value: []string{"Irvine"}}}}, match[0])
+	assert.Equal(t, "source_system_key", match[1].Key)
+	assert.Equal(t, "$or", match[2].Key)
+	// deleted listings are not counted.
+	assert.Equal(t, "deleted", match[3].Key)
+
+	group := pipeline[1][0].Value.(bson.D)
+	assert.Equal(t, bson.D{{Key: "area", Value: "$property.location.address.city"}, {Key: "property_type", Value: "$property.property_type"}}, group[0].Value)
+}
+
+func TestSummarize(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Duration(2*daysPerMonth*24) * time.Hour)
+	groups := []Group{
+		{Active: 4, New: 2, Pending: 1, Sold: 2, ListPrices: []float64{100, 200, 300, 400}, ClosePrices: []float64{190, 210}, DaysOnMarket: []float64{10, 30}, SaleToList: []float64{0.95, 1.05}},
+		{Active: 1, Sold: 0, ListPrices: []float64{50}},
+		{Active: 2},
+	}
+	groups[0].Id.Area, groups[0].Id.PropertyType = "92618", "RESIDENTIAL"
+	groups[1].Id.Area, groups[1].Id.PropertyType = "92618", "LAND"
+	groups[2].Id.Area, groups[2].Id.PropertyType = "92620", "RESIDENTIAL"
+
+	stats := Summarize(groups, []string{"92620", "92618", "90210"}, start, end)
+
+	// in the order of the request, without the areas without listings.
+	assert.Len(t, stats, 2)
+	assert.Equal(t, "92620", stats[0].Area)
+	assert.Equal(t, "92618", stats[1].Area)
+
+	residential := stats[1].ByPropertyType[1]
+	assert.Equal(t, "LAND", stats[1].ByPropertyType[0].PropertyType)
+	assert.Equal(t, "RESIDENTIAL", residential.PropertyType)
+	assert.Equal(t, 250.0, residential.MedianListPrice)
+	assert.Equal(t, 200.0, residential.MedianClosePrice)
+	assert.Equal(t, 20.0, residential.MedianDaysOnMarket)
+	assert.InDelta(t, 1.0, residential.ListToSaleRatio, 1e-9)
+	// 4 active listings at 1 sale a month.
+	assert.InDelta(t, 4.0, residential.MonthsOfSupply, 1e-9)
+	assert.Equal(t, 0.0, stats[1].ByPropertyType[0].MonthsOfSupply)
+
+	total := stats[1].Total
+	assert.Equal(t, "", total.PropertyType)
+	assert.Equal(t, int32(5), total.ActiveInventory)
+	assert.Equal(t, 200.0, total.MedianListPrice)
+	assert.Equal(t, 210.0, total.AverageListPrice)
+	assert.InDelta(t, 5.0, total.MonthsOfSupply, 1e-9)
+}
+
+func TestCache(t *testing.T) {
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	c := NewCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	in := &pb.GetMarketStatsRequest{Areas: []string{"92618"}}
+	stats := &pb.GetMarketStatsResponse{Areas: []*pb.MarketAreaStats{{Area: "92618"}}}
+	_, ok := c.Get(in)
+	assert.False(t, ok)
+
+	c.Put(in, stats)
+	cached, ok := c.Get(&pb.GetMarketStatsRequest{Areas: []string{"92618"}})
+	assert.True(t, ok)
+	assert.Equal(t, stats, cached)
+	_, ok = c.Get(&pb.GetMarketStatsRequest{Areas: []string{"92618"}, AreaType: pb.MarketAreaType_CITY})
+	assert.False(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get(in)
+	assert.False(t, ok)
+	c.Put(&pb.GetMarketStatsRequest{}, stats)
+	assert.Len(t, c.entries, 1)
+
+	// nothing is cached without a ttl.
+	c = NewCache(0)
+	c.Put(in, stats)
+	_, ok = c.Get(in)
+	assert.False(t, ok)
+}
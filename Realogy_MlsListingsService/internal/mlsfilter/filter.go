@@ -0,0 +1,141 @@
+package mlsfilter
+
+import (
+	"fmt"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/mlsvalidation"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// listing document paths used by the MlsFilter fields.
+const (
+	PropertyTypePath       = "property.property_type"
+	StandardStatusPath     = "property.listing.standard_status"
+	ArchitectureStylePath  = "property.structure.architecture_style"
+	ListPricePath          = "property.listing.price.list_price"
+	BedroomsTotalPath      = "property.structure.bedrooms_total"
+	BathroomsTotalPath     = "property.structure.bathrooms_total_integer"
+	BuildingAreaTotalPath  = "property.structure.building_area_total"
+	LotSizeSquareFeetPath  = "property.characteristics.lot_size_square_feet"
+	StoriesTotalPath       = "property.structure.stories_total"
+	ListAgentMlsIdPath     = "property.listing.agent_office.list_agent.list_agent_mls_id"
+	RdmSourceSystemKeyPath = "property.listing.rdm_source_system_key"
+	PostalCodePath         = "property.location.address.postal_code"
+)
+
+// Compile turns an MlsFilter into a mongodb predicate with one entry per listing path.
+// A nil filter compiles to an empty predicate.
+func Compile(filter *pb.MlsFilter) (primitive.D, error) {
+	predicate := primitive.D{}
+	if filter == nil {
+		return predicate, nil
+	}
+
+	if err := validate(filter); err != nil {
+		return nil, err
+	}
+
+	if len(filter.PropertyType) > 0 {
+		predicate = append(predicate, bson.E{Key: PropertyTypePath, Value: bson.M{"$in": filter.PropertyType}})
+	}
+	if len(filter.StandardStatus) > 0 {
+		predicate = append(predicate, bson.E{Key: StandardStatusPath, Value: bson.M{"$in": filter.StandardStatus}})
+	}
+	if len(filter.ArchitectureStyle) > 0 {
+		predicate = append(predicate, bson.E{Key: ArchitectureStylePath, Value: bson.M{"$in": filter.ArchitectureStyle}})
+	}
+	if r := rangeOf(filter.ListPriceMin, filter.ListPriceMax); r != nil {
+		predicate = append(predicate, bson.E{Key: ListPricePath, Value: r})
+	}
+	if filter.BedroomsMin != 0 {
+		predicate = append(predicate, bson.E{Key: BedroomsTotalPath, Value: bson.M{"$gte": filter.BedroomsMin}})
+	}
+	if filter.BathroomsMin != 0 {
+		predicate = append(predicate, bson.E{Key: BathroomsTotalPath, Value: bson.M{"$gte": filter.BathroomsMin}})
+	}
+	if r := rangeOf(filter.BuildingAreaTotalMin, filter.BuildingAreaTotalMax); r != nil {
+		predicate = append(predicate, bson.E{Key: BuildingAreaTotalPath, Value: r})
+	}
+	if r := rangeOf(float64(filter.LotSizeSquareFeetMin), float64(filter.LotSizeSquareFeetMax)); r != nil {
+		predicate = append(predicate, bson.E{Key: LotSizeSquareFeetPath, Value: r})
+	}
+	if filter.StoriesTotal != 0 {
+		predicate = append(predicate, bson.E{Key: StoriesTotalPath, Value: filter.StoriesTotal})
+	}
+	if filter.ListAgentMlsId != "" {
+		predicate = append(predicate, bson.E{Key: ListAgentMlsIdPath, Value: filter.ListAgentMlsId})
+	}
+	if filter.RdmSourceSystemKey != "" {
+		predicate = append(predicate, bson.E{Key: RdmSourceSystemKeyPath, Value: filter.RdmSourceSystemKey})
+	}
+	if len(filter.PostalCode) > 0 {
+		predicate = append(predicate, bson.E{Key: PostalCodePath, Value: bson.M{"$in": filter.PostalCode}})
+	}
+	if filter.CollapseDuplicates {
+		predicate = append(predicate, derived.Collapse())
+	}
+	return predicate, nil
+}
+
+// Apply compiles the filter and appends it to the query of an endpoint.
+// A filter field that constrains a path the endpoint already queries on is rejected, since one would silently override the other.
+func Apply(query primitive.D, filter *pb.MlsFilter) (primitive.D, error) {
+	predicate, err := Compile(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range predicate {
+		for _, q := range query {
+			if p.Key == q.Key {
+				return nil, fmt.Errorf("filter on %s is not supported for this request", p.Key)
+			}
+		}
+	}
+	return append(query, predicate...), nil
+}
+
+func validate(filter *pb.MlsFilter) error {
+	for _, s := range filter.StandardStatus {
+		if !mlsvalidation.IsValidStatus(s) {
+			return fmt.Errorf("standardStatus %q is not a valid status", s)
+		}
+	}
+	if filter.ListPriceMin < 0 || filter.ListPriceMax < 0 {
+		return fmt.Errorf("listPriceMin and listPriceMax must not be negative")
+	}
+	if filter.ListPriceMax != 0 && filter.ListPriceMin > filter.ListPriceMax {
+		return fmt.Errorf("listPriceMin must not be greater than listPriceMax")
+	}
+	if filter.BedroomsMin < 0 || filter.BathroomsMin < 0 || filter.StoriesTotal < 0 {
+		return fmt.Errorf("bedroomsMin, bathroomsMin and storiesTotal must not be negative")
+	}
+	if filter.BuildingAreaTotalMin < 0 || filter.BuildingAreaTotalMax < 0 {
+		return fmt.Errorf("buildingAreaTotalMin and buildingAreaTotalMax must not be negative")
+	}
+	if filter.BuildingAreaTotalMax != 0 && filter.BuildingAreaTotalMin > filter.BuildingAreaTotalMax {
+		return fmt.Errorf("buildingAreaTotalMin must not be greater than buildingAreaTotalMax")
+	}
+	if filter.LotSizeSquareFeetMin < 0 || filter.LotSizeSquareFeetMax < 0 {
+		return fmt.Errorf("lotSizeSquareFeetMin and lotSizeSquareFeetMax must not be negative")
+	}
+	if filter.LotSizeSquareFeetMax != 0 && filter.LotSizeSquareFeetMin > filter.LotSizeSquareFeetMax {
+		return fmt.Errorf("lotSizeSquareFeetMin must not be greater than lotSizeSquareFeetMax")
+	}
+	return nil
+}
+
+// rangeOf returns a single range predicate for the min/max bounds. A zero bound is unset.
+func rangeOf(min, max float64) primitive.D {
+	var r primitive.D
+	if min != 0 {
+		r = append(r, bson.E{Key: "$gte", Value: min})
+	}
+	if max != 0 {
+		r = append(r, bson.E{Key: "$lte", Value: max})
+	}
+	return r
+}
@@ -0,0 +1,90 @@
+package mlsfilter_test
+
+import (
+	"mlslisting/internal/derived"
+	"mlslisting/internal/mlsfilter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestCompileNilFilter(t *testing.T) {
+	predicate, err := mlsfilter.Compile(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, predicate)
+}
+
+func TestCompileListPriceRange(t *testing.T) {
+	predicate, err := mlsfilter.Compile(&pb.MlsFilter{ListPriceMin: 100000, ListPriceMax: 250000})
+	assert.Nil(t, err)
+	// min and max are combined into a single predicate on the list price.
+	assert.Equal(t, primitive.D{
+		{Key: mlsfilter.ListPricePath, Value: primitive.D{{Key: "$gte", Value: float64(100000)}, {Key: "$lte", Value: float64(250000)}}},
+	}, predicate)
+}
+
+func TestCompileAllFields(t *testing.T) {
+	predicate, err := mlsfilter.Compile(&pb.MlsFilter{
+		PropertyType:         []string{"SFR"},
+		StandardStatus:       []string{"ACTIVE"},
+		ArchitectureStyle:    []string{"Colonial"},
+		ListPriceMax:         500000,
+		BedroomsMin:          3,
+		BathroomsMin:         2,
+		BuildingAreaTotalMin: 1500,
+		BuildingAreaTotalMax: 3000,
+		LotSizeSquareFeetMin: 5000,
+		StoriesTotal:         2,
+		ListAgentMlsId:       "A123",
+		RdmSourceSystemKey:   "NJ_GSMLS",
+		PostalCode:           []string{"07030"},
+		CollapseDuplicates:   true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, primitive.D{
+		{Key: mlsfilter.PropertyTypePath, Value: bson.M{"$in": []string{"SFR"}}},
+		{Key: mlsfilter.StandardStatusPath, Value: bson.M{"$in": []string{"ACTIVE"}}},
+		{Key: mlsfilter.ArchitectureStylePath, Value: bson.M{"$in": []string{"Colonial"}}},
+		{Key: mlsfilter.ListPricePath, Value: primitive.D{{Key: "$lte", Value: float64(500000)}}},
+		{Key: mlsfilter.BedroomsTotalPath, Value: bson.M{"$gte": int32(3)}},
+		{Key: mlsfilter.BathroomsTotalPath, Value: bson.M{"$gte": int32(2)}},
+		{Key: mlsfilter.BuildingAreaTotalPath, Value: primitive.D{{Key: "$gte", Value: float64(1500)}, {Key: "$lte", Value: float64(3000)}}},
+		{Key: mlsfilter.LotSizeSquareFeetPath, Value: primitive.D{{Key: "$gte", Value: float64(5000)}}},
+		{Key: mlsfilter.StoriesTotalPath, Value: int32(2)},
+		{Key: mlsfilter.ListAgentMlsIdPath, Value: "A123"},
+		{Key: mlsfilter.RdmSourceSystemKeyPath, Value: "NJ_GSMLS"},
+		{Key: mlsfilter.PostalCodePath, Value: bson.M{"$in": []string{"07030"}}},
+		// listings without duplicates have no marker, they are kept with the canonical ones.
+		{Key: derived.CanonicalPath, Value: primitive.D{{Key: "$ne", Value: false}}},
+	}, predicate)
+}
+
+func TestCompileInvalidFilter(t *testing.T) {
+	filters := []*pb.MlsFilter{
+		{StandardStatus: []string{"LISTED"}},
+		{ListPriceMin: 300000, ListPriceMax: 200000},
+		{ListPriceMin: -1},
+		{BedroomsMin: -1},
+		{BuildingAreaTotalMin: 2000, BuildingAreaTotalMax: 1000},
+		{LotSizeSquareFeetMin: 2000, LotSizeSquareFeetMax: 1000},
+	}
+	for _, filter := range filters {
+		_, err := mlsfilter.Compile(filter)
+		assert.NotNil(t, err, "filter %v", filter)
+	}
+}
+
+func TestApplyRejectsConflictingPath(t *testing.T) {
+	query := primitive.D{{Key: mlsfilter.PostalCodePath, Value: "07030"}}
+
+	_, err := mlsfilter.Apply(query, &pb.MlsFilter{PostalCode: []string{"07031"}})
+	assert.NotNil(t, err)
+
+	query, err = mlsfilter.Apply(query, &pb.MlsFilter{BedroomsMin: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(query))
+}
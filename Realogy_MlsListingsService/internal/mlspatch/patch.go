@@ -0,0 +1,261 @@
+package mlspatch
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+var timestampType = reflect.TypeOf(timestamppb.Timestamp{})
+
+// readOnly are the fields identifying a listing or kept by the service, no source may write them. Writing a parent of them
+// writes its other fields and keeps them.
+var readOnly = []string{
+	"property.listing.listing_id",
+	"property.listing.source_system_key",
+	"property.listing.rdm_source_system_key",
+	"property.listing.dates.last_change_date",
+	"property.listing.dates.inserted_date",
+	"revision",
+	"deleted",
+	"deleted_time",
+}
+
+// Field is a masked field of MlsListing.
+type Field struct {
+	// Path is the field path in proto names, e.g. "property.listing.price.list_price".
+	Path string
+	// DocumentPath is the path of the field in the listing document.
+	DocumentPath string
+	// index of the struct field of each path segment.
+	index []int
+}
+
+// Compile resolves the paths of a field mask on MlsListing, given in proto or json form.
+// Paths may not go through repeated fields, repeat or contain each other, nor name the read-only fields of a listing.
+// A path containing read-only fields resolves to the fields under it but those, e.g. "property.listing.dates" to the dates
+// other than the last change and inserted dates.
+func Compile(mask *fieldmaskpb.FieldMask) ([]Field, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, fmt.Errorf("update mask must have at least one path")
+	}
+	fields := make([]Field, 0, len(mask.Paths))
+	for _, path := range mask.Paths {
+		field, err := resolve(strings.TrimSpace(path))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if contains(f.Path, field.Path) || contains(field.Path, f.Path) {
+				return nil, fmt.Errorf("field mask paths %q and %q overlap", f.Path, field.Path)
+			}
+		}
+		for _, readOnlyPath := range readOnly {
+			if contains(readOnlyPath, field.Path) {
+				return nil, fmt.Errorf("field mask path %q is not writable. %q is read-only", path, readOnlyPath)
+			}
+		}
+		fields = append(fields, withoutReadOnly(field)...)
+	}
+	return fields, nil
+}
+
+// withoutReadOnly returns the field, or the fields under it but the read-only ones when it contains read-only fields.
+func withoutReadOnly(field Field) []Field {
+	parent := false
+	for _, readOnlyPath := range readOnly {
+		parent = parent || contains(field.Path, readOnlyPath)
+	}
+	if !parent {
+		return []Field{field}
+	}
+	t := reflect.TypeOf(pb.MlsListing{})
+	for _, i := range field.index {
+		if t = t.Field(i).Type; t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		name := protoName(t.Field(i))
+		if name == "" || isReadOnly(field.Path+"."+name) {
+			continue
+		}
+		// fields that are not stored are not written.
+		if child, err := resolve(field.Path + "." + name); err == nil {
+			fields = append(fields, withoutReadOnly(child)...)
+		}
+	}
+	return fields
+}
+
+func isReadOnly(path string) bool {
+	for _, readOnlyPath := range readOnly {
+		if path == readOnlyPath {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwritable returns the first field that is neither one of the writable paths nor under one of them, false when there is none.
+func Unwritable(fields []Field, writable []string) (Field, bool) {
+	for _, field := range fields {
+		allowed := false
+		for _, path := range writable {
+			if contains(path, field.Path) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return field, true
+		}
+	}
+	return Field{}, false
+}
+
+// Touches reports whether a field is path, under it or a parent of it.
+func Touches(fields []Field, path string) bool {
+	for _, field := range fields {
+		if contains(field.Path, path) || contains(path, field.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Update returns the mongodb update setting the fields to their values in listing, along with the other document fields to set.
+// Fields without a value, unset messages and lists or zero scalars, are cleared.
+func Update(fields []Field, listing *pb.MlsListing, others ...bson.E) primitive.D {
+	set, unset := append(primitive.D{}, others...), primitive.D{}
+	for _, field := range fields {
+		if v, ok := field.value(reflect.ValueOf(listing)); ok {
+			set = append(set, bson.E{Key: field.DocumentPath, Value: v.Interface()})
+		} else {
+			unset = append(unset, bson.E{Key: field.DocumentPath, Value: ""})
+		}
+	}
+	update := primitive.D{}
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: set})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	return update
+}
+
+// Merge sets the fields of dst to their values in src, as Update sets them in the listing document.
+func Merge(dst, src *pb.MlsListing, fields []Field) {
+	for _, field := range fields {
+		v := reflect.ValueOf(dst).Elem()
+		for _, i := range field.index[:len(field.index)-1] {
+			v = v.Field(i)
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		target := v.Field(field.index[len(field.index)-1])
+		if value, ok := field.value(reflect.ValueOf(src)); ok {
+			target.Set(value)
+		} else {
+			target.Set(reflect.Zero(target.Type()))
+		}
+	}
+}
+
+// value returns the value of the field in a listing, false when it has none.
+func (f Field) value(listing reflect.Value) (reflect.Value, bool) {
+	v := listing
+	for _, i := range f.index {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem().Field(i)
+	}
+	if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+// resolve maps a mask path to its field using the proto names and bson tags of the generated messages.
+func resolve(path string) (Field, error) {
+	if path == "" {
+		return Field{}, fmt.Errorf("field mask path must not be empty")
+	}
+	t := reflect.TypeOf(pb.MlsListing{})
+	segments := strings.Split(path, ".")
+	field := Field{index: make([]int, 0, len(segments))}
+	names, documentNames := make([]string, 0, len(segments)), make([]string, 0, len(segments))
+	for i, segment := range segments {
+		if t.Kind() != reflect.Struct || t == timestampType {
+			return Field{}, fmt.Errorf("field mask path %q is not valid at %q", path, strings.Join(segments[:i+1], "."))
+		}
+		structField, name, ok := fieldOf(t, segment)
+		if !ok {
+			return Field{}, fmt.Errorf("field mask path %q is not valid. %q is not a field of %s", path, segment, t.Name())
+		}
+		documentName := strings.Split(structField.Tag.Get("bson"), ",")[0]
+		if documentName == "" || documentName == "-" {
+			return Field{}, fmt.Errorf("field mask path %q is not valid. %q is not stored", path, segment)
+		}
+		field.index = append(field.index, structField.Index[0])
+		names, documentNames = append(names, name), append(documentNames, documentName)
+
+		t = structField.Type
+		if t.Kind() == reflect.Slice && i < len(segments)-1 {
+			return Field{}, fmt.Errorf("field mask path %q is not valid. %q is a list, it can only be written whole", path, strings.Join(segments[:i+1], "."))
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	field.Path, field.DocumentPath = strings.Join(names, "."), strings.Join(documentNames, ".")
+	return field, nil
+}
+
+// protoName returns the proto name of the struct field of a generated message, "" for the fields that are not message fields.
+func protoName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	return ""
+}
+
+// fieldOf finds the struct field of a generated message and its proto name by its proto or json name.
+func fieldOf(t reflect.Type, name string) (reflect.StructField, string, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		var protoName string
+		found := false
+		for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(option, "name=") {
+				protoName = strings.TrimPrefix(option, "name=")
+			}
+			if option == "name="+name || option == "json="+name {
+				found = true
+			}
+		}
+		if found {
+			return field, protoName, true
+		}
+	}
+	return reflect.StructField{}, "", false
+}
+
+// contains reports whether path is parent or equal to other.
+func contains(path, other string) bool {
+	return path == other || strings.HasPrefix(other, path+".")
+}
@@ -0,0 +1,186 @@
+package mlspatch_test
+
+import (
+	"mlslisting/internal/mlspatch"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestCompileMask(t *testing.T) {
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{
+		"property.listing.remarks.publicRemarks",
+		"property.listing.price",
+		"open_house.open_homes",
+		"masterId",
+	}})
+	assert.Nil(t, err)
+	var paths, documentPaths []string
+	for _, field := range fields {
+		paths, documentPaths = append(paths, field.Path), append(documentPaths, field.DocumentPath)
+	}
+	assert.Equal(t, []string{"property.listing.remarks.public_remarks", "property.listing.price", "open_house.open_homes", "master_id"}, paths)
+	assert.Equal(t, []string{"property.listing.remarks.public_remarks", "property.listing.price", "open_house.open_homes", "master_id"}, documentPaths)
+}
+
+func TestCompileInvalidMask(t *testing.T) {
+	for _, paths := range [][]string{
+		nil,
+		{""},
+		{"price"},
+		{"property.listing.dates.listing_contract_date.seconds"},
+		// lists are written whole.
+		{"open_house.open_homes.open_house_date"},
+		// overlapping paths.
+		{"property.listing.price", "property.listing.price.list_price"},
+		{"property.listing.price", "property.listing.price"},
+		// read-only fields.
+		{"property.listing.listing_id"},
+		{"property.listing.dates.last_change_date"},
+		{"revision"},
+		// a parent of read-only fields contains the fields under it.
+		{"property.listing", "property.listing.price"},
+	} {
+		_, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: paths})
+		assert.NotNil(t, err, paths)
+	}
+}
+
+func TestUnwritable(t *testing.T) {
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.price.list_price", "property.listing.remarks"}})
+	assert.Nil(t, err)
+
+	_, ok := mlspatch.Unwritable(fields, []string{"property.listing.price", "property.listing.remarks"})
+	assert.False(t, ok)
+
+	field, ok := mlspatch.Unwritable(fields, []string{"property.listing.price", "property.listing.remarks.public_remarks"})
+	assert.True(t, ok)
+	assert.Equal(t, "property.listing.remarks", field.Path)
+
+	_, ok = mlspatch.Unwritable(fields, nil)
+	assert.True(t, ok)
+}
+
+func TestTouches(t *testing.T) {
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.price.list_price", "property.structure"}})
+	assert.Nil(t, err)
+
+	assert.True(t, mlspatch.Touches(fields, "property.listing.price"))
+	assert.True(t, mlspatch.Touches(fields, "property.structure.bedrooms_total"))
+	assert.False(t, mlspatch.Touches(fields, "property.listing.standard_status"))
+}
+
+func TestUpdate(t *testing.T) {
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{
+		"property.listing.standard_status",
+		"property.listing.price.list_price",
+		"property.listing.remarks.public_remarks",
+		"open_house.open_homes",
+	}})
+	assert.Nil(t, err)
+
+	now := time.Now()
+	listing := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{
+		StandardStatus: "ACTIVE",
+		Price:          &pb.Price{ListPrice: 250000, ClosePrice: 1},
+	}}}
+	// masked fields without a value are cleared, fields out of the mask are ignored.
+	assert.Equal(t, primitive.D{
+		{Key: "$set", Value: primitive.D{
+			{Key: "last_change_date", Value: now},
+			{Key: "property.listing.standard_status", Value: "ACTIVE"},
+			{Key: "property.listing.price.list_price", Value: float64(250000)},
+		}},
+		{Key: "$unset", Value: primitive.D{
+			{Key: "property.listing.remarks.public_remarks", Value: ""},
+			{Key: "open_house.open_homes", Value: ""},
+		}},
+	}, mlspatch.Update(fields, listing, bson.E{Key: "last_change_date", Value: now}))
+
+	assert.Equal(t, primitive.D{
+		{Key: "$unset", Value: primitive.D{
+			{Key: "property.listing.standard_status", Value: ""},
+			{Key: "property.listing.price.list_price", Value: ""},
+			{Key: "property.listing.remarks.public_remarks", Value: ""},
+			{Key: "open_house.open_homes", Value: ""},
+		}},
+	}, mlspatch.Update(fields, nil))
+}
+
+func TestMerge(t *testing.T) {
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{
+		"property.listing.standard_status",
+		"property.listing.dates.close_date",
+		"property.listing.price.close_price",
+	}})
+	assert.Nil(t, err)
+
+	closeDate := timestamppb.Now()
+	dst := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{
+		StandardStatus: "ACTIVE",
+		Price:          &pb.Price{ListPrice: 250000, ClosePrice: 240000},
+	}}}
+	src := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{
+		StandardStatus: "SOLD",
+		ListingId:      "100018",
+		Dates:          &pb.Dates{CloseDate: closeDate},
+	}}}
+	mlspatch.Merge(dst, src, fields)
+
+	assert.Equal(t, "SOLD", dst.Property.Listing.StandardStatus)
+	assert.Equal(t, closeDate, dst.Property.Listing.Dates.CloseDate)
+	assert.Equal(t, float64(250000), dst.Property.Listing.Price.ListPrice)
+	assert.Equal(t, float64(0), dst.Property.Listing.Price.ClosePrice)
+	assert.Equal(t, "", dst.Property.Listing.ListingId)
+}
+
+func TestCompileParentOfReadOnly(t *testing.T) {
+	// the writable fields of the patch policies of the config.
+	fields, err := mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.dates"}})
+	assert.Nil(t, err)
+	var paths []string
+	for _, field := range fields {
+		paths = append(paths, field.Path)
+		assert.True(t, strings.HasPrefix(field.Path, "property.listing.dates."), field.Path)
+	}
+	assert.Contains(t, paths, "property.listing.dates.close_date")
+	assert.NotContains(t, paths, "property.listing.dates.last_change_date")
+	assert.NotContains(t, paths, "property.listing.dates.inserted_date")
+	_, ok := mlspatch.Unwritable(fields, []string{"property.listing.dates"})
+	assert.False(t, ok)
+
+	fields, err = mlspatch.Compile(&fieldmaskpb.FieldMask{Paths: []string{"property"}})
+	assert.Nil(t, err)
+	paths = nil
+	for _, field := range fields {
+		paths = append(paths, field.Path)
+	}
+	assert.Contains(t, paths, "property.structure")
+	assert.Contains(t, paths, "property.listing.price")
+	assert.Contains(t, paths, "property.listing.dates.close_date")
+	for _, readOnly := range []string{"property.listing.listing_id", "property.listing.source_system_key", "property.listing.dates.last_change_date"} {
+		assert.NotContains(t, paths, readOnly)
+	}
+	assert.True(t, mlspatch.Touches(fields, "property.listing.standard_status"))
+
+	// the read-only fields are neither set nor cleared, and are kept by a merge.
+	closeDate := timestamppb.New(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	update := mlspatch.Update(fields, &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{Dates: &pb.Dates{CloseDate: closeDate}}}})
+	data, err := bson.MarshalExtJSON(update, false, false)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"property.listing.dates.close_date"`)
+	assert.NotContains(t, string(data), `"property.listing.listing_id"`)
+	assert.NotContains(t, string(data), "last_change_date")
+	stored := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{ListingId: "100018", Dates: &pb.Dates{LastChangeDate: closeDate}}}}
+	mlspatch.Merge(stored, &pb.MlsListing{}, fields)
+	assert.Equal(t, "100018", stored.Property.Listing.ListingId)
+	assert.Equal(t, closeDate, stored.Property.Listing.Dates.LastChangeDate)
+}
@@ -0,0 +1,116 @@
+package mlsprojection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+var timestampType = reflect.TypeOf(timestamppb.Timestamp{})
+
+// Compile turns a field mask on MlsListing into a mongodb projection of the listing document paths.
+// Mask paths are MlsListing field names, e.g. "property.listing.price.list_price", in proto or json form.
+// An empty mask compiles to a nil projection, which returns the whole listing.
+func Compile(mask *fieldmaskpb.FieldMask) (primitive.D, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	paths := make([]string, 0, len(mask.Paths))
+	for _, path := range mask.Paths {
+		documentPath, err := documentPath(strings.TrimSpace(path))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, documentPath)
+	}
+	return Include(primitive.D{}, paths...), nil
+}
+
+// Include adds document paths to a projection, e.g. the sort keys a page token is read from.
+// A nil projection returns the whole listing and is kept as is. Paths already covered by a parent path are dropped,
+// mongodb rejects a projection of both a path and its parent.
+func Include(projection primitive.D, paths ...string) primitive.D {
+	if projection == nil {
+		return nil
+	}
+	for _, path := range paths {
+		projection = include(projection, path)
+	}
+	return projection
+}
+
+// Prefix returns the projection of documents nested under prefix, e.g. "fullDocument" of change events.
+func Prefix(projection primitive.D, prefix string) primitive.D {
+	if projection == nil {
+		return nil
+	}
+	prefixed := make(primitive.D, 0, len(projection))
+	for _, e := range projection {
+		prefixed = append(prefixed, bson.E{Key: prefix + "." + e.Key, Value: e.Value})
+	}
+	return prefixed
+}
+
+func include(projection primitive.D, path string) primitive.D {
+	kept := make(primitive.D, 0, len(projection)+1)
+	for _, e := range projection {
+		if e.Key == path || strings.HasPrefix(path, e.Key+".") {
+			// already covered by this path or a parent.
+			return projection
+		}
+		if !strings.HasPrefix(e.Key, path+".") {
+			kept = append(kept, e)
+		}
+	}
+	return append(kept, bson.E{Key: path, Value: 1})
+}
+
+// documentPath maps a mask path to the listing document path using the bson tags of the generated messages.
+func documentPath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("field mask path must not be empty")
+	}
+	t := reflect.TypeOf(pb.MlsListing{})
+	segments := strings.Split(path, ".")
+	names := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		if t.Kind() != reflect.Struct || t == timestampType {
+			return "", fmt.Errorf("field mask path %q is not valid at %q", path, strings.Join(segments[:i+1], "."))
+		}
+		field, ok := fieldOf(t, segment)
+		if !ok {
+			return "", fmt.Errorf("field mask path %q is not valid. %q is not a field of %s", path, segment, t.Name())
+		}
+		name := strings.Split(field.Tag.Get("bson"), ",")[0]
+		if name == "" || name == "-" {
+			return "", fmt.Errorf("field mask path %q is not valid. %q is not stored", path, segment)
+		}
+		names = append(names, name)
+
+		t = field.Type
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+	}
+	return strings.Join(names, "."), nil
+}
+
+// fieldOf finds the struct field of a generated message by its proto or json name.
+func fieldOf(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if option == "name="+name || option == "json="+name {
+				return field, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,60 @@
+package mlsprojection_test
+
+import (
+	"mlslisting/internal/mlsprojection"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCompileEmptyMask(t *testing.T) {
+	projection, err := mlsprojection.Compile(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, projection)
+
+	projection, err = mlsprojection.Compile(&fieldmaskpb.FieldMask{})
+	assert.Nil(t, err)
+	assert.Nil(t, projection)
+}
+
+func TestCompileMask(t *testing.T) {
+	projection, err := mlsprojection.Compile(&fieldmaskpb.FieldMask{Paths: []string{
+		"property.listing.price.list_price",
+		"property.location.gis",
+		"property.location.address.stateOrProvince",
+		"masterId",
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, primitive.D{
+		{Key: "property.listing.price.list_price", Value: 1},
+		{Key: "property.location.gis", Value: 1},
+		{Key: "property.location.address.state_or_province", Value: 1},
+		{Key: "master_id", Value: 1},
+	}, projection)
+}
+
+func TestCompileInvalidMask(t *testing.T) {
+	for _, path := range []string{"", "price", "property.listing.price.list_price.amount", "property.listing.dates.listing_contract_date.seconds"} {
+		_, err := mlsprojection.Compile(&fieldmaskpb.FieldMask{Paths: []string{path}})
+		assert.NotNil(t, err, path)
+	}
+}
+
+func TestIncludeParentPath(t *testing.T) {
+	projection, err := mlsprojection.Compile(&fieldmaskpb.FieldMask{Paths: []string{"property.listing.price.list_price", "property.listing.days_on_market"}})
+	assert.Nil(t, err)
+
+	// a parent path replaces its children, a child of an included path is dropped.
+	projection = mlsprojection.Include(projection, "property.listing", "property.listing.price", "last_change_date")
+	assert.Equal(t, primitive.D{{Key: "property.listing", Value: 1}, {Key: "last_change_date", Value: 1}}, projection)
+
+	assert.Nil(t, mlsprojection.Include(nil, "last_change_date"))
+}
+
+func TestPrefix(t *testing.T) {
+	projection := mlsprojection.Prefix(primitive.D{{Key: "property.listing", Value: 1}}, "fullDocument")
+	assert.Equal(t, primitive.D{{Key: "fullDocument.property.listing", Value: 1}}, projection)
+	assert.Nil(t, mlsprojection.Prefix(nil, "fullDocument"))
+}
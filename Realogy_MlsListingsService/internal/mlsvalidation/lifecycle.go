@@ -0,0 +1,247 @@
+package mlsvalidation
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Paths of the listing fields the lifecycle rules refer to.
+const (
+	ClosePricePath          = "property.listing.price.close_price"
+	ListingContractDatePath = "property.listing.dates.listing_contract_date"
+	CloseDatePath           = "property.listing.dates.close_date"
+	CancellationDatePath    = "property.listing.dates.cancellation_date"
+	PendingTimestampPath    = "property.listing.dates.pending_timestamp"
+	ExpirationDatePath      = "property.listing.dates.expiration_date"
+)
+
+// DateOrder is an invariant that a date of a listing is not earlier than another, when both are set.
+type DateOrder struct {
+	Field     string
+	NotBefore string
+}
+
+// StatusRule is the lifecycle of a status: the statuses a listing in it may change to, and the fields and order of dates
+// a listing needs to be in it.
+type StatusRule struct {
+	Status      StandardStatus
+	Transitions []StandardStatus
+	Required    []string
+	DateOrders  []DateOrder
+}
+
+// Lifecycle is the state machine of the listing standard status. SOLD and TERMINATED are final, listings that expired,
+// were canceled or withdrawn go back on the market as ACTIVE, and UNKNOWN listings may be corrected to any status.
+var Lifecycle = []StatusRule{
+	{
+		Status:      Active,
+		Transitions: []StandardStatus{Pending, Sold, Hold, Temp, Inactive, Withdrawn, Canceled, Expired, Terminated},
+	},
+	{
+		Status:      Pending,
+		Transitions: []StandardStatus{Active, Sold, Hold, Withdrawn, Canceled, Expired, Terminated},
+		Required:    []string{PendingTimestampPath},
+		DateOrders:  []DateOrder{{Field: PendingTimestampPath, NotBefore: ListingContractDatePath}},
+	},
+	{
+		Status:   Sold,
+		Required: []string{CloseDatePath, ClosePricePath},
+		DateOrders: []DateOrder{
+			{Field: CloseDatePath, NotBefore: ListingContractDatePath},
+			{Field: CloseDatePath, NotBefore: PendingTimestampPath},
+		},
+	},
+	{
+		Status:      Hold,
+		Transitions: []StandardStatus{Active, Pending, Withdrawn, Canceled, Expired, Terminated},
+	},
+	{
+		Status:      Temp,
+		Transitions: []StandardStatus{Active, Withdrawn, Canceled, Expired, Terminated},
+	},
+	{
+		Status:      Inactive,
+		Transitions: []StandardStatus{Active, Withdrawn, Canceled, Expired, Terminated},
+	},
+	{
+		Status:      Withdrawn,
+		Transitions: []StandardStatus{Active, Canceled, Expired, Terminated},
+	},
+	{
+		Status:      Canceled,
+		Transitions: []StandardStatus{Active},
+		Required:    []string{CancellationDatePath},
+		DateOrders:  []DateOrder{{Field: CancellationDatePath, NotBefore: ListingContractDatePath}},
+	},
+	{
+		Status:      Expired,
+		Transitions: []StandardStatus{Active},
+		Required:    []string{ExpirationDatePath},
+		DateOrders:  []DateOrder{{Field: ExpirationDatePath, NotBefore: ListingContractDatePath}},
+	},
+	{
+		Status: Terminated,
+	},
+	{
+		Status:      Unknown,
+		Transitions: []StandardStatus{Active, Pending, Sold, Hold, Temp, Inactive, Withdrawn, Canceled, Expired, Terminated},
+	},
+}
+
+// dateFields are the dates of a listing by path.
+var dateFields = map[string]func(*pb.Dates) *timestamppb.Timestamp{
+	ListingContractDatePath: (*pb.Dates).GetListingContractDate,
+	CloseDatePath:           (*pb.Dates).GetCloseDate,
+	CancellationDatePath:    (*pb.Dates).GetCancellationDate,
+	PendingTimestampPath:    (*pb.Dates).GetPendingTimestamp,
+	ExpirationDatePath:      (*pb.Dates).GetExpirationDate,
+}
+
+// ParseStatus returns the status of its name, and whether it is one.
+func ParseStatus(name string) (StandardStatus, bool) {
+	for s := Active; s <= Withdrawn; s++ {
+		if s.String() == name {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// Rule returns the lifecycle rule of a status.
+func Rule(s StandardStatus) (StatusRule, bool) {
+	for _, rule := range Lifecycle {
+		if rule.Status == s {
+			return rule, true
+		}
+	}
+	return StatusRule{}, false
+}
+
+// ValidateTransition : validate that a listing may change from a status to another. A listing may always keep its status,
+// and one without a recognized status may change to any.
+func ValidateTransition(from string, to string) error {
+	if from == to {
+		return nil
+	}
+	fromStatus, ok := ParseStatus(from)
+	if !ok {
+		return nil
+	}
+	toStatus, ok := ParseStatus(to)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "Standard Status value was not recognized as an acceptable value : "+to)
+	}
+	rule, _ := Rule(fromStatus)
+	for _, allowed := range rule.Transitions {
+		if allowed == toStatus {
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "Standard Status can not change from %s to %s", from, to)
+}
+
+// ValidateStatus : validate that a listing has the fields its status requires and that its dates are in order.
+func ValidateStatus(listing *pb.Listing) error {
+	s, ok := ParseStatus(listing.GetStandardStatus())
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "Standard Status value was not recognized as an acceptable value : "+listing.GetStandardStatus())
+	}
+	rule, _ := Rule(s)
+	for _, path := range rule.Required {
+		if !isSet(listing, path) {
+			return status.Errorf(codes.InvalidArgument, "%s is required for a %s listing", path, s)
+		}
+	}
+	for _, order := range rule.DateOrders {
+		date, notBefore := listingDate(listing, order.Field), listingDate(listing, order.NotBefore)
+		if date != nil && notBefore != nil && date.AsTime().Before(notBefore.AsTime()) {
+			return status.Errorf(codes.InvalidArgument, "%s of a %s listing can not be earlier than %s", order.Field, s, order.NotBefore)
+		}
+	}
+	return nil
+}
+
+// UpdatedListing returns the listing as an update sets it, the status, prices and dates of the update replace those of the listing
+// when they are set.
+func UpdatedListing(listing *pb.Listing, update *pb.UpdateListing) *pb.Listing {
+	updated := &pb.Listing{StandardStatus: listing.GetStandardStatus(), Price: &pb.Price{}, Dates: &pb.Dates{}}
+	if listing.GetPrice() != nil {
+		*updated.Price = pb.Price{ListPrice: listing.Price.ListPrice, ClosePrice: listing.Price.ClosePrice}
+	}
+	if listing.GetDates() != nil {
+		*updated.Dates = pb.Dates{
+			ListingContractDate: listing.Dates.ListingContractDate,
+			CloseDate:           listing.Dates.CloseDate,
+			CancellationDate:    listing.Dates.CancellationDate,
+			PendingTimestamp:    listing.Dates.PendingTimestamp,
+			ExpirationDate:      listing.Dates.ExpirationDate,
+		}
+	}
+	if update.GetStandardStatus() != "" {
+		updated.StandardStatus = update.StandardStatus
+	}
+	if price := update.GetPrice(); price != nil {
+		if price.ListPrice != 0 {
+			updated.Price.ListPrice = price.ListPrice
+		}
+		if price.ClosePrice != 0 {
+			updated.Price.ClosePrice = price.ClosePrice
+		}
+	}
+	if dates := update.GetDates(); dates != nil {
+		for _, date := range []struct {
+			value *timestamppb.Timestamp
+			set   **timestamppb.Timestamp
+		}{
+			{dates.ListingContractDate, &updated.Dates.ListingContractDate},
+			{dates.CloseDate, &updated.Dates.CloseDate},
+			{dates.CancellationDate, &updated.Dates.CancellationDate},
+			{dates.PendingTimestamp, &updated.Dates.PendingTimestamp},
+			{dates.ExpirationDate, &updated.Dates.ExpirationDate},
+		} {
+			if date.value != nil {
+				*date.set = date.value
+			}
+		}
+	}
+	return updated
+}
+
+// StatusLifecycle returns the lifecycle as the response of its introspection rpc.
+func StatusLifecycle() *pb.GetStatusLifecycleResponse {
+	response := &pb.GetStatusLifecycleResponse{}
+	for _, rule := range Lifecycle {
+		statusRule := &pb.StatusLifecycleRule{StandardStatus: rule.Status.String(), RequiredFields: rule.Required}
+		for _, to := range rule.Transitions {
+			statusRule.Transitions = append(statusRule.Transitions, to.String())
+		}
+		for _, order := range rule.DateOrders {
+			statusRule.DateOrders = append(statusRule.DateOrders, &pb.StatusDateOrder{Field: order.Field, NotBefore: order.NotBefore})
+		}
+		response.Statuses = append(response.Statuses, statusRule)
+	}
+	return response
+}
+
+func isSet(listing *pb.Listing, path string) bool {
+	if path == ClosePricePath {
+		return listing.GetPrice().GetClosePrice() != 0
+	}
+	return listingDate(listing, path) != nil
+}
+
+// listingDate returns a date of a listing, nil when it is not set.
+func listingDate(listing *pb.Listing, path string) *timestamppb.Timestamp {
+	get, ok := dateFields[path]
+	if !ok {
+		return nil
+	}
+	date := get(listing.GetDates())
+	if date == nil || date.AsTime().IsZero() || (date.Seconds == 0 && date.Nanos == 0) {
+		return nil
+	}
+	return date
+}
@@ -0,0 +1,102 @@
+package mlsvalidation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestLifecycle(t *testing.T) {
+	// every status has a rule, and its transitions are to other statuses with a rule.
+	for s := Active; s <= Withdrawn; s++ {
+		rule, ok := Rule(s)
+		assert.True(t, ok, s.String())
+		for _, to := range rule.Transitions {
+			_, ok := Rule(to)
+			assert.True(t, ok, to.String())
+			assert.NotEqual(t, s, to)
+		}
+		for _, order := range rule.DateOrders {
+			assert.Contains(t, dateFields, order.Field)
+			assert.Contains(t, dateFields, order.NotBefore)
+		}
+	}
+	assert.Len(t, StatusLifecycle().Statuses, len(Lifecycle))
+}
+
+func TestValidateTransition(t *testing.T) {
+	assert.Nil(t, ValidateTransition("ACTIVE", "PENDING"))
+	assert.Nil(t, ValidateTransition("PENDING", "ACTIVE"))
+	assert.Nil(t, ValidateTransition("SOLD", "SOLD"))
+	assert.Nil(t, ValidateTransition("EXPIRED", "ACTIVE"))
+	// listings without a recognized status may be corrected.
+	assert.Nil(t, ValidateTransition("", "SOLD"))
+	assert.Nil(t, ValidateTransition("UNKNOWN", "SOLD"))
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(ValidateTransition("SOLD", "ACTIVE")))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(ValidateTransition("EXPIRED", "PENDING")))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(ValidateTransition("TERMINATED", "ACTIVE")))
+	assert.Equal(t, codes.InvalidArgument, status.Code(ValidateTransition("ACTIVE", "UNDER CONTRACT")))
+}
+
+func TestValidateStatus(t *testing.T) {
+	contract := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	dates := &pb.Dates{ListingContractDate: timestamppb.New(contract)}
+
+	assert.Nil(t, ValidateStatus(&pb.Listing{StandardStatus: "ACTIVE"}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(ValidateStatus(&pb.Listing{StandardStatus: "SOLD", Dates: dates})))
+	assert.Equal(t, codes.InvalidArgument, status.Code(ValidateStatus(&pb.Listing{StandardStatus: "SOLD", Price: &pb.Price{ClosePrice: 1000},
+		Dates: &pb.Dates{ListingContractDate: dates.ListingContractDate, CloseDate: timestamppb.New(contract.AddDate(0, 0, -1))}})))
+	assert.Nil(t, ValidateStatus(&pb.Listing{StandardStatus: "SOLD", Price: &pb.Price{ClosePrice: 1000},
+		Dates: &pb.Dates{ListingContractDate: dates.ListingContractDate, CloseDate: timestamppb.New(contract.AddDate(0, 1, 0))}}))
+
+	// a sale closes after it went pending.
+	err := ValidateStatus(&pb.Listing{StandardStatus: "SOLD", Price: &pb.Price{ClosePrice: 1000},
+		Dates: &pb.Dates{PendingTimestamp: timestamppb.New(contract.AddDate(0, 1, 0)), CloseDate: timestamppb.New(contract)}})
+	assert.Equal(t, "property.listing.dates.close_date of a SOLD listing can not be earlier than property.listing.dates.pending_timestamp", status.Convert(err).Message())
+
+	// dates without the date they follow are not ordered.
+	assert.Nil(t, ValidateStatus(&pb.Listing{StandardStatus: "EXPIRED", Dates: &pb.Dates{ExpirationDate: timestamppb.New(contract)}}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(ValidateStatus(&pb.Listing{StandardStatus: "EXPIRED"})))
+}
+
+func TestUpdatedListing(t *testing.T) {
+	contract := timestamppb.New(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC))
+	closeDate := timestamppb.New(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	listing := &pb.Listing{StandardStatus: "PENDING", Price: &pb.Price{ListPrice: 500000}, Dates: &pb.Dates{ListingContractDate: contract}}
+
+	updated := UpdatedListing(listing, &pb.UpdateListing{StandardStatus: "SOLD", Price: &pb.UpdatePrice{ClosePrice: 490000}, Dates: &pb.UpdateDates{CloseDate: closeDate}})
+	assert.Equal(t, "SOLD", updated.StandardStatus)
+	assert.Equal(t, 500000.0, updated.Price.ListPrice)
+	assert.Equal(t, 490000.0, updated.Price.ClosePrice)
+	assert.Equal(t, contract, updated.Dates.ListingContractDate)
+	assert.Equal(t, closeDate, updated.Dates.CloseDate)
+	// the listing is left as it is.
+	assert.Equal(t, "PENDING", listing.StandardStatus)
+	assert.Nil(t, listing.Dates.CloseDate)
+
+	assert.Equal(t, "PENDING", UpdatedListing(listing, &pb.UpdateListing{}).StandardStatus)
+	assert.Equal(t, "ACTIVE", UpdatedListing(nil, &pb.UpdateListing{StandardStatus: "ACTIVE"}).StandardStatus)
+}
+
+func TestValidateUpdateListing(t *testing.T) {
+	sold := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{StandardStatus: "SOLD"}}}
+	err := ValidateUpdateListing(&pb.UpdateMlsListingByListingIdRequest{SourceSystemKey: "SOLO",
+		Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{StandardStatus: "ACTIVE"}}}, sold)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	active := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{StandardStatus: "ACTIVE"}}}
+	err = ValidateUpdateListing(&pb.UpdateMlsListingByListingIdRequest{SourceSystemKey: "SOLO",
+		Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{StandardStatus: "PENDING"}}}, active)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = ValidateUpdateListing(&pb.UpdateMlsListingByListingIdRequest{SourceSystemKey: "SOLO",
+		Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{StandardStatus: "PENDING", Dates: &pb.UpdateDates{PendingTimestamp: timestamppb.Now()}}}}, active)
+	assert.Nil(t, err)
+}
@@ -110,14 +110,22 @@ func ValidateInsertListing(in *pb.MlsListingInput) error {
 	return nil
 }
 
-// ValidateUpdateListing : validate an update to a listing.
-func ValidateUpdateListing(in *pb.UpdateMlsListingByListingIdRequest, listingFromDB *pb.MlsListing) error {
-	if !((in.SourceSystemKey == "SOLO" || in.SourceSystemKey == "ELL") || (!IsEmpty(listingFromDB.Realogy) &&
+// ValidateUpdateSource : validate that a listing may be updated, listings of SOLO and ELL or flagged realogy listings with master ids.
+func ValidateUpdateSource(sourceSystemKey string, listingFromDB *pb.MlsListing) error {
+	if !((sourceSystemKey == "SOLO" || sourceSystemKey == "ELL") || (!IsEmpty(listingFromDB.Realogy) &&
 		listingFromDB.Realogy.IsRealogyListing && !IsEmpty(listingFromDB.MasterId) && !IsEmpty(listingFromDB.MasterId.ListAgentMasterId) &&
 		!IsEmpty(listingFromDB.MasterId.ListOfficeMasterId) &&
 		!IsEmpty(listingFromDB.MasterId.CompanyMasterId))) {
 		return status.Errorf(codes.Unauthenticated, "The request to update this listing is not authorized due to invalid source,missing master ids, or is not flagged as a realogy listing")
 	}
+	return nil
+}
+
+// ValidateUpdateListing : validate an update to a listing.
+func ValidateUpdateListing(in *pb.UpdateMlsListingByListingIdRequest, listingFromDB *pb.MlsListing) error {
+	if err := ValidateUpdateSource(in.SourceSystemKey, listingFromDB); err != nil {
+		return err
+	}
 	if in.Property.Listing != nil {
 		// if the status is empty we can assume they don't want to update it and can keep the current value
 		if !IsEmpty(in.Property.Listing.StandardStatus) && !IsValidStatus(in.Property.Listing.StandardStatus) {
@@ -127,22 +135,33 @@ func ValidateUpdateListing(in *pb.UpdateMlsListingByListingIdRequest, listingFro
 		if !IsEmpty(in.Property.Listing.Price) && !IsEmpty(in.Property.Listing.Price.ListPrice) && in.Property.Listing.Price.ListPrice < 0 {
 			return status.Errorf(codes.InvalidArgument, "List Price invalid.")
 		}
-		if in.Property.Listing.StandardStatus == "SOLD" &&
-			(IsEmpty(in.Property.Listing.Dates) || IsEmpty(in.Property.Listing.Dates.CloseDate) || IsEmpty(in.Property.Listing.Price) || IsEmpty(in.Property.Listing.Price.ClosePrice) || in.Property.Listing.Dates.CloseDate.AsTime().Before(listingFromDB.Property.Listing.Dates.ListingContractDate.AsTime())) {
-			return status.Errorf(codes.InvalidArgument, "Closed Date and Close Price required for SOLD listing and Close date must be later than ListingContractDate")
+		// status changes follow the lifecycle, and the listing as updated has the fields of its status with its dates in order.
+		if !IsEmpty(in.Property.Listing.StandardStatus) {
+			if err := ValidateTransition(listingFromDB.GetProperty().GetListing().GetStandardStatus(), in.Property.Listing.StandardStatus); err != nil {
+				return err
+			}
 		}
-		if in.Property.Listing.StandardStatus == "CANCELED" &&
-			(IsEmpty(in.Property.Listing.Dates) || IsEmpty(in.Property.Listing.Dates.CancellationDate) || in.Property.Listing.Dates.CancellationDate.AsTime().IsZero() || in.Property.Listing.Dates.CancellationDate.AsTime().Before(listingFromDB.Property.Listing.Dates.ListingContractDate.AsTime())) {
-			return status.Errorf(codes.InvalidArgument, "Cancellation Date required for CANCELED listing and Cancellation date must be later than ListingContractDate")
-		}
-		if in.Property.Listing.StandardStatus == "PENDING" &&
-			(IsEmpty(in.Property.Listing.Dates) || IsEmpty(in.Property.Listing.Dates.PendingTimestamp) || in.Property.Listing.Dates.PendingTimestamp.AsTime().Before(listingFromDB.Property.Listing.Dates.ListingContractDate.AsTime())) {
-			return status.Errorf(codes.InvalidArgument, "Pending Timestamp required for PENDING listing and Pending Timestamp must be later than ListingContractDate")
-		}
-		if in.Property.Listing.StandardStatus == "EXPIRED" &&
-			(IsEmpty(in.Property.Listing.Dates) || IsEmpty(in.Property.Listing.Dates.ExpirationDate) || in.Property.Listing.Dates.ExpirationDate.AsTime().IsZero() || in.Property.Listing.Dates.ExpirationDate.AsTime().Before(listingFromDB.Property.Listing.Dates.ListingContractDate.AsTime())) {
-			return status.Errorf(codes.InvalidArgument, "Expiration Date required for EXPIRED listing and expiration date must be later than ListingContractDate")
+		updated := UpdatedListing(listingFromDB.GetProperty().GetListing(), in.Property.Listing)
+		if !IsEmpty(in.Property.Listing.StandardStatus) || (!IsEmpty(in.Property.Listing.Dates) && IsValidStatus(updated.StandardStatus)) {
+			if err := ValidateStatus(updated); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
+
+// ValidatePatchedListing : validate the status, price and dates of a listing with a patch applied, as ValidateUpdateListing does for an update.
+func ValidatePatchedListing(listingFromDB *pb.MlsListing, listing *pb.MlsListing) error {
+	l := listing.GetProperty().GetListing()
+	if !IsValidStatus(l.GetStandardStatus()) {
+		return status.Errorf(codes.InvalidArgument, "Standard Status value was not recognized as an acceptable value : "+l.GetStandardStatus())
+	}
+	if l.GetPrice().GetListPrice() < 0 {
+		return status.Errorf(codes.InvalidArgument, "List Price invalid.")
+	}
+	if err := ValidateTransition(listingFromDB.GetProperty().GetListing().GetStandardStatus(), l.GetStandardStatus()); err != nil {
+		return err
+	}
+	return ValidateStatus(l)
+}
@@ -0,0 +1,136 @@
+package odata
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Edm types of the fields.
+const (
+	EdmString         = "Edm.String"
+	EdmDouble         = "Edm.Double"
+	EdmInt32          = "Edm.Int32"
+	EdmInt64          = "Edm.Int64"
+	EdmBoolean        = "Edm.Boolean"
+	EdmDateTimeOffset = "Edm.DateTimeOffset"
+)
+
+// KeyField is the key of the Property resource, the id of the listing document.
+const KeyField = "ListingKey"
+
+// Field is a field of the RESO Property resource.
+type Field struct {
+	Name string
+	Type string
+	// listing document path, e.g. "property.listing.price.list_price".
+	Path string
+	// proto field names from MlsListing to the field, empty for the key.
+	protoPath []string
+}
+
+var (
+	timestampType = reflect.TypeOf(timestamppb.Timestamp{})
+
+	// Fields of the Property resource in metadata order, the key first.
+	Fields []*Field
+	fields = map[string]*Field{}
+)
+
+func init() {
+	Fields = append(Fields, &Field{Name: KeyField, Type: EdmString, Path: "_id"})
+	Fields = append(Fields, catalog()...)
+	for _, field := range Fields {
+		fields[field.Name] = field
+	}
+}
+
+// Lookup returns a field of the Property resource by name.
+func Lookup(name string) (*Field, bool) {
+	field, ok := fields[name]
+	return field, ok
+}
+
+// catalog returns the single valued fields of MlsListing.property. The messages follow the RESO Data Dictionary,
+// a field is named after its message field, e.g. ListPrice for property.listing.price.list_price.
+// Names found more than once, e.g. Percentage of both compensations, are left out.
+func catalog() []*Field {
+	var found []*Field
+	property, _ := reflect.TypeOf(pb.MlsListing{}).FieldByName("Property")
+	walk(property.Type.Elem(), "property", []string{"property"}, &found)
+
+	count := map[string]int{}
+	for _, field := range found {
+		count[field.Name]++
+	}
+	var unique []*Field
+	for _, field := range found {
+		if count[field.Name] == 1 {
+			unique = append(unique, field)
+		}
+	}
+	sort.SliceStable(unique, func(i, j int) bool { return unique[i].Name < unique[j].Name })
+	return unique
+}
+
+func walk(t reflect.Type, path string, protoPath []string, found *[]*Field) {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		bsonName := strings.Split(structField.Tag.Get("bson"), ",")[0]
+		protoName := protoName(structField.Tag.Get("protobuf"))
+		if bsonName == "" || bsonName == "-" || protoName == "" {
+			continue
+		}
+		fieldPath := path + "." + bsonName
+		fieldProtoPath := append(append([]string{}, protoPath...), protoName)
+
+		fieldType := structField.Type
+		if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map {
+			continue // collections are not part of the resource.
+		}
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && fieldType != timestampType {
+			walk(fieldType, fieldPath, fieldProtoPath, found)
+			continue
+		}
+		if edmType := edmType(fieldType); edmType != "" {
+			*found = append(*found, &Field{Name: structField.Name, Type: edmType, Path: fieldPath, protoPath: fieldProtoPath})
+		}
+	}
+}
+
+// protoName returns the field name of a protobuf struct tag, e.g. "list_price" of "fixed64,1,opt,name=list_price,json=listPrice,proto3".
+func protoName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
+func edmType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return EdmString
+	case reflect.Float32, reflect.Float64:
+		return EdmDouble
+	case reflect.Int32, reflect.Uint32:
+		return EdmInt32
+	case reflect.Int64, reflect.Uint64:
+		return EdmInt64
+	case reflect.Bool:
+		return EdmBoolean
+	case reflect.Struct:
+		if t == timestampType {
+			return EdmDateTimeOffset
+		}
+	}
+	return ""
+}
@@ -0,0 +1,385 @@
+package odata
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var comparisonOperators = map[string]string{
+	"eq": "",
+	"ne": "$ne",
+	"gt": "$gt",
+	"ge": "$gte",
+	"lt": "$lt",
+	"le": "$lte",
+}
+
+// ParseFilter translates an OData $filter expression on the Property resource into a mongodb query of the listing documents.
+// Supported are the comparison operators eq, ne, gt, ge, lt and le, "in", the logical operators and, or and not, parentheses
+// and the string functions contains, startswith and endswith. An empty expression returns an empty query.
+func ParseFilter(expression string) (bson.D, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return bson.D{}, nil
+	}
+	p := &parser{tokens: tokens}
+	query, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("$filter: unexpected %q", p.tokens[p.pos].text)
+	}
+	return query, nil
+}
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	stringToken
+	openToken
+	closeToken
+	commaToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: openToken, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: closeToken, text: ")"})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: commaToken, text: ","})
+			i++
+		case c == '\'':
+			// quotes are escaped by doubling them, 'O''Hare'.
+			var text strings.Builder
+			for i++; ; i++ {
+				if i >= len(expression) {
+					return nil, fmt.Errorf("$filter: unterminated string")
+				}
+				if expression[i] == '\'' {
+					if i+1 < len(expression) && expression[i+1] == '\'' {
+						text.WriteByte('\'')
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				text.WriteByte(expression[i])
+			}
+			tokens = append(tokens, token{kind: stringToken, text: text.String()})
+		default:
+			start := i
+			for i < len(expression) && !strings.ContainsRune(" \t(),'", rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: wordToken, text: expression[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, error) {
+	t, ok := p.peek()
+	if !ok {
+		return token{}, fmt.Errorf("$filter: unexpected end of expression")
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != kind {
+		return fmt.Errorf("$filter: expected %q, found %q", text, t.text)
+	}
+	return nil
+}
+
+// keyword consumes the next token when it is the keyword.
+func (p *parser) keyword(keyword string) bool {
+	if t, ok := p.peek(); ok && t.kind == wordToken && t.text == keyword {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) or() (bson.D, error) {
+	return p.logical("or", "$or", p.and)
+}
+
+func (p *parser) and() (bson.D, error) {
+	return p.logical("and", "$and", p.unary)
+}
+
+// logical parses operands joined by a logical operator into a single query.
+func (p *parser) logical(keyword string, operator string, operand func() (bson.D, error)) (bson.D, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := bson.A{first}
+	for p.keyword(keyword) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, next)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return bson.D{{Key: operator, Value: operands}}, nil
+}
+
+func (p *parser) unary() (bson.D, error) {
+	if p.keyword("not") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return bson.D{{Key: "$nor", Value: bson.A{operand}}}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (bson.D, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == openToken {
+		query, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return query, p.expect(closeToken, ")")
+	}
+	if t.kind != wordToken {
+		return nil, fmt.Errorf("$filter: unexpected %q", t.text)
+	}
+	switch t.text {
+	case "contains", "startswith", "endswith":
+		return p.stringFunction(t.text)
+	}
+
+	field, ok := Lookup(t.text)
+	if !ok {
+		return nil, fmt.Errorf("$filter: unknown field %q", t.text)
+	}
+	operator, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if operator.kind == wordToken && operator.text == "in" {
+		return p.in(field)
+	}
+	mongoOperator, ok := comparisonOperators[operator.text]
+	if operator.kind != wordToken || !ok {
+		return nil, fmt.Errorf("$filter: expected a comparison operator after %s, found %q", field.Name, operator.text)
+	}
+	value, err := p.literal(field)
+	if err != nil {
+		return nil, err
+	}
+	if mongoOperator == "" {
+		return bson.D{{Key: field.Path, Value: value}}, nil
+	}
+	return bson.D{{Key: field.Path, Value: bson.D{{Key: mongoOperator, Value: value}}}}, nil
+}
+
+// in parses the values of "Field in (value, ...)".
+func (p *parser) in(field *Field) (bson.D, error) {
+	if err := p.expect(openToken, "("); err != nil {
+		return nil, err
+	}
+	values := bson.A{}
+	for {
+		value, err := p.literal(field)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == closeToken {
+			break
+		}
+		if t.kind != commaToken {
+			return nil, fmt.Errorf("$filter: expected \",\" or \")\", found %q", t.text)
+		}
+	}
+	return bson.D{{Key: field.Path, Value: bson.D{{Key: "$in", Value: values}}}}, nil
+}
+
+// stringFunction parses "contains(Field,'value')", startswith and endswith alike.
+func (p *parser) stringFunction(function string) (bson.D, error) {
+	if err := p.expect(openToken, "("); err != nil {
+		return nil, err
+	}
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	field, ok := Lookup(t.text)
+	if t.kind != wordToken || !ok {
+		return nil, fmt.Errorf("$filter: unknown field %q", t.text)
+	}
+	if field.Type != EdmString {
+		return nil, fmt.Errorf("$filter: %s requires a string field, %s is %s", function, field.Name, field.Type)
+	}
+	if err := p.expect(commaToken, ","); err != nil {
+		return nil, err
+	}
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if value.kind != stringToken {
+		return nil, fmt.Errorf("$filter: %s requires a string, found %q", function, value.text)
+	}
+	if err := p.expect(closeToken, ")"); err != nil {
+		return nil, err
+	}
+
+	pattern := regexp.QuoteMeta(value.text)
+	switch function {
+	case "startswith":
+		pattern = "^" + pattern
+	case "endswith":
+		pattern = pattern + "$"
+	}
+	return bson.D{{Key: field.Path, Value: primitive.Regex{Pattern: pattern}}}, nil
+}
+
+// literal parses a value compared to a field, it must be of the type of the field or null.
+func (p *parser) literal(field *Field) (interface{}, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == wordToken && t.text == "null" {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case EdmString:
+		if t.kind == stringToken {
+			return t.text, nil
+		}
+	case EdmDouble, EdmInt32, EdmInt64:
+		if t.kind == wordToken {
+			if number, err := strconv.ParseFloat(t.text, 64); err == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+				if field.Type != EdmDouble && number == math.Trunc(number) {
+					return int64(number), nil
+				}
+				return number, nil
+			}
+		}
+	case EdmBoolean:
+		if t.kind == wordToken && (t.text == "true" || t.text == "false") {
+			return t.text == "true", nil
+		}
+	case EdmDateTimeOffset:
+		if t.kind == wordToken {
+			if date, err := time.Parse(time.RFC3339Nano, t.text); err == nil {
+				return date.UTC(), nil
+			}
+			if date, err := time.Parse("2006-01-02", t.text); err == nil {
+				return date, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("$filter: %s is %s, %q is not a value of it", field.Name, field.Type, t.text)
+}
+
+// ParseOrderBy translates an OData $orderby, e.g. "ListPrice desc,ModificationTimestamp", into a mongodb sort.
+// The listing key is appended as the last key so that pages of $top and $skip are stable.
+func ParseOrderBy(orderBy string) (bson.D, error) {
+	sort := bson.D{}
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 {
+			if strings.TrimSpace(orderBy) == "" {
+				break
+			}
+			return nil, fmt.Errorf("$orderby: empty item")
+		}
+		field, ok := Lookup(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("$orderby: unknown field %q", parts[0])
+		}
+		direction := 1
+		if len(parts) == 2 && parts[1] == "desc" {
+			direction = -1
+		} else if len(parts) > 2 || len(parts) == 2 && parts[1] != "asc" {
+			return nil, fmt.Errorf("$orderby: expected asc or desc after %s", field.Name)
+		}
+		sort = append(sort, bson.E{Key: field.Path, Value: direction})
+	}
+	for _, e := range sort {
+		if e.Key == "_id" {
+			return sort, nil
+		}
+	}
+	return append(sort, bson.E{Key: "_id", Value: 1}), nil
+}
+
+// ParseSelect returns the fields of an OData $select, all fields when it is empty.
+func ParseSelect(names []string) ([]*Field, error) {
+	if len(names) == 0 {
+		return Fields, nil
+	}
+	selected := make([]*Field, 0, len(names))
+	for _, name := range names {
+		field, ok := Lookup(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("$select: unknown field %q", name)
+		}
+		selected = append(selected, field)
+	}
+	return selected, nil
+}
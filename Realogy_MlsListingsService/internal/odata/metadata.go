@@ -0,0 +1,81 @@
+package odata
+
+import (
+	"encoding/xml"
+)
+
+// Namespace is the schema namespace of the RESO Web API resources.
+const Namespace = "org.reso.metadata"
+
+// EntitySet is the name of the entity set of the listings.
+const EntitySet = "Property"
+
+type edmx struct {
+	XMLName      xml.Name     `xml:"edmx:Edmx"`
+	Xmlns        string       `xml:"xmlns:edmx,attr"`
+	Version      string       `xml:"Version,attr"`
+	DataServices dataServices `xml:"edmx:DataServices"`
+}
+
+type dataServices struct {
+	Schema schema `xml:"Schema"`
+}
+
+type schema struct {
+	Xmlns      string     `xml:"xmlns,attr"`
+	Namespace  string     `xml:"Namespace,attr"`
+	EntityType entityType `xml:"EntityType"`
+	Container  container  `xml:"EntityContainer"`
+}
+
+type entityType struct {
+	Name       string      `xml:"Name,attr"`
+	Key        propertyRef `xml:"Key>PropertyRef"`
+	Properties []property  `xml:"Property"`
+}
+
+type propertyRef struct {
+	Name string `xml:"Name,attr"`
+}
+
+type property struct {
+	Name     string `xml:"Name,attr"`
+	Type     string `xml:"Type,attr"`
+	Nullable string `xml:"Nullable,attr,omitempty"`
+}
+
+type container struct {
+	Name      string    `xml:"Name,attr"`
+	EntitySet entitySet `xml:"EntitySet"`
+}
+
+type entitySet struct {
+	Name       string `xml:"Name,attr"`
+	EntityType string `xml:"EntityType,attr"`
+}
+
+// Metadata returns the CSDL ($metadata) document of the service.
+func Metadata() ([]byte, error) {
+	properties := make([]property, 0, len(Fields))
+	for _, field := range Fields {
+		p := property{Name: field.Name, Type: field.Type}
+		if field.Name == KeyField {
+			p.Nullable = "false"
+		}
+		properties = append(properties, p)
+	}
+	document, err := xml.MarshalIndent(edmx{
+		Xmlns:   "http://docs.oasis-open.org/odata/ns/edmx",
+		Version: "4.0",
+		DataServices: dataServices{Schema: schema{
+			Xmlns:      "http://docs.oasis-open.org/odata/ns/edm",
+			Namespace:  Namespace,
+			EntityType: entityType{Name: EntitySet, Key: propertyRef{Name: KeyField}, Properties: properties},
+			Container:  container{Name: "Default", EntitySet: entitySet{Name: EntitySet, EntityType: Namespace + "." + EntitySet}},
+		}},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), document...), nil
+}
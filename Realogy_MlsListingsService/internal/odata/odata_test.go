@@ -0,0 +1,157 @@
+package odata_test
+
+import (
+	"mlslisting/internal/odata"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestCatalog(t *testing.T) {
+	assert.Equal(t, odata.KeyField, odata.Fields[0].Name)
+
+	field, ok := odata.Lookup("ListPrice")
+	assert.True(t, ok)
+	assert.Equal(t, odata.EdmDouble, field.Type)
+	assert.Equal(t, "property.listing.price.list_price", field.Path)
+
+	field, ok = odata.Lookup("ModificationTimestamp")
+	assert.True(t, ok)
+	assert.Equal(t, odata.EdmDateTimeOffset, field.Type)
+
+	_, ok = odata.Lookup("list_price")
+	assert.False(t, ok)
+}
+
+func TestParseFilterEmpty(t *testing.T) {
+	filter, err := odata.ParseFilter("  ")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{}, filter)
+}
+
+func TestParseFilterComparison(t *testing.T) {
+	filter, err := odata.ParseFilter("City eq 'Irving' and ListPrice le 500000 and BedroomsTotal ge 3")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "property.location.address.city", Value: "Irving"}},
+		bson.D{{Key: "property.listing.price.list_price", Value: bson.D{{Key: "$lte", Value: float64(500000)}}}},
+		bson.D{{Key: "property.structure.bedrooms_total", Value: bson.D{{Key: "$gte", Value: int64(3)}}}},
+	}}}, filter)
+}
+
+func TestParseFilterPrecedence(t *testing.T) {
+	// and binds tighter than or, parentheses group.
+	filter, err := odata.ParseFilter("StandardStatus eq 'ACTIVE' or not (City eq 'O''Fallon' and Fireplace eq true)")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "property.listing.standard_status", Value: "ACTIVE"}},
+		bson.D{{Key: "$nor", Value: bson.A{
+			bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "property.location.address.city", Value: "O'Fallon"}},
+				bson.D{{Key: "property.structure.fireplace", Value: true}},
+			}}},
+		}}},
+	}}}, filter)
+}
+
+func TestParseFilterInAndFunctions(t *testing.T) {
+	filter, err := odata.ParseFilter("PostalCode in ('75038', '75039') and startswith(City, 'Irv.') and ModificationTimestamp gt 2022-01-02T03:04:05Z")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "property.location.address.postal_code", Value: bson.D{{Key: "$in", Value: bson.A{"75038", "75039"}}}}},
+		bson.D{{Key: "property.location.address.city", Value: primitive.Regex{Pattern: `^Irv\.`}}},
+		bson.D{{Key: "property.listing.dates.modification_timestamp", Value: bson.D{{Key: "$gt", Value: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)}}}},
+	}}}, filter)
+
+	filter, err = odata.ParseFilter("ListingKey ne null")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "_id", Value: bson.D{{Key: "$ne", Value: nil}}}}, filter)
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	for expression, message := range map[string]string{
+		"Town eq 'Irving'":            `$filter: unknown field "Town"`,
+		"City eq 5":                   `$filter: City is Edm.String, "5" is not a value of it`,
+		"ListPrice eq 'cheap'":        `$filter: ListPrice is Edm.Double, "cheap" is not a value of it`,
+		"City like 'Irving'":          `$filter: expected a comparison operator after City, found "like"`,
+		"City eq 'Irving":             `$filter: unterminated string`,
+		"(City eq 'Irving'":           `$filter: unexpected end of expression`,
+		"City eq 'Irving' ListPrice":  `$filter: unexpected "ListPrice"`,
+		"contains(ListPrice, '5')":    `$filter: contains requires a string field, ListPrice is Edm.Double`,
+		"PostalCode in ('75038' '1')": `$filter: expected "," or ")", found "1"`,
+		"City eq 'Irving' and":        `$filter: unexpected end of expression`,
+	} {
+		_, err := odata.ParseFilter(expression)
+		if assert.NotNil(t, err, expression) {
+			assert.Equal(t, message, err.Error(), expression)
+		}
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	sort, err := odata.ParseOrderBy("ListPrice desc, City asc,BedroomsTotal")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{
+		{Key: "property.listing.price.list_price", Value: -1},
+		{Key: "property.location.address.city", Value: 1},
+		{Key: "property.structure.bedrooms_total", Value: 1},
+		{Key: "_id", Value: 1},
+	}, sort)
+
+	sort, err = odata.ParseOrderBy("")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "_id", Value: 1}}, sort)
+
+	sort, err = odata.ParseOrderBy("ListingKey desc")
+	assert.Nil(t, err)
+	assert.Equal(t, bson.D{{Key: "_id", Value: -1}}, sort)
+
+	_, err = odata.ParseOrderBy("ListPrice down")
+	assert.NotNil(t, err)
+	_, err = odata.ParseOrderBy("ListPrice,")
+	assert.NotNil(t, err)
+}
+
+func TestParseSelect(t *testing.T) {
+	selected, err := odata.ParseSelect(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, odata.Fields, selected)
+
+	selected, err = odata.ParseSelect([]string{"ListingKey", " ListPrice"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ListingKey", "ListPrice"}, []string{selected[0].Name, selected[1].Name})
+
+	_, err = odata.ParseSelect([]string{"Price"})
+	assert.NotNil(t, err)
+}
+
+func TestRecord(t *testing.T) {
+	listing := &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{
+		ListingId: "100018",
+		Price:     &pb.Price{ListPrice: 250000},
+		Dates:     &pb.Dates{ModificationTimestamp: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC))},
+	}}}
+	selected, _ := odata.ParseSelect([]string{"ListingKey", "ListingId", "ListPrice", "ModificationTimestamp", "City"})
+
+	assert.Equal(t, map[string]interface{}{
+		"ListingKey":            "100018-KY_WKRMLS",
+		"ListingId":             "100018",
+		"ListPrice":             float64(250000),
+		"ModificationTimestamp": "2022-01-02T03:04:05Z",
+		"City":                  nil,
+	}, odata.Record("100018-KY_WKRMLS", listing, selected))
+}
+
+func TestMetadata(t *testing.T) {
+	document, err := odata.Metadata()
+	assert.Nil(t, err)
+	assert.Contains(t, string(document), `<PropertyRef Name="ListingKey"></PropertyRef>`)
+	assert.Contains(t, string(document), `<Property Name="ListPrice" Type="Edm.Double"></Property>`)
+	assert.Contains(t, string(document), `<EntitySet Name="Property" EntityType="org.reso.metadata.Property"></EntitySet>`)
+}
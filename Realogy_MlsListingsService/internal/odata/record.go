@@ -0,0 +1,47 @@
+package odata
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Record returns a listing as a record of the Property resource with the selected fields.
+// Fields under a message the listing does not have are null.
+func Record(key string, listing *pb.MlsListing, selected []*Field) map[string]interface{} {
+	record := make(map[string]interface{}, len(selected))
+	for _, field := range selected {
+		if field.Name == KeyField {
+			record[KeyField] = key
+			continue
+		}
+		record[field.Name] = value(listing.ProtoReflect(), field.protoPath)
+	}
+	return record
+}
+
+func value(m protoreflect.Message, protoPath []string) interface{} {
+	for i, name := range protoPath {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil
+		}
+		if fd.Message() == nil {
+			return m.Get(fd).Interface()
+		}
+		if !m.Has(fd) {
+			return nil
+		}
+		if i == len(protoPath)-1 { // timestamps are the only message fields.
+			if timestamp, ok := m.Get(fd).Message().Interface().(*timestamppb.Timestamp); ok {
+				return timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+			}
+			return nil
+		}
+		m = m.Get(fd).Message()
+	}
+	return nil
+}
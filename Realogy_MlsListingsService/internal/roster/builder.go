@@ -0,0 +1,176 @@
+package roster
+
+import (
+	"context"
+	"mlslisting/internal/derived"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const retryDelay = 10 * time.Second
+
+// listingEvent is a listing change event, projected to the source and the mls ids of the members of the rosters.
+type listingEvent struct {
+	Listing bson.Raw `bson:"fullDocument"`
+}
+
+// Builder keeps the rosters of agents and offices current with the listings. The members of changed listings are refreshed
+// from the change stream of the listings collection, and the rosters are rebuilt every interval: members removed from a listing
+// or only in hard deleted listings are kept until then.
+// Builds are not coordinated across instances of the service, a single instance should run the builder.
+type Builder struct {
+	listings        *mongo.Collection
+	rosters         map[Kind]*mongo.Collection
+	rebuildInterval time.Duration
+	now             func() time.Time
+}
+
+func NewBuilder(listings *mongo.Collection, agents *mongo.Collection, offices *mongo.Collection, rebuildInterval time.Duration) *Builder {
+	return &Builder{
+		listings:        listings,
+		rosters:         map[Kind]*mongo.Collection{Agents: agents, Offices: offices},
+		rebuildInterval: rebuildInterval,
+		now:             time.Now,
+	}
+}
+
+// Run rebuilds the rosters and refreshes them from listing changes until ctx is done.
+func (b *Builder) Run(ctx context.Context) {
+	go b.rebuildEvery(ctx)
+	for ctx.Err() == nil {
+		if err := b.watch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Roster change stream failed, restarting. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (b *Builder) rebuildEvery(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := b.Rebuild(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Unable to rebuild the rosters. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(b.rebuildInterval):
+		}
+	}
+}
+
+// Rebuild aggregates the rosters from all the listings and removes the members no longer in any listing.
+func (b *Builder) Rebuild(ctx context.Context) error {
+	started := time.Now()
+	refreshed := b.now()
+	for kind, roster := range b.rosters {
+		if err := b.merge(ctx, kind, primitive.D{}, nil, refreshed); err != nil {
+			return err
+		}
+		if _, err := roster.DeleteMany(ctx, bson.D{{Key: "refreshed_at", Value: bson.D{{Key: "$lt", Value: refreshed}}}}); err != nil {
+			return err
+		}
+	}
+	log.Infof("Rebuilt the rosters of agents and offices in %v", time.Since(started))
+	return nil
+}
+
+func (b *Builder) watch(ctx context.Context) error {
+	project := bson.D{{Key: "operationType", Value: 1}, {Key: "fullDocument.source_system_key", Value: 1}}
+	for _, kind := range []Kind{Agents, Offices} {
+		for _, path := range kind.MlsIdPaths() {
+			project = append(project, bson.E{Key: "fullDocument." + path, Value: 1})
+		}
+	}
+	pipeline := mongo.Pipeline{
+		derived.ChangeStage(),
+		bson.D{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}}}}},
+		bson.D{{Key: "$project", Value: project}},
+	}
+	cs, err := b.listings.Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if err != nil {
+		return err
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var event listingEvent
+		if err := cs.Decode(&event); err != nil {
+			log.Errorf("Unable to decode listing event for the rosters. %v", err)
+			continue
+		}
+		if event.Listing == nil { // the listing is deleted by the time the update is looked up.
+			continue
+		}
+		if err := b.refresh(ctx, event.Listing); err != nil {
+			return err
+		}
+	}
+	return cs.Err()
+}
+
+// refresh aggregates the members of a listing again from all their listings.
+func (b *Builder) refresh(ctx context.Context, listing bson.Raw) error {
+	source, _ := listing.Lookup("source_system_key").StringValueOK()
+	if source == "" {
+		return nil
+	}
+	for kind, roster := range b.rosters {
+		ids, filter := Members(kind, source, listing)
+		if len(ids) == 0 {
+			continue
+		}
+		refreshed := b.now()
+		if err := b.merge(ctx, kind, filter, ids, refreshed); err != nil {
+			return err
+		}
+		if _, err := roster.DeleteMany(ctx, bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+			{Key: "refreshed_at", Value: bson.D{{Key: "$lt", Value: refreshed}}},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Members returns the roster ids of the members named by a listing of a source, and the filter of the listings naming them.
+func Members(kind Kind, source string, listing bson.Raw) ([]string, primitive.D) {
+	var ids []string
+	var listings bson.A
+	seen := map[string]bool{}
+	for _, path := range kind.MlsIdPaths() {
+		mlsId, _ := listing.Lookup(strings.Split(path, ".")...).StringValueOK()
+		if mlsId == "" {
+			continue
+		}
+		if id := Id(source, mlsId); !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+			for _, p := range kind.MlsIdPaths() {
+				listings = append(listings, bson.D{{Key: p, Value: mlsId}})
+			}
+		}
+	}
+	return ids, primitive.D{{Key: "source_system_key", Value: source}, {Key: "$or", Value: listings}}
+}
+
+// Id is the roster id of a member, its source and mls id.
+func Id(source string, mlsId string) string {
+	return source + "_" + mlsId
+}
+
+func (b *Builder) merge(ctx context.Context, kind Kind, filter primitive.D, ids []string, refreshed time.Time) error {
+	cur, err := b.listings.Aggregate(ctx, Pipeline(kind, filter, ids, b.rosters[kind].Name(), refreshed), options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	return cur.Close(ctx)
+}
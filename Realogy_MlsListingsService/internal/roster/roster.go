@@ -0,0 +1,178 @@
+package roster
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"mlslisting/internal/tombstone"
+)
+
+// Kind is a roster of agents or offices.
+type Kind int
+
+const (
+	Agents Kind = iota
+	Offices
+)
+
+// activeStatus is the status of the listings counted as active.
+const activeStatus = "ACTIVE"
+
+// member is where a listing names a member of a roster: its mls id, master id and contact fields.
+type member struct {
+	mlsId    string
+	masterId string
+	fields   bson.D
+}
+
+// agentRoles are the agents of a listing, the listing agent first as it is the one with the most contact fields.
+var agentRoles = []member{
+	{"$property.listing.agent_office.list_agent.list_agent_mls_id", "$master_id.list_agent_master_id", bson.D{
+		{Key: "full_name", Value: "$property.listing.agent_office.list_agent.list_agent_fullname"},
+		{Key: "email", Value: "$property.listing.agent_office.list_agent.list_agent_email"},
+		{Key: "phone", Value: "$property.listing.agent_office.list_agent.list_agent_office_phone"},
+		{Key: "state_license", Value: "$property.listing.agent_office.list_agent.list_agent_state_license"},
+		{Key: "office_mls_id", Value: "$property.listing.agent_office.list_office.list_office_mls_id"},
+		{Key: "office_name", Value: "$property.listing.agent_office.list_office.list_office_name"},
+	}},
+	{"$property.listing.agent_office.co_list_agent.co_list_agent_mls_id", "$master_id.co_list_agent_master_id", bson.D{
+		{Key: "full_name", Value: "$property.listing.agent_office.co_list_agent.co_list_agent_full_name"},
+		{Key: "phone", Value: "$property.listing.agent_office.co_list_agent.co_list_agent_office_phone"},
+		{Key: "office_mls_id", Value: "$property.listing.agent_office.co_list_office.co_list_office_mls_id"},
+		{Key: "office_name", Value: "$property.listing.agent_office.co_list_office.co_list_office_name"},
+	}},
+	{"$property.listing.agent_office.buyer_agent.buyer_agent_mls_id", "$master_id.buyer_agent_master_id", bson.D{
+		{Key: "full_name", Value: "$property.listing.agent_office.buyer_agent.buyer_agent_fullname"},
+		{Key: "phone", Value: "$property.listing.agent_office.buyer_agent.buyer_office_phone"},
+		{Key: "office_mls_id", Value: "$property.listing.agent_office.buyer_office.buyer_office_mls_id"},
+		{Key: "office_name", Value: "$property.listing.agent_office.buyer_office.buyer_office_name"},
+	}},
+	{"$property.listing.agent_office.co_buyer_agent.co_buyer_agent_mls_id", "$master_id.co_buyer_agent_master_id", bson.D{
+		{Key: "full_name", Value: "$property.listing.agent_office.co_buyer_agent.co_buyer_agent_fullname"},
+		{Key: "office_mls_id", Value: "$property.listing.agent_office.co_buyer_office.co_buyer_office_mls_id"},
+		{Key: "office_name", Value: "$property.listing.agent_office.co_buyer_office.co_buyer_office_name"},
+	}},
+}
+
+// officeRoles are the offices of a listing, the listing office first.
+var officeRoles = []member{
+	{"$property.listing.agent_office.list_office.list_office_mls_id", "$master_id.list_office_master_id", bson.D{
+		{Key: "name", Value: "$property.listing.agent_office.list_office.list_office_name"},
+		{Key: "phone", Value: "$property.listing.agent_office.list_office.list_office_phone"},
+		{Key: "email", Value: "$property.listing.agent_office.list_office.list_office_email"},
+		{Key: "address", Value: "$property.listing.agent_office.list_office.list_office_address"},
+		{Key: "city", Value: "$property.listing.agent_office.list_office.list_office_city"},
+		{Key: "state_or_province", Value: "$property.listing.agent_office.list_office.list_office_state_or_province"},
+		{Key: "postal_code", Value: "$property.listing.agent_office.list_office.list_office_postal_code"},
+	}},
+	{"$property.listing.agent_office.co_list_office.co_list_office_mls_id", "$master_id.co_list_office_master_id", bson.D{
+		{Key: "name", Value: "$property.listing.agent_office.co_list_office.co_list_office_name"},
+		{Key: "phone", Value: "$property.listing.agent_office.co_list_office.co_list_office_phone"},
+	}},
+	{"$property.listing.agent_office.buyer_office.buyer_office_mls_id", "$master_id.buyer_office_master_id", bson.D{
+		{Key: "name", Value: "$property.listing.agent_office.buyer_office.buyer_office_name"},
+		{Key: "phone", Value: "$property.listing.agent_office.buyer_office.buyer_office_phone"},
+	}},
+	{"$property.listing.agent_office.co_buyer_office.co_buyer_office_mls_id", "$master_id.co_buyer_office_master_id", bson.D{
+		{Key: "name", Value: "$property.listing.agent_office.co_buyer_office.co_buyer_office_name"},
+		{Key: "phone", Value: "$property.listing.agent_office.co_buyer_office.co_buyer_office_phone"},
+	}},
+}
+
+// contact fields of the members of each roster, the non empty value of the most recently changed listing is kept.
+var contactFields = map[Kind][]string{
+	Agents:  {"full_name", "email", "phone", "state_license", "office_mls_id", "office_name"},
+	Offices: {"name", "phone", "email", "address", "city", "state_or_province", "postal_code"},
+}
+
+func (k Kind) roles() []member {
+	if k == Offices {
+		return officeRoles
+	}
+	return agentRoles
+}
+
+// MlsIdPaths are the listing paths of the mls ids of the roster members, to find the listings of a member.
+func (k Kind) MlsIdPaths() []string {
+	var paths []string
+	for _, role := range k.roles() {
+		paths = append(paths, role.mlsId[1:])
+	}
+	return paths
+}
+
+// Pipeline aggregates the listings matching the filter into the members of a roster and merges them into the roster collection.
+// Members are keyed by their source and mls id, members restricts the result to those ids when it is not nil.
+// Merged members are stamped with refreshed, the members left with an older stamp are no longer in any listing.
+func Pipeline(kind Kind, filter primitive.D, members []string, into string, refreshed time.Time) mongo.Pipeline {
+	roles := bson.A{}
+	for _, role := range kind.roles() {
+		fields := bson.D{{Key: "mls_id", Value: role.mlsId}, {Key: "master_id", Value: role.masterId}}
+		roles = append(roles, append(fields, role.fields...))
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tombstone.Exclude(filter)}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "source_system_key", Value: 1},
+			{Key: "active", Value: bson.D{{Key: "$eq", Value: bson.A{"$property.listing.standard_status", activeStatus}}}},
+			{Key: "last_change_date", Value: 1},
+			{Key: "member", Value: roles},
+		}}},
+		{{Key: "$unwind", Value: "$member"}},
+		{{Key: "$match", Value: bson.D{{Key: "member.mls_id", Value: bson.D{{Key: "$nin", Value: bson.A{nil, ""}}}}}}},
+		{{Key: "$addFields", Value: bson.D{{Key: "member_id", Value: bson.D{{Key: "$concat", Value: bson.A{"$source_system_key", "_", "$member.mls_id"}}}}}}},
+	}
+	if members != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "member_id", Value: bson.D{{Key: "$in", Value: members}}}}}})
+	}
+
+	group := bson.D{
+		{Key: "_id", Value: "$member_id"},
+		{Key: "source_system_key", Value: bson.D{{Key: "$first", Value: "$source_system_key"}}},
+		{Key: "mls_id", Value: bson.D{{Key: "$first", Value: "$member.mls_id"}}},
+		{Key: "master_id", Value: bson.D{{Key: "$max", Value: "$member.master_id"}}},
+		{Key: "listings", Value: bson.D{{Key: "$addToSet", Value: "$_id"}}},
+		{Key: "active_listings", Value: bson.D{{Key: "$addToSet", Value: bson.D{{Key: "$cond", Value: bson.A{"$active", "$_id", nil}}}}}},
+		{Key: "last_activity_time", Value: bson.D{{Key: "$max", Value: "$last_change_date"}}},
+	}
+	project := bson.D{
+		{Key: "source_system_key", Value: 1},
+		{Key: "mls_id", Value: 1},
+		{Key: "master_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$master_id", ""}}}},
+		{Key: "listing_count", Value: bson.D{{Key: "$size", Value: "$listings"}}},
+		{Key: "active_listing_count", Value: bson.D{{Key: "$size", Value: present("$active_listings")}}},
+		{Key: "last_activity_time", Value: 1},
+		{Key: "refreshed_at", Value: refreshed},
+	}
+	for _, field := range contactFields[kind] {
+		// the values of the most recently changed listings come first.
+		group = append(group, bson.E{Key: field, Value: bson.D{{Key: "$push", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$member." + field, ""}}}}}})
+		project = append(project, bson.E{Key: field, Value: bson.D{{Key: "$ifNull", Value: bson.A{
+			bson.D{{Key: "$arrayElemAt", Value: bson.A{present("$" + field), 0}}}, "",
+		}}}})
+	}
+
+	return append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "last_change_date", Value: -1}}}},
+		bson.D{{Key: "$group", Value: group}},
+		bson.D{{Key: "$project", Value: project}},
+		bson.D{{Key: "$merge", Value: bson.D{
+			{Key: "into", Value: into},
+			{Key: "on", Value: "_id"},
+			{Key: "whenMatched", Value: "replace"},
+			{Key: "whenNotMatched", Value: "insert"},
+		}}},
+	)
+}
+
+// present filters the null and empty values out of an array.
+func present(array string) bson.D {
+	return bson.D{{Key: "$filter", Value: bson.D{
+		{Key: "input", Value: array},
+		{Key: "cond", Value: bson.D{{Key: "$not", Value: bson.A{bson.D{{Key: "$in", Value: bson.A{"$$this", bson.A{nil, ""}}}}}}}},
+	}}}
+}
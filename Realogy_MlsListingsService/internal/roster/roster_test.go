@@ -0,0 +1,59 @@
+package roster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMlsIdPaths(t *testing.T) {
+	assert.Equal(t, []string{
+		"property.listing.agent_office.list_agent.list_agent_mls_id",
+		"property.listing.agent_office.co_list_agent.co_list_agent_mls_id",
+		"property.listing.agent_office.buyer_agent.buyer_agent_mls_id",
+		"property.listing.agent_office.co_buyer_agent.co_buyer_agent_mls_id",
+	}, Agents.MlsIdPaths())
+	assert.Len(t, Offices.MlsIdPaths(), 4)
+}
+
+func TestMembers(t *testing.T) {
+	listing, err := bson.Marshal(bson.D{{Key: "property", Value: bson.D{{Key: "listing", Value: bson.D{{Key: "agent_office", Value: bson.D{
+		{Key: "list_agent", Value: bson.D{{Key: "list_agent_mls_id", Value: "AG1"}}},
+		{Key: "co_list_agent", Value: bson.D{{Key: "co_list_agent_mls_id", Value: ""}}},
+		// the same agent in two roles is a single member.
+		{Key: "buyer_agent", Value: bson.D{{Key: "buyer_agent_mls_id", Value: "AG1"}}},
+		{Key: "co_buyer_agent", Value: bson.D{{Key: "co_buyer_agent_mls_id", Value: "AG2"}}},
+	}}}}}}})
+	assert.Nil(t, err)
+
+	ids, filter := Members(Agents, "CRMLS", listing)
+	assert.Equal(t, []string{"CRMLS_AG1", "CRMLS_AG2"}, ids)
+	assert.Equal(t, bson.E{Key: "source_system_key", Value: "CRMLS"}, filter[0])
+	// the listings naming the members in any role.
+	assert.Len(t, filter[1].Value, 8)
+
+	ids, _ = Members(Offices, "CRMLS", listing)
+	assert.Empty(t, ids)
+}
+
+func TestPipeline(t *testing.T) {
+	refreshed := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	pipeline := Pipeline(Offices, primitive.D{{Key: "source_system_key", Value: "CRMLS"}}, []string{"CRMLS_OF1"}, "listing_offices", refreshed)
+
+	// deleted listings are not counted.
+	match := pipeline[0][0].Value.(primitive.D)
+	assert.Equal(t, "source_system_key", match[0].Key)
+	assert.Len(t, match, 2)
+	assert.Equal(t, bson.E{Key: "$unwind", Value: "$member"}, pipeline[2][0])
+	assert.Equal(t, bson.D{{Key: "member_id", Value: bson.D{{Key: "$in", Value: []string{"CRMLS_OF1"}}}}}, pipeline[5][0].Value)
+
+	project := pipeline[len(pipeline)-2][0].Value.(bson.D)
+	assert.Contains(t, project, bson.E{Key: "refreshed_at", Value: refreshed})
+	assert.Equal(t, "listing_offices", pipeline[len(pipeline)-1][0].Value.(bson.D)[0].Value)
+
+	// a full rebuild aggregates every member.
+	assert.Len(t, Pipeline(Agents, primitive.D{}, nil, "listing_agents", refreshed), len(pipeline)-1)
+}
@@ -3,8 +3,17 @@ package server
 import (
 	"context"
 	"fmt"
+	"mlslisting/internal/dedup"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/displayrules"
+	"mlslisting/internal/eventhub"
+	"mlslisting/internal/history"
 	"mlslisting/internal/interceptor"
+	"mlslisting/internal/marketstats"
+	"mlslisting/internal/roster"
 	"mlslisting/internal/services"
+	"mlslisting/internal/subscription"
+	"mlslisting/internal/webhook"
 	"reflect"
 
 	"contrib.go.opencensus.io/exporter/aws"
@@ -15,6 +24,7 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"mlslisting/internal/config"
+	drpb "mlslisting/internal/generated/realogy.com/api/mls/displayrules/v1"
 	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
 	"net"
 	"net/http"
@@ -43,6 +53,44 @@ func (s *Server) Start(ctx context.Context) error {
 	// mongodb
 	s.initMongo(ctx)
 
+	// listing history
+	if s.Config.Api.History.Record {
+		recorder := history.NewRecorder(s.MongoDatabase.Collection(s.MongoCollections["listings"]), s.MongoDatabase.Collection(s.MongoCollections["listing_history"]))
+		go recorder.Run(ctx)
+	}
+
+	// listing webhooks
+	if s.Config.Api.Webhooks.Deliver {
+		webhooksConfig := &s.Config.Api.Webhooks
+		dispatcher := webhook.NewDispatcher(s.MongoDatabase.Collection(s.MongoCollections["listings"]),
+			webhook.NewStore(s.MongoDatabase.Collection(s.MongoCollections["listing_webhooks"]), s.MongoDatabase.Collection(s.MongoCollections["listing_webhook_dead_letters"])),
+			subscription.NewStore(s.MongoDatabase.Collection(s.MongoCollections["listing_subscriptions"])),
+			webhook.NewSender(time.Duration(webhooksConfig.TimeoutSecs)*time.Second, webhooksConfig.MaxAttempts, time.Duration(webhooksConfig.RetryBackoffSecs)*time.Second),
+			webhooksConfig.BatchSize, time.Duration(webhooksConfig.BatchIntervalSecs)*time.Second, webhooksConfig.QueueSize)
+		go dispatcher.Run(ctx)
+	}
+
+	// agent and office rosters
+	if s.Config.Api.Roster.Build {
+		builder := roster.NewBuilder(s.MongoDatabase.Collection(s.MongoCollections["listings"]),
+			s.MongoDatabase.Collection(s.MongoCollections["listing_agents"]), s.MongoDatabase.Collection(s.MongoCollections["listing_offices"]),
+			time.Duration(s.Config.Api.Roster.RebuildIntervalSecs)*time.Second)
+		go builder.Run(ctx)
+	}
+
+	// geo location of feed listings
+	if s.Config.Api.GeoLocation.Sync {
+		syncer := derived.NewSyncer(s.MongoDatabase.Collection(s.MongoCollections["listings"]), time.Duration(s.Config.Api.GeoLocation.ResyncIntervalSecs)*time.Second)
+		go syncer.Run(ctx)
+	}
+
+	// duplicate listing clusters
+	if s.Config.Api.Dedup.Build {
+		builder := dedup.NewBuilder(s.MongoDatabase.Collection(s.MongoCollections["listings"]), &s.Config.Api.Dedup,
+			time.Duration(s.Config.Api.Dedup.RebuildIntervalSecs)*time.Second)
+		go builder.Run(ctx)
+	}
+
 	// start prometheus server
 	s.startPrometheus()
 
@@ -130,6 +178,40 @@ func (s *Server) startPrometheus() {
 	}()
 }
 
+// display rules cache, nil when the display rules service is not configured.
+func (s *Server) initDisplayRules(ctx context.Context) *displayrules.Cache {
+	drConfig := s.Config.Api.DisplayRules
+	if drConfig.Address == "" {
+		log.Info("Display rules service is not configured")
+		return nil
+	}
+	conn, err := grpc.DialContext(ctx, drConfig.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect to the display rules service: %s", err)
+	}
+	go func() {
+		defer conn.Close()
+		<-ctx.Done()
+	}()
+
+	cache := displayrules.NewCache(drpb.NewMlsDisplayRulesServiceClient(conn), time.Duration(drConfig.CacheTtlSecs)*time.Second)
+	go cache.Watch(ctx)
+	return cache
+}
+
+// shared change streams of the listing event streams, nil when they are not shared.
+func (s *Server) initEventHub(ctx context.Context) *eventhub.Hub {
+	sharedConfig := s.Config.Api.Stream.Shared
+	if !sharedConfig.Enabled {
+		return nil
+	}
+	hub := eventhub.NewHub(s.MongoDatabase.Collection(s.MongoCollections["listings"]),
+		sharedConfig.SubscriberBufferSize, sharedConfig.ReplaySize, time.Duration(sharedConfig.IdleSecs)*time.Second)
+	config.PromRegistry.MustRegister(hub)
+	go hub.Run(ctx)
+	return hub
+}
+
 // cleanup the connections
 func (s *Server) Cleanup(ctx context.Context) {
 	go func() {
@@ -168,10 +250,12 @@ func (s *Server) Run(ctx context.Context, network, address string) error {
 		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
 	}
 	ip := interceptor.NewInterceptor(&s.Config.Api.Auth)
+	dr := interceptor.NewDisplayRulesInterceptor(s.initDisplayRules(ctx))
+	hub := s.initEventHub(ctx)
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(config.PrometheusGrpcMetrics.StreamServerInterceptor()),
-		grpc.ChainUnaryInterceptor(config.PrometheusGrpcMetrics.UnaryServerInterceptor(), ip.UnaryAuthInterceptor),
+		grpc.ChainStreamInterceptor(config.PrometheusGrpcMetrics.StreamServerInterceptor(), dr.StreamDisplayRulesInterceptor),
+		grpc.ChainUnaryInterceptor(config.PrometheusGrpcMetrics.UnaryServerInterceptor(), ip.UnaryAuthInterceptor, dr.UnaryDisplayRulesInterceptor),
 	)
 
 	reflection.Register(grpcServer) // enable server reflection
@@ -181,12 +265,30 @@ func (s *Server) Run(ctx context.Context, network, address string) error {
 
 	// register
 	pb.RegisterMlsListingServiceServer(grpcServer, &services.Service{MongoDatabase: s.MongoDatabase,
+		ListingsCollection:           s.MongoCollections["listings"],
+		HistoryCollection:            s.MongoCollections["listing_history"],
+		SubscriptionsCollection:      s.MongoCollections["listing_subscriptions"],
+		WebhooksCollection:           s.MongoCollections["listing_webhooks"],
+		WebhookDeadLettersCollection: s.MongoCollections["listing_webhook_dead_letters"],
+		AgentsCollection:             s.MongoCollections["listing_agents"],
+		OfficesCollection:            s.MongoCollections["listing_offices"],
+		MaxQueryTimeSecs:             s.Config.MongoDB.MaxQueryTimeSecs,
+		Pagination:                   &s.Config.Api.Pagination,
+		Stream:                       &s.Config.Api.Stream,
+		BySource:                     &s.Config.Api.BySource,
+		ByAddress:                    &s.Config.Api.ByAddress,
+		Webhooks:                     &s.Config.Api.Webhooks,
+		Bulk:                         &s.Config.Api.Bulk,
+		Export:                       &s.Config.Api.Export,
+		Patch:                        &s.Config.Api.Patch,
+		Comps:                        &s.Config.Api.Comps,
+		Dedup:                        &s.Config.Api.Dedup,
+		Hub:                          hub})
+	pb.RegisterMlsListingsStatsServiceServer(grpcServer, &services.StatsService{MongoDatabase: s.MongoDatabase,
 		ListingsCollection: s.MongoCollections["listings"],
 		MaxQueryTimeSecs:   s.Config.MongoDB.MaxQueryTimeSecs,
-		Pagination:         &s.Config.Api.Pagination,
-		Stream:             &s.Config.Api.Stream,
-		BySource:           &s.Config.Api.BySource,
-		ByAddress:          &s.Config.Api.ByAddress})
+		MarketStats:        &s.Config.Api.MarketStats,
+		MarketStatsCache:   marketstats.NewCache(time.Duration(s.Config.Api.MarketStats.CacheTtlSecs) * time.Second)})
 
 	go func() {
 		defer grpcServer.GracefulStop()
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/mlsvalidation"
+	"mlslisting/internal/tombstone"
+	"mlslisting/internal/transformer"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// duplicateKeyCode is the mongodb error code of a duplicate key.
+const duplicateKeyCode = 11000
+
+const (
+	bulkInserted = "inserted"
+	bulkUpdated  = "updated"
+	bulkRejected = "rejected"
+)
+
+// BulkUpsertMlsListings validates listings as AddMlsListings does and writes the valid ones with an unordered bulk write.
+// Listings that fail validation, change status against its lifecycle or whose write fails are rejected without failing the others.
+func (s *Service) BulkUpsertMlsListings(ctx context.Context, in *pb.BulkUpsertMlsListingsRequest) (*pb.BulkUpsertMlsListingsResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/bulkUpsertListings")
+	defer span.End()
+
+	var upsertedBy string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if md != nil {
+		apiKey := md.Get("apikey")
+		if len(apiKey) != 0 {
+			upsertedBy = apiKey[0]
+		}
+		log.Printf("request has been made by %s to upsert %d mls listings", md.Get("apikey"), len(in.Listings))
+	}
+
+	err := validation.Errors{
+		"Listings": validation.Validate(in.Listings, validation.Required, validation.Length(1, s.Bulk.MaxListings)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	response := &pb.BulkUpsertMlsListingsResponse{Results: make([]*pb.BulkUpsertMlsListingResult, len(in.Listings))}
+	var valid []*pb.MlsListingInput
+	for i, listing := range in.Listings {
+		result := &pb.BulkUpsertMlsListingResult{Index: int32(i), ListingId: listing.ListingId, RdmSourceSystemKey: listing.RdmSourceSystemKey}
+		response.Results[i] = result
+		if err := validateListingInput(listing); err != nil {
+			result.Result, result.Reason = bulkRejected, status.Convert(err).Message()
+			continue
+		}
+		result.MlsId = listingDocumentId(listing)
+		valid = append(valid, listing)
+	}
+
+	// status changes of stored listings follow the lifecycle.
+	stored, err := s.storedListings(ctx, valid)
+	if err != nil {
+		log.Errorf("Unable to find the status of the listings to upsert. %v", err)
+		return nil, status.Errorf(codes.Internal, "Unable to upsert listings.")
+	}
+
+	var models []mongo.WriteModel
+	var modelListings []int          // index in the request of the listing of each write model.
+	fromStatuses := map[int]string{} // status a status change of a write model was validated from.
+	requested := map[string]int{}
+	for i, listing := range in.Listings {
+		result := response.Results[i]
+		if result.Result == bulkRejected {
+			continue
+		}
+		// the tombstones of soft deleted listings are kept, their upsert fails on the duplicate id.
+		filter := bson.D{{Key: "_id", Value: result.MlsId}, tombstone.NotDeleted()}
+		current, ok := stored[result.MlsId]
+		if ok {
+			if err := validateBulkTransition(current, listing); err != nil {
+				result.Result, result.Reason = bulkRejected, status.Convert(err).Message()
+				continue
+			}
+		}
+		if first, ok := requested[result.MlsId]; ok {
+			result.Result, result.Reason = bulkRejected, fmt.Sprintf("Listing %s is also at index %d of the request.", result.MlsId, first)
+			continue
+		}
+		requested[result.MlsId] = i
+
+		// status changes are written only from the status they were validated from, the upsert of a listing whose status
+		// changed since fails on the duplicate id as well.
+		if ok && current.GetStandardStatus() != listing.Property.Listing.StandardStatus {
+			fromStatuses[len(models)] = current.GetStandardStatus()
+			filter = append(filter, statusFilter(current.GetStandardStatus()))
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			// listing inputs have no gis coordinates, the property replaces those of the stored listing and its geo location.
+			SetUpdate(derived.WithGeoLocation(bson.D{
+				{Key: "$set", Value: listingDocument(listing)},
+				{Key: "$setOnInsert", Value: bson.D{{Key: "inserted_by", Value: upsertedBy}}},
+				{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+				// listings added before were written with a misspelled last change date, read by nothing.
+				{Key: "$unset", Value: bson.D{{Key: "last_changed_date", Value: ""}}},
+			}, nil)).
+			SetUpsert(true))
+		modelListings = append(modelListings, i)
+	}
+
+	if len(models) > 0 {
+		writeResult, err := s.MongoDatabase.Collection(s.ListingsCollection).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		var bulkErr mongo.BulkWriteException
+		if err != nil && (!errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil) {
+			log.Errorf("Unable to upsert %d listings. %v", len(models), err)
+			return nil, status.Errorf(codes.Internal, "Unable to upsert listings.")
+		}
+
+		writeErrors := map[int]string{}
+		for _, writeErr := range bulkErr.WriteErrors {
+			writeErrors[writeErr.Index] = writeErr.Message
+			if from, ok := fromStatuses[writeErr.Index]; ok && writeErr.Code == duplicateKeyCode {
+				writeErrors[writeErr.Index] = statusChangedMessage(response.Results[modelListings[writeErr.Index]].MlsId, from)
+			} else if writeErr.Code == duplicateKeyCode {
+				writeErrors[writeErr.Index] = fmt.Sprintf("Listing %s is deleted, restore it to update it.", response.Results[modelListings[writeErr.Index]].MlsId)
+			}
+		}
+		for j, i := range modelListings {
+			result := response.Results[i]
+			if message, ok := writeErrors[j]; ok {
+				log.Errorf("Unable to upsert listing %s. %s", result.MlsId, message)
+				result.Result, result.Reason = bulkRejected, message
+			} else if _, ok := writeResult.UpsertedIDs[int64(j)]; ok {
+				result.Result = bulkInserted
+			} else {
+				result.Result = bulkUpdated
+			}
+		}
+	}
+
+	for _, result := range response.Results {
+		switch result.Result {
+		case bulkInserted:
+			response.Inserted++
+		case bulkUpdated:
+			response.Updated++
+		default:
+			response.Rejected++
+		}
+	}
+	return response, nil
+}
+
+// storedListings returns the status of the stored listings of a bulk upsert, by listing document id.
+func (s *Service) storedListings(ctx context.Context, listings []*pb.MlsListingInput) (map[string]*pb.Listing, error) {
+	if len(listings) == 0 {
+		return nil, nil
+	}
+	var ids []string
+	for _, listing := range listings {
+		ids = append(ids, listingDocumentId(listing))
+	}
+	cursor, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx,
+		bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}, tombstone.NotDeleted()},
+		options.Find().SetProjection(bson.D{{Key: "property.listing.standard_status", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var documents []struct {
+		Id       string `bson:"_id"`
+		Property struct {
+			Listing *pb.Listing `bson:"listing"`
+		} `bson:"property"`
+	}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, err
+	}
+	stored := make(map[string]*pb.Listing, len(documents))
+	for _, document := range documents {
+		stored[document.Id] = document.Property.Listing
+	}
+	return stored, nil
+}
+
+// validateBulkTransition validates the status change of a stored listing by a bulk upsert. The upsert replaces the property
+// of the stored listing, the prices and dates the new status requires are those of the listing as it is written.
+func validateBulkTransition(current *pb.Listing, listing *pb.MlsListingInput) error {
+	if current.GetStandardStatus() == listing.Property.Listing.StandardStatus {
+		return nil
+	}
+	if err := mlsvalidation.ValidateTransition(current.GetStandardStatus(), listing.Property.Listing.StandardStatus); err != nil {
+		return err
+	}
+	return mlsvalidation.ValidateStatus(writtenListing(listing))
+}
+
+// writtenListing returns the status, prices and dates of the listing a bulk upsert writes.
+func writtenListing(listing *pb.MlsListingInput) *pb.Listing {
+	written := transformer.TransformListingInputToMlsListing(listing).Property.Listing
+	return &pb.Listing{
+		StandardStatus: written.StandardStatus,
+		Price:          &pb.Price{ListPrice: written.Price.ListPrice, ClosePrice: written.Price.ClosePrice},
+		Dates:          &pb.Dates{CloseDate: timestamppb.New(written.Dates.CloseDate)},
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"mlslisting/internal/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestBulkUpsertMlsListingsRejected(t *testing.T) {
+	s := &Service{Bulk: &config.BulkConfig{MaxListings: 3}}
+	response, err := s.BulkUpsertMlsListings(context.Background(), &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{
+		{ListingId: "456778", RdmSourceSystemKey: "SOLO"},
+		{ListingId: "456779", RdmSourceSystemKey: "SOLO", Property: &pb.PropertyInput{PropertyType: "CONDO",
+			Listing:  &pb.ListingInput{StandardStatus: "ACTIVE", Price: &pb.PriceInput{ListPrice: 60000}},
+			Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irving", Country: "India"}}}},
+		{ListingId: "456780", RdmSourceSystemKey: "CABCREIS", Property: &pb.PropertyInput{PropertyType: "LAND",
+			Listing:  &pb.ListingInput{StandardStatus: "ACTIVE", Price: &pb.PriceInput{ListPrice: 60000}},
+			Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irving", Country: "India"}}}},
+	}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), response.Rejected)
+	assert.Equal(t, []*pb.BulkUpsertMlsListingResult{
+		{Index: 0, ListingId: "456778", RdmSourceSystemKey: "SOLO", Result: bulkRejected, Reason: "Invalid input. Property: listing and location address cannot be blank."},
+		{Index: 1, ListingId: "456779", RdmSourceSystemKey: "SOLO", Result: bulkRejected, Reason: "Unparsed Address can not be empty or null"},
+		{Index: 2, ListingId: "456780", RdmSourceSystemKey: "CABCREIS", Result: bulkRejected, Reason: "Invalid input. RdmSourceSystemKey: must be a valid value."},
+	}, response.Results)
+}
+
+func TestBulkUpsertMlsListingsLimit(t *testing.T) {
+	s := &Service{Bulk: &config.BulkConfig{MaxListings: 1}}
+	_, err := s.BulkUpsertMlsListings(context.Background(), &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{{}, {}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.BulkUpsertMlsListings(context.Background(), &pb.BulkUpsertMlsListingsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestValidateBulkTransition(t *testing.T) {
+	listing := func(standardStatus string, closePrice float64) *pb.MlsListingInput {
+		return &pb.MlsListingInput{Property: &pb.PropertyInput{Listing: &pb.ListingInput{StandardStatus: standardStatus,
+			Price: &pb.PriceInput{ListPrice: 60000, ClosePrice: closePrice}, Dates: &pb.DatesInput{CloseDate: timestamppb.Now()}},
+			Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irvine"}}}}
+	}
+	stored := func(standardStatus string) *pb.Listing {
+		return &pb.Listing{StandardStatus: standardStatus}
+	}
+	assert.Nil(t, validateBulkTransition(stored("SOLD"), listing("SOLD", 0)))
+	assert.Nil(t, validateBulkTransition(stored("ACTIVE"), listing("SOLD", 59000)))
+	// the status of a sold listing is final.
+	assert.Equal(t, codes.FailedPrecondition, status.Code(validateBulkTransition(stored("SOLD"), listing("ACTIVE", 0))))
+	// a listing changing to SOLD needs its close price.
+	assert.Equal(t, codes.InvalidArgument, status.Code(validateBulkTransition(stored("ACTIVE"), listing("SOLD", 0))))
+
+	// the upsert replaces the stored property, the close price of the stored listing is not kept.
+	withClosePrice := &pb.Listing{StandardStatus: "PENDING", Price: &pb.Price{ClosePrice: 59000}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(validateBulkTransition(withClosePrice, listing("SOLD", 0))))
+	// nor is its close date.
+	withoutCloseDate := listing("SOLD", 59000)
+	withoutCloseDate.Property.Listing.Dates.CloseDate = nil
+	closed := &pb.Listing{StandardStatus: "PENDING", Dates: &pb.Dates{CloseDate: timestamppb.Now()}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(validateBulkTransition(closed, withoutCloseDate)))
+}
+
+func TestListingDocument(t *testing.T) {
+	doc := listingDocument(&pb.MlsListingInput{ListingId: "456778", RdmSourceSystemKey: "SOLO", Property: &pb.PropertyInput{
+		Listing:  &pb.ListingInput{StandardStatus: "ACTIVE", Price: &pb.PriceInput{ListPrice: 60000}, Dates: &pb.DatesInput{CloseDate: timestamppb.Now()}},
+		Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irvine"}}}})
+	// the last change date is the one listings are sorted, paged and streamed by.
+	assert.IsType(t, time.Time{}, doc.Map()["last_change_date"])
+	assert.NotContains(t, doc.Map(), "last_changed_date")
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/comps"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// GetComparableSales returns the sold listings most similar to a subject property, scored by comps.Rank.
+func (s *Service) GetComparableSales(ctx context.Context, in *pb.GetComparableSalesRequest) (*pb.GetComparableSalesResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/comparableSales")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey":   validation.Validate(in.SourceSystemKey, validation.When(in.ListingId != "", validation.Required)),
+		"PostalCode":        validation.Validate(in.PostalCode, validation.When(in.UnparsedAddress != "", validation.Required)),
+		"Point":             validateGeoPoint(in.Point),
+		"BuildingAreaTotal": validation.Validate(in.BuildingAreaTotal, validation.Min(0.0)),
+		"RadiusMeters":      validation.Validate(in.RadiusMeters, validation.Min(0.0), validation.Max(s.Comps.MaxRadiusMeters)),
+		"SoldWithinDays":    validation.Validate(in.SoldWithinDays, validation.Min(0), validation.Max(s.Comps.MaxSoldWithinDays)),
+	}.Filter()
+	if err == nil && in.ListingId == "" && in.Point == nil && in.UnparsedAddress == "" {
+		err = fmt.Errorf("one of listingId, point or unparsedAddress is required")
+	}
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	subject, err := s.comparableSubject(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := in.RadiusMeters
+	if radius == 0 {
+		radius = s.Comps.DefaultRadiusMeters
+	}
+	days := in.SoldWithinDays
+	if days == 0 {
+		days = s.Comps.DefaultSoldWithinDays
+	}
+	filter := comparableSalesFilter(subject, radius, time.Now().AddDate(0, 0, -int(days)))
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "property.listing.dates.close_date", Value: -1}}).
+		SetLimit(s.Comps.MaxCandidates).
+		SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+
+	cursor, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Errorf("Unable to find comparable sales for %s. %v", in, err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching comparable sales %s", in))
+	}
+	var sales []*pb.MlsListing
+	if err := cursor.All(ctx, &sales); err != nil {
+		log.Errorf("Unable to decode comparable sales for %s. %v", in, err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching comparable sales %s", in))
+	}
+	if len(sales) == 0 {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find comparable sales for %s", in))
+	}
+
+	return &pb.GetComparableSalesResponse{
+		Subject:         subject,
+		ComparableSales: comps.Rank(subject, sales, s.Comps.Adjustments, s.compsLimit(in.Limit)),
+	}, nil
+}
+
+// comparableSubject returns the subject of a request, from its listing, its address or its point, with the attributes of the request.
+func (s *Service) comparableSubject(ctx context.Context, in *pb.GetComparableSalesRequest) (*pb.ComparableSubject, error) {
+	subject := &pb.ComparableSubject{Point: in.Point}
+	var filter primitive.D
+	if in.ListingId != "" {
+		filter = primitive.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}}
+	} else if in.Point == nil {
+		filter = primitive.D{
+			{Key: "property.location.address.unparsed_address", Value: in.UnparsedAddress},
+			{Key: "property.location.address.postal_code", Value: in.PostalCode},
+		}
+	}
+	if filter != nil {
+		listing := &pb.MlsListing{}
+		err := s.MongoDatabase.Collection(s.ListingsCollection).FindOne(ctx, tombstone.Exclude(filter)).Decode(listing)
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find the subject listing for %s", in))
+		}
+		if err != nil {
+			log.Errorf("Unable to find the subject listing for %s. %v", in, err)
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Unable to find the subject listing for %s", in))
+		}
+		if in.ListingId != "" {
+			subject = comps.SubjectOf(listing)
+		} else {
+			// only the location of a listing at the address is taken, the address is not the listing.
+			subject.Point = comps.SubjectOf(listing).Point
+		}
+	}
+	if subject.Point == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, fmt.Sprintf("The subject of %s has no location.", in))
+	}
+
+	if in.PropertyType != "" {
+		subject.PropertyType = in.PropertyType
+	}
+	if in.BedroomsTotal > 0 {
+		subject.BedroomsTotal = in.BedroomsTotal
+	}
+	if in.BathroomsTotalInteger > 0 {
+		subject.BathroomsTotalInteger = in.BathroomsTotalInteger
+	}
+	if in.BuildingAreaTotal > 0 {
+		subject.BuildingAreaTotal = in.BuildingAreaTotal
+	}
+	if in.YearBuilt > 0 {
+		subject.YearBuilt = in.YearBuilt
+	}
+	return subject, nil
+}
+
+// comparableSalesFilter matches the sales closed since a time within a radius of the subject, with the close price and building
+// area their price per square foot is computed from. The subject listing is not its own comparable sale.
+func comparableSalesFilter(subject *pb.ComparableSubject, radiusMeters float64, closedSince time.Time) primitive.D {
+	filter := primitive.D{
+		{Key: geoLocationField, Value: geoWithinSphere(subject.Point, radiusMeters)},
+		{Key: "property.listing.standard_status", Value: "SOLD"},
+		{Key: "property.listing.dates.close_date", Value: bson.D{{Key: "$gte", Value: closedSince}}},
+		{Key: "property.listing.price.close_price", Value: bson.D{{Key: "$gt", Value: 0}}},
+		{Key: "property.structure.building_area_total", Value: bson.D{{Key: "$gt", Value: 0}}},
+	}
+	if subject.ListingId != "" {
+		filter = append(filter, bson.E{Key: "$nor", Value: bson.A{
+			bson.D{{Key: "listing_id", Value: subject.ListingId}, {Key: "source_system_key", Value: subject.SourceSystemKey}},
+		}})
+	}
+	return tombstone.Exclude(filter)
+}
+
+func (s *Service) compsLimit(limit int32) int {
+	if limit <= 0 {
+		return int(s.Comps.LimitDefault)
+	} else if limit <= s.Comps.LimitMax {
+		return int(limit)
+	}
+	return int(s.Comps.LimitMax)
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mlslisting/internal/config"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestGetComparableSalesInvalid(t *testing.T) {
+	s := &Service{Comps: &config.CompsConfig{MaxRadiusMeters: 16093, MaxSoldWithinDays: 730}}
+	point := &pb.GeoPoint{Latitude: 33.68, Longitude: -117.82}
+	for _, in := range []*pb.GetComparableSalesRequest{
+		// a subject is required.
+		{},
+		{BedroomsTotal: 3},
+		{ListingId: "493278"},
+		{UnparsedAddress: "1 Main St"},
+		{Point: &pb.GeoPoint{Latitude: 93.68, Longitude: -117.82}},
+		{Point: point, RadiusMeters: 20000},
+		{Point: point, SoldWithinDays: 1000},
+		{Point: point, BuildingAreaTotal: -1},
+	} {
+		_, err := s.GetComparableSales(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
+
+func TestComparableSalesFilter(t *testing.T) {
+	since := time.Date(2026, 4, 17, 0, 0, 0, 0, time.UTC)
+	subject := &pb.ComparableSubject{ListingId: "493278", SourceSystemKey: "CABCREIS", Point: &pb.GeoPoint{Latitude: 33.68, Longitude: -117.82}}
+	filter := comparableSalesFilter(subject, 1609, since)
+
+	assert.Equal(t, geoLocationField, filter[0].Key)
+	assert.Contains(t, filter, bson.E{Key: "property.listing.standard_status", Value: "SOLD"})
+	assert.Contains(t, filter, bson.E{Key: "property.listing.dates.close_date", Value: bson.D{{Key: "$gte", Value: since}}})
+	// the subject listing is excluded, and so are deleted listings.
+	assert.Contains(t, filter, bson.E{Key: "$nor", Value: bson.A{bson.D{{Key: "listing_id", Value: "493278"}, {Key: "source_system_key", Value: "CABCREIS"}}}})
+	assert.Equal(t, "deleted", filter[len(filter)-1].Key)
+
+	subject.ListingId = ""
+	assert.Len(t, comparableSalesFilter(subject, 1609, since), len(filter)-1)
+}
+
+func TestCompsLimit(t *testing.T) {
+	s := &Service{Comps: &config.CompsConfig{LimitDefault: 10, LimitMax: 50}}
+	assert.Equal(t, 10, s.compsLimit(0))
+	assert.Equal(t, 5, s.compsLimit(5))
+	assert.Equal(t, 50, s.compsLimit(100))
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// internalSources are the sources of listings written by this service rather than an MLS feed.
+var internalSources = []interface{}{"SOLO", "ELL", "LC"}
+
+// DeleteMlsListing soft deletes a listing of an internal source by marking it as a tombstone, or removes it when hard is set.
+func (s *Service) DeleteMlsListing(ctx context.Context, in *pb.DeleteMlsListingRequest) (*pb.DeleteMlsListingResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/deleteListing")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingId":       validation.Validate(in.ListingId, validation.Required),
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required, validation.In(internalSources...)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	var deletedBy string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if md != nil {
+		apiKey := md.Get("apikey")
+		if len(apiKey) != 0 {
+			deletedBy = apiKey[0]
+		}
+		log.Printf("request has been made by %s to delete mls listing %s of %s (hard: %v)", md.Get("apikey"), in.ListingId, in.SourceSystemKey, in.Hard)
+	}
+	collection := s.MongoDatabase.Collection(s.ListingsCollection)
+	filter := bson.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}}
+
+	var deleted struct {
+		Id string `bson:"_id"`
+	}
+	if in.Hard {
+		err = collection.FindOneAndDelete(ctx, filter, options.FindOneAndDelete().SetProjection(bson.D{{Key: "_id", Value: 1}})).Decode(&deleted)
+		if err != nil {
+			return nil, listingWriteError(err, in.ListingId, "delete")
+		}
+		return &pb.DeleteMlsListingResponse{MlsId: deleted.Id}, nil
+	}
+
+	// mongodb dates are in milliseconds.
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: tombstone.DeletedField, Value: true},
+			{Key: tombstone.DeletedTimeField, Value: now},
+			{Key: tombstone.DeletedByField, Value: deletedBy},
+			{Key: "last_change_date", Value: now},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	}
+	err = collection.FindOneAndUpdate(ctx, tombstone.Exclude(filter), update, options.FindOneAndUpdate().SetProjection(bson.D{{Key: "_id", Value: 1}})).Decode(&deleted)
+	if err != nil {
+		return nil, listingWriteError(err, in.ListingId, "delete")
+	}
+	return &pb.DeleteMlsListingResponse{MlsId: deleted.Id, DeletedTime: timestamppb.New(now)}, nil
+}
+
+// RestoreMlsListing restores a soft deleted listing of an internal source.
+func (s *Service) RestoreMlsListing(ctx context.Context, in *pb.RestoreMlsListingRequest) (*pb.RestoreMlsListingResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/restoreListing")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingId":       validation.Validate(in.ListingId, validation.Required),
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required, validation.In(internalSources...)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if md != nil {
+		log.Printf("request has been made by %s to restore mls listing %s of %s", md.Get("apikey"), in.ListingId, in.SourceSystemKey)
+	}
+	filter := primitive.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}, tombstone.Deleted()}
+	update := bson.D{
+		{Key: "$unset", Value: bson.D{
+			{Key: tombstone.DeletedField, Value: ""},
+			{Key: tombstone.DeletedTimeField, Value: ""},
+			{Key: tombstone.DeletedByField, Value: ""},
+		}},
+		{Key: "$set", Value: bson.D{{Key: "last_change_date", Value: time.Now()}}},
+		{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}},
+	}
+	var restored pb.MlsListing
+	result := s.MongoDatabase.Collection(s.ListingsCollection).
+		FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if err := result.Decode(&restored); err != nil {
+		return nil, listingWriteError(err, in.ListingId, "restore")
+	}
+	setETag(ctx, decodeVersion(result))
+	return &pb.RestoreMlsListingResponse{MlsListing: &restored}, nil
+}
+
+// listingWriteError returns the error of a write of a listing, NotFound when no listing matched.
+func listingWriteError(err error, listingId string, write string) error {
+	if err == mongo.ErrNoDocuments {
+		msg := fmt.Sprintf("Unable to find Realogy Listing for given listingID %s", listingId)
+		log.Errorf(msg)
+		return status.Errorf(codes.NotFound, msg)
+	}
+	log.Errorf("Unable to %s listing %s. %v", write, listingId, err)
+	return status.Errorf(codes.Internal, fmt.Sprintf("Unable to %s listing %s.", write, listingId))
+}
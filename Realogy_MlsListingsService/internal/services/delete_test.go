@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestDeleteMlsListingInvalid(t *testing.T) {
+	s := &Service{}
+	for _, in := range []*pb.DeleteMlsListingRequest{
+		{SourceSystemKey: "SOLO"},
+		// listings of MLS feeds are deleted by their feed.
+		{ListingId: "100018", SourceSystemKey: "KY_WKRMLS"},
+	} {
+		_, err := s.DeleteMlsListing(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+
+	_, err := s.RestoreMlsListing(context.Background(), &pb.RestoreMlsListingRequest{ListingId: "100018", SourceSystemKey: "KY_WKRMLS"})
+	assert.Equal(t, "Invalid input. SourceSystemKey: must be a valid value.", status.Convert(err).Message())
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/dedup"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// GetListingDuplicates returns the duplicate cluster of a listing, its canonical listing first, with how each listing matches
+// the requested one. A listing without duplicates is returned alone.
+func (s *Service) GetListingDuplicates(ctx context.Context, in *pb.GetListingDuplicatesRequest) (*pb.GetListingDuplicatesResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listingDuplicates")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingId":       validation.Validate(in.ListingId, validation.Required),
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	listings, err := s.findDuplicates(ctx, bson.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}, tombstone.NotDeleted()})
+	if err != nil {
+		log.Errorf("Unable to find listing %s. %v", in, err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching duplicates of %s", in))
+	}
+	if len(listings) == 0 {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find listing %s", in))
+	}
+	requested := listings[0]
+
+	clusterId := requested.MlsListing.GetDuplicate().GetClusterId()
+	if clusterId != "" {
+		if listings, err = s.findDuplicates(ctx, bson.D{{Key: derived.ClusterIdPath, Value: clusterId}, tombstone.NotDeleted()}); err != nil {
+			log.Errorf("Unable to find the listings of duplicate cluster %s. %v", clusterId, err)
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching duplicates of %s", in))
+		}
+	}
+
+	response := &pb.GetListingDuplicatesResponse{ClusterId: clusterId, CanonicalMlsId: requested.MlsListing.GetDuplicate().GetCanonicalMlsId()}
+	if clusterId == "" {
+		response.CanonicalMlsId = requested.Id
+	}
+	for _, listing := range listings {
+		duplicate := &pb.ListingDuplicate{
+			MlsId:      listing.Id,
+			Canonical:  listing.Id == response.CanonicalMlsId,
+			MlsListing: listing.MlsListing,
+		}
+		if listing.Id != requested.Id {
+			duplicate.MatchedBy = dedup.Match(requested.MlsListing, listing.MlsListing, s.Dedup.ProximityMeters)
+		}
+		response.Duplicates = append(response.Duplicates, duplicate)
+	}
+	return response, nil
+}
+
+// findDuplicates returns the listings of a filter, the canonical listing of a cluster first.
+func (s *Service) findDuplicates(ctx context.Context, filter bson.D) ([]dedup.Listing, error) {
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: derived.CanonicalPath, Value: -1}, {Key: "_id", Value: 1}}).
+		SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	cursor, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var listings []dedup.Listing
+	for cursor.Next(ctx) {
+		listing, err := dedup.Decode(cursor)
+		if err != nil {
+			return nil, err
+		}
+		listings = append(listings, listing)
+	}
+	return listings, cursor.Err()
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mlslisting/internal/config"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestGetListingDuplicatesInvalid(t *testing.T) {
+	s := &Service{Dedup: &config.DedupConfig{ProximityMeters: 25}}
+	for _, in := range []*pb.GetListingDuplicatesRequest{
+		{},
+		{ListingId: "493278"},
+		{SourceSystemKey: "CABCREIS"},
+	} {
+		_, err := s.GetListingDuplicates(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mlslisting/internal/export"
+	"mlslisting/internal/mlsfilter"
+	"mlslisting/internal/mlsprojection"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// ExportMlsListings streams the listings of a source as NDJSON or CSV rows, read from the cursor one listing at a time.
+func (s *Service) ExportMlsListings(in *pb.ExportMlsListingsRequest, stream pb.MlsListingService_ExportMlsListingsServer) error {
+
+	ctx, span := trace.StartSpan(stream.Context(), "/exportListings")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+		"Format":          validation.Validate(in.Format, validation.In(pb.ExportFormat_NDJSON, pb.ExportFormat_CSV)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	query, err := mlsfilter.Apply(primitive.D{{Key: "source_system_key", Value: in.SourceSystemKey}}, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	columns, err := export.Columns(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	// the listing key keeps the order of the rows stable between exports.
+	findOptions := options.Find().
+		SetMaxTime(time.Duration(s.Export.MaxQueryTimeSecs) * time.Second).
+		SetSort(bson.D{{Key: "_id", Value: 1}})
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
+	cursor, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx, tombstone.Exclude(query), findOptions)
+	if err != nil {
+		log.Errorf("Unable to export listings of %s. %v", in.SourceSystemKey, err)
+		return status.Errorf(codes.Internal, "Unable to export listings.")
+	}
+	defer cursor.Close(ctx)
+
+	contentType := export.ContentType(in.Format, in.Gzip)
+	chunker := export.NewChunker(s.Export.ChunkSizeBytes, func(data []byte) error {
+		return stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: data})
+	})
+	var out io.Writer = chunker
+	var compressor *gzip.Writer
+	if in.Gzip {
+		compressor = gzip.NewWriter(chunker)
+		out = compressor
+	}
+	writer := export.NewWriter(out, in.Format, columns)
+
+	rows := 0
+	for cursor.Next(ctx) {
+		var listing pb.MlsListing
+		if err := cursor.Decode(&listing); err != nil {
+			log.Errorf("Unable to decode the mongo document: %v", err)
+			continue
+		}
+		if err := writer.Write(&listing); err != nil {
+			log.Errorf("Error while exporting mls listings: %v", err)
+			return status.Errorf(codes.Internal, "Unable to export listings.")
+		}
+		rows++
+	}
+	if err := cursor.Err(); err != nil {
+		log.Errorf("Unable to export listings of %s. %v", in.SourceSystemKey, err)
+		return status.Errorf(codes.Internal, "Unable to export listings.")
+	}
+
+	if err := writer.Flush(); err != nil {
+		log.Errorf("Error while exporting mls listings: %v", err)
+		return status.Errorf(codes.Internal, "Unable to export listings.")
+	}
+	if compressor != nil {
+		if err := compressor.Close(); err != nil {
+			log.Errorf("Error while exporting mls listings: %v", err)
+			return status.Errorf(codes.Internal, "Unable to export listings.")
+		}
+	}
+	if err := chunker.Flush(); err != nil {
+		log.Errorf("Error while exporting mls listings: %v", err)
+		return err
+	}
+	log.Debugf("Exported %d listings of %s", rows, in.SourceSystemKey)
+	return nil
+}
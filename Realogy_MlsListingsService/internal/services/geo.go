@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/mlsfilter"
+	"mlslisting/internal/mlsprojection"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// geoLocationField is the GeoJSON point derived from property.location.gis latitude/longitude, backed by a 2dsphere index.
+const geoLocationField = derived.GeoLocationField
+
+// earthRadiusMeters converts a distance to radians for $centerSphere.
+const earthRadiusMeters = 6378100.0
+
+// geoPolygon is a GeoJSON polygon geometry.
+type geoPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+func (s *Service) SearchMlsListingsByGeo(ctx context.Context, in *pb.SearchMlsListingsByGeoRequest) (*pb.SearchMlsListingsByGeoResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listingsByGeo")
+	defer span.End()
+
+	err := validation.Errors{
+		"Point":        validateGeoPoint(in.Point),
+		"RadiusMeters": validation.Validate(in.RadiusMeters, validation.Min(0.0)),
+	}.Filter()
+	if err == nil && in.BoundingBox != nil {
+		err = validation.Errors{
+			"BoundingBox.SouthWest": validation.Validate(in.BoundingBox.SouthWest, validation.Required),
+			"BoundingBox.NorthEast": validation.Validate(in.BoundingBox.NorthEast, validation.Required),
+		}.Filter()
+		if err == nil {
+			err = validation.Errors{
+				"BoundingBox.SouthWest": validateGeoPoint(in.BoundingBox.SouthWest),
+				"BoundingBox.NorthEast": validateGeoPoint(in.BoundingBox.NorthEast),
+			}.Filter()
+		}
+	}
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	// exactly one area has to be given.
+	areas := 0
+	if in.Point != nil && in.RadiusMeters > 0 {
+		areas++
+	}
+	if in.BoundingBox != nil {
+		areas++
+	}
+	if in.Polygon != "" {
+		areas++
+	}
+	if areas != 1 {
+		msg := "exactly one of point with radiusMeters, boundingBox or polygon is required"
+		log.Errorf("Validation Error. %v", msg)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+	}
+	// the radius is around the point, an area without one would be searched around its center but counted around nothing.
+	if in.RadiusMeters > 0 && in.Point == nil {
+		msg := "radiusMeters requires point"
+		log.Errorf("Validation Error. %v", msg)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+	}
+
+	// the area to search within and the origin to order listings by distance.
+	var within primitive.D
+	var origin *pb.GeoPoint
+	switch {
+	case in.BoundingBox != nil:
+		sw, ne := in.BoundingBox.SouthWest, in.BoundingBox.NorthEast
+		if sw.Latitude >= ne.Latitude {
+			msg := "boundingBox southWest latitude must be less than northEast latitude"
+			log.Errorf("Validation Error. %v", msg)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+		}
+		ring := [][2]float64{
+			{sw.Longitude, sw.Latitude},
+			{ne.Longitude, sw.Latitude},
+			{ne.Longitude, ne.Latitude},
+			{sw.Longitude, ne.Latitude},
+			{sw.Longitude, sw.Latitude},
+		}
+		within = geoWithin(ring)
+		origin = in.Point
+		if origin == nil {
+			origin = ringCenter(ring)
+		}
+	case in.Polygon != "":
+		ring, err := parseGeoPolygon(in.Polygon)
+		if err != nil {
+			log.Errorf("Validation Error. %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+		}
+		within = geoWithin(ring)
+		origin = in.Point
+		if origin == nil {
+			origin = ringCenter(ring)
+		}
+	default:
+		origin = in.Point
+	}
+
+	query := primitive.D{}
+	if within != nil {
+		query = append(query, bson.E{Key: geoLocationField, Value: within})
+	}
+	query, err = mlsfilter.Apply(query, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	geoNear := bson.D{
+		{Key: "near", Value: geoPoint(origin)},
+		{Key: "key", Value: geoLocationField},
+		{Key: "distanceField", Value: "geo_distance"},
+		{Key: "spherical", Value: true},
+		{Key: "query", Value: tombstone.Exclude(query)},
+	}
+	if in.RadiusMeters > 0 {
+		geoNear = append(geoNear, bson.E{Key: "maxDistance", Value: in.RadiusMeters})
+	}
+
+	limit := s.getLimit(in.Limit)
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear}},
+		{{Key: "$skip", Value: int64(in.Offset)}},
+		// one more than the limit tells whether there is a next page.
+		{{Key: "$limit", Value: limit + 1}},
+	}
+	if projection != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$project", Value: projection}})
+	}
+
+	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
+
+	opts := options.Aggregate().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	cur, err := mongoCollection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		log.Errorf("Error while processing the request to search mls by geo: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+	}
+	defer cur.Close(ctx)
+
+	response := &pb.SearchMlsListingsByGeoResponse{}
+	hasMore := false
+	for cur.Next(ctx) {
+		if int64(len(response.MlsListings)) == limit {
+			hasMore = true
+			break
+		}
+		var result pb.MlsListing
+		err := cur.Decode(&result)
+		if err != nil {
+			log.Errorf("Unable to decode the document: %v", err)
+		}
+		response.MlsListings = append(response.MlsListings, &result)
+	}
+
+	if len(response.MlsListings) == 0 {
+		log.Errorf("Unable to find mls listings for %s", in)
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find mls listings for %s", in))
+	}
+
+	// $geoNear cannot be counted, the radius is counted as a sphere around the point instead.
+	countFilter := query
+	if in.RadiusMeters > 0 {
+		countFilter = append(primitive.D{{Key: geoLocationField, Value: geoWithinSphere(origin, in.RadiusMeters)}}, query...)
+	}
+	response.PageInfo, err = s.pageInfo(ctx, listQuery{
+		filter:    countFilter,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		countMode: in.CountMode,
+	}, hasMore)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// validateGeoPoint validates the latitude and longitude ranges of an optional point.
+func validateGeoPoint(p *pb.GeoPoint) error {
+	if p == nil {
+		return nil
+	}
+	return validation.Errors{
+		"Latitude":  validation.Validate(p.Latitude, validation.Min(-90.0), validation.Max(90.0)),
+		"Longitude": validation.Validate(p.Longitude, validation.Min(-180.0), validation.Max(180.0)),
+	}.Filter()
+}
+
+// parseGeoPolygon returns the ring of a GeoJSON polygon geometry without holes.
+func parseGeoPolygon(polygon string) ([][2]float64, error) {
+	var geometry geoPolygon
+	if err := json.Unmarshal([]byte(polygon), &geometry); err != nil {
+		return nil, fmt.Errorf("polygon is not a valid GeoJSON geometry: %v", err)
+	}
+	if geometry.Type != "Polygon" {
+		return nil, fmt.Errorf("polygon must be a GeoJSON geometry of type Polygon")
+	}
+	if len(geometry.Coordinates) == 0 || len(geometry.Coordinates[0]) < 4 {
+		return nil, fmt.Errorf("polygon must have at least 4 positions")
+	}
+	if len(geometry.Coordinates) > 1 {
+		return nil, fmt.Errorf("polygon must have a single ring, polygons with holes are not supported")
+	}
+	ring := geometry.Coordinates[0]
+	if ring[0] != ring[len(ring)-1] {
+		return nil, fmt.Errorf("polygon ring must be closed")
+	}
+	for _, position := range ring {
+		if err := validateGeoPoint(&pb.GeoPoint{Longitude: position[0], Latitude: position[1]}); err != nil {
+			return nil, fmt.Errorf("polygon position %v: %v", position, err)
+		}
+	}
+	return ring, nil
+}
+
+// ringCenter returns the average of the ring positions, ignoring the closing position.
+func ringCenter(ring [][2]float64) *pb.GeoPoint {
+	var lng, lat float64
+	n := len(ring) - 1
+	for _, position := range ring[:n] {
+		lng += position[0]
+		lat += position[1]
+	}
+	return &pb.GeoPoint{Longitude: lng / float64(n), Latitude: lat / float64(n)}
+}
+
+func geoWithin(ring [][2]float64) primitive.D {
+	coordinates := bson.A{}
+	for _, position := range ring {
+		coordinates = append(coordinates, bson.A{position[0], position[1]})
+	}
+	return bson.D{{Key: "$geoWithin", Value: bson.D{{Key: "$geometry", Value: bson.D{
+		{Key: "type", Value: "Polygon"},
+		{Key: "coordinates", Value: bson.A{coordinates}},
+	}}}}}
+}
+
+// geoWithinSphere returns the predicate for points within the radius of the point.
+func geoWithinSphere(p *pb.GeoPoint, radiusMeters float64) primitive.D {
+	return bson.D{{Key: "$geoWithin", Value: bson.D{{Key: "$centerSphere", Value: bson.A{
+		bson.A{p.Longitude, p.Latitude},
+		radiusMeters / earthRadiusMeters,
+	}}}}}
+}
+
+func geoPoint(p *pb.GeoPoint) primitive.D {
+	return bson.D{
+		{Key: "type", Value: "Point"},
+		{Key: "coordinates", Value: bson.A{p.Longitude, p.Latitude}},
+	}
+}
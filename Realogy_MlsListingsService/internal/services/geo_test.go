@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestSearchMlsListingsByGeoInvalid(t *testing.T) {
+	s := &Service{}
+	box := &pb.GeoBoundingBox{SouthWest: &pb.GeoPoint{Latitude: 40.6, Longitude: -74.1}, NorthEast: &pb.GeoPoint{Latitude: 40.8, Longitude: -73.9}}
+	polygon := `{"type":"Polygon","coordinates":[[[-74.1,40.6],[-73.9,40.6],[-73.9,40.8],[-74.1,40.6]]]}`
+	for _, in := range []*pb.SearchMlsListingsByGeoRequest{
+		{},
+		{Point: &pb.GeoPoint{Latitude: 91, Longitude: -74.0}, RadiusMeters: 1000},
+		// a radius is only around a point.
+		{BoundingBox: box, RadiusMeters: 1000},
+		{Polygon: polygon, RadiusMeters: 1000},
+		// polygons with holes are not supported.
+		{Polygon: `{"type":"Polygon","coordinates":[[[-74.1,40.6],[-73.9,40.6],[-73.9,40.8],[-74.1,40.6]],[[-74.0,40.65],[-73.95,40.65],[-73.95,40.7],[-74.0,40.65]]]}`},
+		{Point: &pb.GeoPoint{Latitude: 40.7, Longitude: -74.0}, RadiusMeters: 1000, BoundingBox: box},
+	} {
+		_, err := s.SearchMlsListingsByGeo(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/history"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// GetMlsListingHistory returns the price and status changes recorded for a listing by history.Recorder.
+func (s *Service) GetMlsListingHistory(ctx context.Context, in *pb.GetMlsListingHistoryRequest) (*pb.GetMlsListingHistoryResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listingHistory")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingId":       validation.Validate(in.ListingId, validation.Required),
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	filter := bson.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "change_time", Value: 1}, {Key: "_id", Value: 1}}).
+		SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+
+	cursor, err := s.MongoDatabase.Collection(s.HistoryCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Errorf("Unable to find listing history. %v", err)
+		return nil, status.Errorf(codes.Internal, "Unable to find listing history.")
+	}
+	entries := []*pb.MlsListingHistoryEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		log.Errorf("Unable to decode listing history. %v", err)
+		return nil, status.Errorf(codes.Internal, "Unable to find listing history.")
+	}
+	if len(entries) == 0 {
+		return nil, status.Errorf(codes.NotFound, "No history found for listing %s of %s.", in.ListingId, in.SourceSystemKey)
+	}
+
+	return &pb.GetMlsListingHistoryResponse{
+		History: entries,
+		Summary: history.Summarize(entries, time.Now()),
+	}, nil
+}
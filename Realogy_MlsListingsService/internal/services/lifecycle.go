@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/mlsvalidation"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// GetStatusLifecycle returns the lifecycle of the listing standard status that updates, patches and bulk upserts are validated against.
+func (s *Service) GetStatusLifecycle(ctx context.Context, in *pb.GetStatusLifecycleRequest) (*pb.GetStatusLifecycleResponse, error) {
+
+	_, span := trace.StartSpan(ctx, "/statusLifecycle")
+	defer span.End()
+
+	return mlsvalidation.StatusLifecycle(), nil
+}
+
+// standardStatusPath is the listing document field of the standard status.
+const standardStatusPath = "property.listing.standard_status"
+
+// statusFilter matches listing documents in the status a status change was validated from, the write of the change fails
+// when a concurrent write changed the status since the listing was read. Listings without a status match "".
+func statusFilter(from string) bson.E {
+	if from == "" {
+		return bson.E{Key: standardStatusPath, Value: bson.M{"$in": bson.A{nil, ""}}}
+	}
+	return bson.E{Key: standardStatusPath, Value: from}
+}
+
+// changedStatusConflict returns the error of a status change whose listing changed status by a concurrent write after it was read.
+func changedStatusConflict(ctx context.Context, collection *mongo.Collection, filter primitive.D, listingId string, from string) error {
+	var listing pb.MlsListing
+	if err := collection.FindOne(ctx, filter, options.FindOne().SetProjection(bson.M{standardStatusPath: 1})).Decode(&listing); err != nil {
+		if err == mongo.ErrNoDocuments {
+			msg := fmt.Sprintf("Unable to find Realogy Listing for given listingID %s", listingId)
+			log.Errorf(msg)
+			return status.Errorf(codes.NotFound, msg)
+		}
+		msg := fmt.Sprintf("error updating doc %v", listingId)
+		log.Errorf("%v: %v", msg, err)
+		return status.Error(codes.Internal, msg)
+	}
+	msg := statusChangedMessage(listingId, from)
+	log.Errorf("%s Its status is %s.", msg, listing.GetProperty().GetListing().GetStandardStatus())
+	return status.Errorf(codes.FailedPrecondition, msg)
+}
+
+// statusChangedMessage is the message of a status change whose listing is no longer in the status it was validated from.
+func statusChangedMessage(listingId string, from string) string {
+	return fmt.Sprintf("Listing %s has changed, its status is no longer %s.", listingId, from)
+}
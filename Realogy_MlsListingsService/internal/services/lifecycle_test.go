@@ -0,0 +1,15 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestStatusFilter(t *testing.T) {
+	assert.Equal(t, bson.E{Key: "property.listing.standard_status", Value: "Active"}, statusFilter("Active"))
+	// listings without a status have no field or an empty one.
+	assert.Equal(t, bson.E{Key: "property.listing.standard_status", Value: bson.M{"$in": bson.A{nil, ""}}}, statusFilter(""))
+	assert.Equal(t, "Listing 456778 has changed, its status is no longer Pending.", statusChangedMessage("456778", "Pending"))
+}
@@ -36,6 +36,26 @@ func (m *MockMlsListingServiceClient) EXPECT() *MockMlsListingServiceClientMockR
 	return m.recorder
 }
 
+// AckMlsListingEvent mocks base method.
+func (m *MockMlsListingServiceClient) AckMlsListingEvent(arg0 context.Context, arg1 *v1.AckMlsListingEventRequest, arg2 ...grpc.CallOption) (*v1.AckMlsListingEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AckMlsListingEvent", varargs...)
+	ret0, _ := ret[0].(*v1.AckMlsListingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AckMlsListingEvent indicates an expected call of AckMlsListingEvent.
+func (mr *MockMlsListingServiceClientMockRecorder) AckMlsListingEvent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckMlsListingEvent", reflect.TypeOf((*MockMlsListingServiceClient)(nil).AckMlsListingEvent), varargs...)
+}
+
 // AddMlsListings mocks base method.
 func (m *MockMlsListingServiceClient) AddMlsListings(arg0 context.Context, arg1 *v1.MlsListingInput, arg2 ...grpc.CallOption) (*v1.AddListingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +76,166 @@ func (mr *MockMlsListingServiceClientMockRecorder) AddMlsListings(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMlsListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).AddMlsListings), varargs...)
 }
 
+// BulkUpsertMlsListings mocks base method.
+func (m *MockMlsListingServiceClient) BulkUpsertMlsListings(arg0 context.Context, arg1 *v1.BulkUpsertMlsListingsRequest, arg2 ...grpc.CallOption) (*v1.BulkUpsertMlsListingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BulkUpsertMlsListings", varargs...)
+	ret0, _ := ret[0].(*v1.BulkUpsertMlsListingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpsertMlsListings indicates an expected call of BulkUpsertMlsListings.
+func (mr *MockMlsListingServiceClientMockRecorder) BulkUpsertMlsListings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertMlsListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).BulkUpsertMlsListings), varargs...)
+}
+
+// CreateMlsListingWebhook mocks base method.
+func (m *MockMlsListingServiceClient) CreateMlsListingWebhook(arg0 context.Context, arg1 *v1.CreateMlsListingWebhookRequest, arg2 ...grpc.CallOption) (*v1.MlsListingWebhook, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateMlsListingWebhook", varargs...)
+	ret0, _ := ret[0].(*v1.MlsListingWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMlsListingWebhook indicates an expected call of CreateMlsListingWebhook.
+func (mr *MockMlsListingServiceClientMockRecorder) CreateMlsListingWebhook(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMlsListingWebhook", reflect.TypeOf((*MockMlsListingServiceClient)(nil).CreateMlsListingWebhook), varargs...)
+}
+
+// DeleteMlsListing mocks base method.
+func (m *MockMlsListingServiceClient) DeleteMlsListing(arg0 context.Context, arg1 *v1.DeleteMlsListingRequest, arg2 ...grpc.CallOption) (*v1.DeleteMlsListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMlsListing", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteMlsListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMlsListing indicates an expected call of DeleteMlsListing.
+func (mr *MockMlsListingServiceClientMockRecorder) DeleteMlsListing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMlsListing", reflect.TypeOf((*MockMlsListingServiceClient)(nil).DeleteMlsListing), varargs...)
+}
+
+// DeleteMlsListingWebhook mocks base method.
+func (m *MockMlsListingServiceClient) DeleteMlsListingWebhook(arg0 context.Context, arg1 *v1.DeleteMlsListingWebhookRequest, arg2 ...grpc.CallOption) (*v1.DeleteMlsListingWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMlsListingWebhook", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteMlsListingWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMlsListingWebhook indicates an expected call of DeleteMlsListingWebhook.
+func (mr *MockMlsListingServiceClientMockRecorder) DeleteMlsListingWebhook(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMlsListingWebhook", reflect.TypeOf((*MockMlsListingServiceClient)(nil).DeleteMlsListingWebhook), varargs...)
+}
+
+// ExportMlsListings mocks base method.
+func (m *MockMlsListingServiceClient) ExportMlsListings(arg0 context.Context, arg1 *v1.ExportMlsListingsRequest, arg2 ...grpc.CallOption) (v1.MlsListingService_ExportMlsListingsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportMlsListings", varargs...)
+	ret0, _ := ret[0].(v1.MlsListingService_ExportMlsListingsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMlsListings indicates an expected call of ExportMlsListings.
+func (mr *MockMlsListingServiceClientMockRecorder) ExportMlsListings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMlsListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ExportMlsListings), varargs...)
+}
+
+// GetAgent mocks base method.
+func (m *MockMlsListingServiceClient) GetAgent(arg0 context.Context, arg1 *v1.GetAgentRequest, arg2 ...grpc.CallOption) (*v1.GetAgentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAgent", varargs...)
+	ret0, _ := ret[0].(*v1.GetAgentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgent indicates an expected call of GetAgent.
+func (mr *MockMlsListingServiceClientMockRecorder) GetAgent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgent", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetAgent), varargs...)
+}
+
+// GetComparableSales mocks base method.
+func (m *MockMlsListingServiceClient) GetComparableSales(arg0 context.Context, arg1 *v1.GetComparableSalesRequest, arg2 ...grpc.CallOption) (*v1.GetComparableSalesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComparableSales", varargs...)
+	ret0, _ := ret[0].(*v1.GetComparableSalesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComparableSales indicates an expected call of GetComparableSales.
+func (mr *MockMlsListingServiceClientMockRecorder) GetComparableSales(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComparableSales", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetComparableSales), varargs...)
+}
+
+// GetListingDuplicates mocks base method.
+func (m *MockMlsListingServiceClient) GetListingDuplicates(arg0 context.Context, arg1 *v1.GetListingDuplicatesRequest, arg2 ...grpc.CallOption) (*v1.GetListingDuplicatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetListingDuplicates", varargs...)
+	ret0, _ := ret[0].(*v1.GetListingDuplicatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingDuplicates indicates an expected call of GetListingDuplicates.
+func (mr *MockMlsListingServiceClientMockRecorder) GetListingDuplicates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingDuplicates", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetListingDuplicates), varargs...)
+}
+
 // GetMlsListingByListingGuid mocks base method.
 func (m *MockMlsListingServiceClient) GetMlsListingByListingGuid(arg0 context.Context, arg1 *v1.GetMlsListingByListingGuidRequest, arg2 ...grpc.CallOption) (*v1.GetMlsListingByListingGuidResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +296,26 @@ func (mr *MockMlsListingServiceClientMockRecorder) GetMlsListingBySource(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMlsListingBySource", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetMlsListingBySource), varargs...)
 }
 
+// GetMlsListingHistory mocks base method.
+func (m *MockMlsListingServiceClient) GetMlsListingHistory(arg0 context.Context, arg1 *v1.GetMlsListingHistoryRequest, arg2 ...grpc.CallOption) (*v1.GetMlsListingHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMlsListingHistory", varargs...)
+	ret0, _ := ret[0].(*v1.GetMlsListingHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMlsListingHistory indicates an expected call of GetMlsListingHistory.
+func (mr *MockMlsListingServiceClientMockRecorder) GetMlsListingHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMlsListingHistory", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetMlsListingHistory), varargs...)
+}
+
 // GetMlsListingsByAddress mocks base method.
 func (m *MockMlsListingServiceClient) GetMlsListingsByAddress(arg0 context.Context, arg1 *v1.GetMlsListingsByAddressRequest, arg2 ...grpc.CallOption) (*v1.GetMlsListingsByAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -396,6 +596,46 @@ func (mr *MockMlsListingServiceClientMockRecorder) GetRealogyListings(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRealogyListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetRealogyListings), varargs...)
 }
 
+// GetStatusLifecycle mocks base method.
+func (m *MockMlsListingServiceClient) GetStatusLifecycle(arg0 context.Context, arg1 *v1.GetStatusLifecycleRequest, arg2 ...grpc.CallOption) (*v1.GetStatusLifecycleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStatusLifecycle", varargs...)
+	ret0, _ := ret[0].(*v1.GetStatusLifecycleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusLifecycle indicates an expected call of GetStatusLifecycle.
+func (mr *MockMlsListingServiceClientMockRecorder) GetStatusLifecycle(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusLifecycle", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetStatusLifecycle), varargs...)
+}
+
+// GetUpcomingOpenHouses mocks base method.
+func (m *MockMlsListingServiceClient) GetUpcomingOpenHouses(arg0 context.Context, arg1 *v1.GetUpcomingOpenHousesRequest, arg2 ...grpc.CallOption) (*v1.GetUpcomingOpenHousesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUpcomingOpenHouses", varargs...)
+	ret0, _ := ret[0].(*v1.GetUpcomingOpenHousesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingOpenHouses indicates an expected call of GetUpcomingOpenHouses.
+func (mr *MockMlsListingServiceClientMockRecorder) GetUpcomingOpenHouses(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingOpenHouses", reflect.TypeOf((*MockMlsListingServiceClient)(nil).GetUpcomingOpenHouses), varargs...)
+}
+
 // HealthCheck mocks base method.
 func (m *MockMlsListingServiceClient) HealthCheck(arg0 context.Context, arg1 *v1.HealthRequest, arg2 ...grpc.CallOption) (*v1.HealthResponse, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +656,166 @@ func (mr *MockMlsListingServiceClientMockRecorder) HealthCheck(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockMlsListingServiceClient)(nil).HealthCheck), varargs...)
 }
 
+// ListAgents mocks base method.
+func (m *MockMlsListingServiceClient) ListAgents(arg0 context.Context, arg1 *v1.ListAgentsRequest, arg2 ...grpc.CallOption) (*v1.ListAgentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAgents", varargs...)
+	ret0, _ := ret[0].(*v1.ListAgentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgents indicates an expected call of ListAgents.
+func (mr *MockMlsListingServiceClientMockRecorder) ListAgents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgents", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ListAgents), varargs...)
+}
+
+// ListMlsListingWebhookDeadLetters mocks base method.
+func (m *MockMlsListingServiceClient) ListMlsListingWebhookDeadLetters(arg0 context.Context, arg1 *v1.ListMlsListingWebhookDeadLettersRequest, arg2 ...grpc.CallOption) (*v1.ListMlsListingWebhookDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMlsListingWebhookDeadLetters", varargs...)
+	ret0, _ := ret[0].(*v1.ListMlsListingWebhookDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMlsListingWebhookDeadLetters indicates an expected call of ListMlsListingWebhookDeadLetters.
+func (mr *MockMlsListingServiceClientMockRecorder) ListMlsListingWebhookDeadLetters(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMlsListingWebhookDeadLetters", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ListMlsListingWebhookDeadLetters), varargs...)
+}
+
+// ListMlsListingWebhooks mocks base method.
+func (m *MockMlsListingServiceClient) ListMlsListingWebhooks(arg0 context.Context, arg1 *v1.ListMlsListingWebhooksRequest, arg2 ...grpc.CallOption) (*v1.ListMlsListingWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMlsListingWebhooks", varargs...)
+	ret0, _ := ret[0].(*v1.ListMlsListingWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMlsListingWebhooks indicates an expected call of ListMlsListingWebhooks.
+func (mr *MockMlsListingServiceClientMockRecorder) ListMlsListingWebhooks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMlsListingWebhooks", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ListMlsListingWebhooks), varargs...)
+}
+
+// ListOffices mocks base method.
+func (m *MockMlsListingServiceClient) ListOffices(arg0 context.Context, arg1 *v1.ListOfficesRequest, arg2 ...grpc.CallOption) (*v1.ListOfficesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOffices", varargs...)
+	ret0, _ := ret[0].(*v1.ListOfficesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOffices indicates an expected call of ListOffices.
+func (mr *MockMlsListingServiceClientMockRecorder) ListOffices(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOffices", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ListOffices), varargs...)
+}
+
+// PatchMlsListing mocks base method.
+func (m *MockMlsListingServiceClient) PatchMlsListing(arg0 context.Context, arg1 *v1.PatchMlsListingRequest, arg2 ...grpc.CallOption) (*v1.PatchMlsListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchMlsListing", varargs...)
+	ret0, _ := ret[0].(*v1.PatchMlsListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchMlsListing indicates an expected call of PatchMlsListing.
+func (mr *MockMlsListingServiceClientMockRecorder) PatchMlsListing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchMlsListing", reflect.TypeOf((*MockMlsListingServiceClient)(nil).PatchMlsListing), varargs...)
+}
+
+// QueryMlsListings mocks base method.
+func (m *MockMlsListingServiceClient) QueryMlsListings(arg0 context.Context, arg1 *v1.QueryMlsListingsRequest, arg2 ...grpc.CallOption) (*v1.QueryMlsListingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryMlsListings", varargs...)
+	ret0, _ := ret[0].(*v1.QueryMlsListingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryMlsListings indicates an expected call of QueryMlsListings.
+func (mr *MockMlsListingServiceClientMockRecorder) QueryMlsListings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryMlsListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).QueryMlsListings), varargs...)
+}
+
+// ReplayMlsListingWebhookDeadLetters mocks base method.
+func (m *MockMlsListingServiceClient) ReplayMlsListingWebhookDeadLetters(arg0 context.Context, arg1 *v1.ReplayMlsListingWebhookDeadLettersRequest, arg2 ...grpc.CallOption) (*v1.ReplayMlsListingWebhookDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayMlsListingWebhookDeadLetters", varargs...)
+	ret0, _ := ret[0].(*v1.ReplayMlsListingWebhookDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayMlsListingWebhookDeadLetters indicates an expected call of ReplayMlsListingWebhookDeadLetters.
+func (mr *MockMlsListingServiceClientMockRecorder) ReplayMlsListingWebhookDeadLetters(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayMlsListingWebhookDeadLetters", reflect.TypeOf((*MockMlsListingServiceClient)(nil).ReplayMlsListingWebhookDeadLetters), varargs...)
+}
+
+// RestoreMlsListing mocks base method.
+func (m *MockMlsListingServiceClient) RestoreMlsListing(arg0 context.Context, arg1 *v1.RestoreMlsListingRequest, arg2 ...grpc.CallOption) (*v1.RestoreMlsListingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreMlsListing", varargs...)
+	ret0, _ := ret[0].(*v1.RestoreMlsListingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreMlsListing indicates an expected call of RestoreMlsListing.
+func (mr *MockMlsListingServiceClientMockRecorder) RestoreMlsListing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreMlsListing", reflect.TypeOf((*MockMlsListingServiceClient)(nil).RestoreMlsListing), varargs...)
+}
+
 // SearchMlsListings mocks base method.
 func (m *MockMlsListingServiceClient) SearchMlsListings(arg0 context.Context, arg1 *v1.SearchMlsListingsRequest, arg2 ...grpc.CallOption) (*v1.SearchMlsListingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +836,26 @@ func (mr *MockMlsListingServiceClientMockRecorder) SearchMlsListings(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMlsListings", reflect.TypeOf((*MockMlsListingServiceClient)(nil).SearchMlsListings), varargs...)
 }
 
+// SearchMlsListingsByGeo mocks base method.
+func (m *MockMlsListingServiceClient) SearchMlsListingsByGeo(arg0 context.Context, arg1 *v1.SearchMlsListingsByGeoRequest, arg2 ...grpc.CallOption) (*v1.SearchMlsListingsByGeoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchMlsListingsByGeo", varargs...)
+	ret0, _ := ret[0].(*v1.SearchMlsListingsByGeoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMlsListingsByGeo indicates an expected call of SearchMlsListingsByGeo.
+func (mr *MockMlsListingServiceClientMockRecorder) SearchMlsListingsByGeo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMlsListingsByGeo", reflect.TypeOf((*MockMlsListingServiceClient)(nil).SearchMlsListingsByGeo), varargs...)
+}
+
 // StreamMlsListingByCity mocks base method.
 func (m *MockMlsListingServiceClient) StreamMlsListingByCity(arg0 context.Context, arg1 *v1.GetMlsListingsByCityRequest, arg2 ...grpc.CallOption) (v1.MlsListingService_StreamMlsListingByCityClient, error) {
 	m.ctrl.T.Helper()
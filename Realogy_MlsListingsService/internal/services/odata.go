@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/mlsprojection"
+	"mlslisting/internal/odata"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// QueryMlsListings runs an OData query of the Property resource on the listings collection.
+func (s *Service) QueryMlsListings(ctx context.Context, in *pb.QueryMlsListingsRequest) (*pb.QueryMlsListingsResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/queryListings")
+	defer span.End()
+
+	err := validation.Errors{
+		"Top":  validation.Validate(in.Top, validation.Min(0)),
+		"Skip": validation.Validate(in.Skip, validation.Min(0)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	filter, err := odata.ParseFilter(in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	sort, err := odata.ParseOrderBy(in.OrderBy)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	selected, err := odata.ParseSelect(in.Select)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	response := &pb.QueryMlsListingsResponse{Listings: []*pb.MlsListing{}, ListingKeys: []string{}}
+	findOptions := s.findOptions(in.Top, in.Skip).SetSort(sort)
+	if len(in.Select) > 0 {
+		projection := primitive.D{}
+		for _, field := range selected {
+			projection = mlsprojection.Include(projection, field.Path)
+		}
+		findOptions.SetProjection(projection)
+	}
+	for _, field := range selected {
+		response.Select = append(response.Select, field.Name)
+	}
+	response.Top = int32(*findOptions.Limit)
+
+	filter = tombstone.Exclude(filter)
+	collection := s.MongoDatabase.Collection(s.ListingsCollection)
+	if in.Count {
+		countOptions := options.Count().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+		if response.Count, err = collection.CountDocuments(ctx, filter, countOptions); err != nil {
+			log.Errorf("Unable to count listings for OData filter %q. %v", in.Filter, err)
+			return nil, status.Errorf(codes.Internal, "Unable to count listings.")
+		}
+	}
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Errorf("Unable to find listings for OData filter %q. %v", in.Filter, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find listings.")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var key struct {
+			Id string `bson:"_id"`
+		}
+		var listing pb.MlsListing
+		if err := cursor.Decode(&key); err != nil {
+			log.Errorf("Unable to decode the mongo document: %v", err)
+			continue
+		}
+		if err := cursor.Decode(&listing); err != nil {
+			log.Errorf("Unable to decode the mongo document: %v", err)
+			continue
+		}
+		response.Listings = append(response.Listings, &listing)
+		response.ListingKeys = append(response.ListingKeys, key.Id)
+	}
+	if err := cursor.Err(); err != nil {
+		log.Errorf("Unable to find listings for OData filter %q. %v", in.Filter, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find listings.")
+	}
+	return response, nil
+}
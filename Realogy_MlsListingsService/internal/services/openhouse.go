@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+const (
+	// openHouseWindowDefault is the window of upcoming open houses when the request has no end time.
+	openHouseWindowDefault = 7 * 24 * time.Hour
+	// openHouseWindowMax is the longest window of upcoming open houses.
+	openHouseWindowMax = 31 * 24 * time.Hour
+
+	openHouseInPerson   = "IN_PERSON"
+	openHouseLiveStream = "LIVE_STREAM"
+)
+
+// GetUpcomingOpenHouses returns the open houses starting within a window in an area, one entry per open house ordered by start time.
+func (s *Service) GetUpcomingOpenHouses(ctx context.Context, in *pb.GetUpcomingOpenHousesRequest) (*pb.GetUpcomingOpenHousesResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/upcomingOpenHouses")
+	defer span.End()
+
+	err := validation.Errors{
+		"State":        validation.Validate(in.State, validation.When(in.City == "", validation.Empty.Error("requires a city"))),
+		"Point":        validateGeoPoint(in.Point),
+		"RadiusMeters": validation.Validate(in.RadiusMeters, validation.Min(0.0), validation.When(in.Point != nil, validation.Required)),
+	}.Filter()
+	if err == nil && in.City == "" && in.PostalCode == "" && in.Point == nil && in.SourceSystemKey == "" {
+		err = fmt.Errorf("one of city, postalCode, point with radiusMeters or sourceSystemKey is required")
+	}
+	if err == nil && in.Point == nil && in.RadiusMeters > 0 {
+		err = fmt.Errorf("radiusMeters requires a point")
+	}
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	start, end, err := openHouseWindow(in, time.Now())
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	match := primitive.D{}
+	if in.City != "" {
+		match = append(match, bson.E{Key: "property.location.address.city", Value: in.City})
+	}
+	if in.State != "" {
+		match = append(match, bson.E{Key: "property.location.address.state_or_province", Value: in.State})
+	}
+	if in.PostalCode != "" {
+		match = append(match, bson.E{Key: "property.location.address.postal_code", Value: in.PostalCode})
+	}
+	if in.Point != nil {
+		match = append(match, bson.E{Key: geoLocationField, Value: geoWithinSphere(in.Point, in.RadiusMeters)})
+	}
+	if in.SourceSystemKey != "" {
+		match = append(match, bson.E{Key: "property.listing.source_system_key", Value: in.SourceSystemKey})
+	}
+
+	limit := s.getLimit(in.Limit)
+	pipeline := upcomingOpenHousesPipeline(tombstone.Exclude(match), start, end, int64(in.Offset), limit)
+
+	opts := options.Aggregate().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	cur, err := s.MongoDatabase.Collection(s.ListingsCollection).Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		log.Errorf("Error while processing the request for upcoming open houses: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching open houses %s", in))
+	}
+	defer cur.Close(ctx)
+
+	response := &pb.GetUpcomingOpenHousesResponse{}
+	hasMore := false
+	for cur.Next(ctx) {
+		if int64(len(response.OpenHouses)) == limit {
+			hasMore = true
+			break
+		}
+		var result pb.UpcomingOpenHouse
+		if err := cur.Decode(&result); err != nil {
+			log.Errorf("Unable to decode the document: %v", err)
+		}
+		response.OpenHouses = append(response.OpenHouses, &result)
+	}
+
+	if len(response.OpenHouses) == 0 {
+		log.Errorf("Unable to find open houses for %s", in)
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find open houses for %s", in))
+	}
+	response.PageInfo = &pb.PageInfo{Limit: int32(limit), Offset: in.Offset, HasMore: hasMore}
+	return response, nil
+}
+
+// openHouseWindow returns the window of open house start times of a request, by default the week from now.
+func openHouseWindow(in *pb.GetUpcomingOpenHousesRequest, now time.Time) (time.Time, time.Time, error) {
+	start := now
+	if in.StartTime != nil {
+		start = in.StartTime.AsTime()
+	}
+	end := start.Add(openHouseWindowDefault)
+	if in.EndTime != nil {
+		end = in.EndTime.AsTime()
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("endTime must be after startTime")
+	}
+	if end.Sub(start) > openHouseWindowMax {
+		return start, end, fmt.Errorf("the window from startTime to endTime can not be longer than %d days", int(openHouseWindowMax.Hours()/24))
+	}
+	return start, end, nil
+}
+
+// upcomingOpenHousesPipeline unwinds the open houses, in person and live streamed, of the listings matching the filter
+// into one document per open house starting within the window, ordered by start time.
+func upcomingOpenHousesPipeline(match primitive.D, start, end time.Time, offset, limit int64) mongo.Pipeline {
+	window := bson.D{{Key: "$gte", Value: start}, {Key: "$lt", Value: end}}
+	// the date of open houses is their start when the source has no start time.
+	starting := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "open_house_start_time", Value: window}},
+		bson.D{{Key: "open_house_start_time", Value: nil}, {Key: "open_house_date", Value: window}},
+	}}}
+	match = append(match, bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "open_house.open_homes", Value: bson.D{{Key: "$elemMatch", Value: starting}}}},
+		bson.D{{Key: "live_stream_open_house.live_stream_open_homes", Value: bson.D{{Key: "$elemMatch", Value: starting}}}},
+	}})
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "listing_id", Value: "$property.listing.listing_id"},
+			{Key: "source_system_key", Value: "$property.listing.source_system_key"},
+			{Key: "standard_status", Value: "$property.listing.standard_status"},
+			{Key: "list_price", Value: "$property.listing.price.list_price"},
+			{Key: "unparsed_address", Value: "$property.location.address.unparsed_address"},
+			{Key: "city", Value: "$property.location.address.city"},
+			{Key: "state_or_province", Value: "$property.location.address.state_or_province"},
+			{Key: "postal_code", Value: "$property.location.address.postal_code"},
+			{Key: "open_house", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				openHouses("$open_house.open_homes", openHouseInPerson, "$$oh.open_house_remarks"),
+				openHouses("$live_stream_open_house.live_stream_open_homes", openHouseLiveStream, "$$oh.comments"),
+			}}}},
+		}}},
+		{{Key: "$unwind", Value: "$open_house"}},
+		{{Key: "$match", Value: bson.D{
+			{Key: "open_house.start_time", Value: window},
+			{Key: "open_house.is_canceled", Value: bson.D{{Key: "$ne", Value: true}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "open_house.start_time", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$skip", Value: offset}},
+		// one more than the limit tells whether there is a next page.
+		{{Key: "$limit", Value: limit + 1}},
+		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: bson.D{{Key: "$mergeObjects", Value: bson.A{"$$ROOT", "$open_house"}}}}}}},
+		{{Key: "$project", Value: bson.D{{Key: "open_house", Value: 0}, {Key: "is_canceled", Value: 0}}}},
+	}
+}
+
+// openHouses maps the open houses of a listing array field to the fields of UpcomingOpenHouse.
+func openHouses(field string, openHouseType string, remarks string) bson.D {
+	return bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: bson.D{{Key: "$ifNull", Value: bson.A{field, bson.A{}}}}},
+		{Key: "as", Value: "oh"},
+		{Key: "in", Value: bson.D{
+			{Key: "type", Value: openHouseType},
+			{Key: "open_house_date", Value: "$$oh.open_house_date"},
+			{Key: "start_time", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$$oh.open_house_start_time", "$$oh.open_house_date"}}}},
+			{Key: "end_time", Value: "$$oh.open_house_end_time"},
+			{Key: "remarks", Value: remarks},
+			{Key: "is_appointment_needed", Value: "$$oh.is_appointment_needed"},
+			{Key: "url", Value: "$$oh.url"},
+			{Key: "is_canceled", Value: "$$oh.is_canceled"},
+		}},
+	}}}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestGetUpcomingOpenHousesInvalid(t *testing.T) {
+	s := &Service{}
+	for _, in := range []*pb.GetUpcomingOpenHousesRequest{
+		// an area is required.
+		{},
+		{State: "CA"},
+		{City: "Irvine", RadiusMeters: 1000},
+		{Point: &pb.GeoPoint{Latitude: 33.68, Longitude: -117.82}},
+		{Point: &pb.GeoPoint{Latitude: 93.68, Longitude: -117.82}, RadiusMeters: 1000},
+		{City: "Irvine", StartTime: timestamppb.New(time.Now()), EndTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		{City: "Irvine", EndTime: timestamppb.New(time.Now().Add(60 * 24 * time.Hour))},
+	} {
+		_, err := s.GetUpcomingOpenHouses(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
+
+func TestOpenHouseWindow(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	start, end, err := openHouseWindow(&pb.GetUpcomingOpenHousesRequest{}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, start)
+	assert.Equal(t, now.Add(7*24*time.Hour), end)
+
+	saturday := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	start, end, err = openHouseWindow(&pb.GetUpcomingOpenHousesRequest{
+		StartTime: timestamppb.New(saturday),
+		EndTime:   timestamppb.New(saturday.Add(48 * time.Hour)),
+	}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, saturday, start)
+	assert.Equal(t, saturday.Add(48*time.Hour), end)
+
+	_, _, err = openHouseWindow(&pb.GetUpcomingOpenHousesRequest{EndTime: timestamppb.New(now)}, now)
+	assert.NotNil(t, err)
+}
+
+func TestUpcomingOpenHousesPipeline(t *testing.T) {
+	start := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	pipeline := upcomingOpenHousesPipeline(primitive.D{{Key: "property.location.address.city", Value: "Irvine"}}, start, end, 20, 10)
+
+	// listings are matched by their area and an open house in the window, then unwound into the open houses in the window.
+	match := pipeline[0][0].Value.(primitive.D)
+	assert.Equal(t, "property.location.address.city", match[0].Key)
+	assert.Equal(t, "$or", match[1].Key)
+	assert.Equal(t, bson.E{Key: "$unwind", Value: "$open_house"}, pipeline[2][0])
+	assert.Equal(t, bson.D{
+		{Key: "open_house.start_time", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lt", Value: end}}},
+		{Key: "open_house.is_canceled", Value: bson.D{{Key: "$ne", Value: true}}},
+	}, pipeline[3][0].Value)
+	assert.Equal(t, bson.D{{Key: "open_house.start_time", Value: 1}, {Key: "_id", Value: 1}}, pipeline[4][0].Value)
+	assert.Equal(t, int64(20), pipeline[5][0].Value)
+	assert.Equal(t, int64(11), pipeline[6][0].Value)
+}
@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mlslisting/internal/mlsprojection"
+	"mlslisting/internal/tombstone"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// listQuery describes a page of listings to find.
+type listQuery struct {
+	filter    primitive.D
+	limit     int32
+	offset    int32
+	pageToken string
+	countMode pb.CountMode
+	sort      []string
+	fields    *fieldmaskpb.FieldMask
+	collation *options.Collation
+}
+
+// listPage is a page of listings with its paging metadata.
+type listPage struct {
+	listings      []*pb.MlsListing
+	nextPageToken string
+	pageInfo      *pb.PageInfo
+}
+
+// pageToken is the keyset position of the last listing of a page: its values of the sort keys.
+type pageToken struct {
+	Sort   string          `bson:"s"`
+	Values []bson.RawValue `bson:"v"`
+}
+
+// findListings finds a page of listings ordered by the sort of the query, by last change date when there is none.
+// The next page token resumes after the last listing of the page and is empty when there are no more listings.
+func (s *Service) findListings(ctx context.Context, q listQuery) (*listPage, error) {
+	filter := tombstone.Exclude(q.filter)
+	keys, err := parseSort(q.sort)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	projection, err := mlsprojection.Compile(q.fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	if q.pageToken != "" {
+		if q.offset != 0 {
+			msg := "offset cannot be combined with pageToken"
+			log.Errorf("Validation Error. %v", msg)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+		}
+		token, err := decodePageToken(q.pageToken, keys)
+		if err != nil {
+			log.Errorf("Validation Error. %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+		}
+		filter = primitive.D{{Key: "$and", Value: bson.A{filter, token.after(keys)}}}
+	}
+
+	limit := s.getLimit(q.limit)
+	findOptions := s.findOptions(q.limit, q.offset)
+	// one more than the limit tells whether there is a next page.
+	findOptions.SetLimit(limit + 1)
+	findOptions.SetSort(sortDocument(keys))
+	if q.collation != nil {
+		findOptions.SetCollation(q.collation)
+	}
+	if projection != nil {
+		// the next page token is read from the sort keys.
+		for _, k := range keys {
+			projection = mlsprojection.Include(projection, k.path)
+		}
+		findOptions.SetProjection(projection)
+	}
+
+	cur, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Errorf("Error while processing the request to search mls: %v", err)
+		return nil, status.Errorf(codes.Internal, "Error while searching mls listings")
+	}
+	defer cur.Close(ctx)
+
+	page := &listPage{}
+	var last *pageToken
+	for cur.Next(ctx) {
+		if int64(len(page.listings)) == limit {
+			page.nextPageToken, err = last.encode()
+			if err != nil {
+				log.Errorf("Unable to create the next page token: %v", err)
+				return nil, status.Errorf(codes.Internal, "Error while searching mls listings")
+			}
+			break
+		}
+		var result pb.MlsListing
+		err := cur.Decode(&result)
+		if err != nil {
+			// log error and process next item. no need to abort the loop.
+			log.Errorf("Unable to decode the document: %v", err)
+		}
+		page.listings = append(page.listings, &result)
+		last = &pageToken{Sort: sortSpec(keys)}
+		for _, k := range keys {
+			last.Values = append(last.Values, rawValueOf(cur.Current, k.path))
+		}
+	}
+
+	// the total count is for the whole request, regardless of the page token.
+	page.pageInfo, err = s.pageInfo(ctx, q, page.nextPageToken != "")
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// searchListings runs an atlas search for a page of listings. Matching listings are counted with $searchMeta.
+// search is the $search stage document, the index and the operator. The sort of the query is applied by the search index.
+func (s *Service) searchListings(ctx context.Context, search primitive.D, q listQuery) (*listPage, error) {
+	if q.pageToken != "" {
+		msg := "pageToken is not supported with search query"
+		log.Errorf("Validation Error. %v", msg)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+	}
+	sort, err := searchSortDocument(q.sort)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	projection, err := mlsprojection.Compile(q.fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	search = tombstone.ExcludeSearch(search)
+	sortedSearch := search
+	if sort != nil {
+		sortedSearch = append(append(primitive.D{}, search...), bson.E{Key: "sort", Value: sort})
+	}
+	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
+	opts := options.Aggregate().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+
+	limit := s.getLimit(q.limit)
+	pipeline := bson.A{
+		bson.D{{Key: "$search", Value: sortedSearch}},
+		bson.D{{Key: "$skip", Value: int64(q.offset)}},
+		// one more than the limit tells whether there is a next page.
+		bson.D{{Key: "$limit", Value: limit + 1}},
+	}
+	if projection != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$project", Value: projection}})
+	}
+	cur, err := mongoCollection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		log.Errorf("Error while searching listings : %v", err)
+		return nil, status.Errorf(codes.Internal, "Error while searching mls listings")
+	}
+	defer cur.Close(ctx)
+
+	page := &listPage{}
+	hasMore := false
+	for cur.Next(ctx) {
+		if int64(len(page.listings)) == limit {
+			hasMore = true
+			break
+		}
+		var result pb.MlsListing
+		err := cur.Decode(&result)
+		if err != nil {
+			log.Errorf("unable to decode the document: %v", err)
+		}
+		page.listings = append(page.listings, &result)
+	}
+
+	count := bson.D{{Key: "type", Value: "total"}}
+	if q.countMode == pb.CountMode_ESTIMATED {
+		count = bson.D{{Key: "type", Value: "lowerBound"}, {Key: "threshold", Value: s.countEstimateLimit()}}
+	}
+	meta := append(primitive.D{}, search...)
+	meta = append(meta, bson.E{Key: "count", Value: count})
+	metaCur, err := mongoCollection.Aggregate(ctx, bson.A{bson.D{{Key: "$searchMeta", Value: meta}}}, opts)
+	if err != nil {
+		log.Errorf("Error while counting search results : %v", err)
+		return nil, status.Errorf(codes.Internal, "Error while searching mls listings")
+	}
+	defer metaCur.Close(ctx)
+
+	var searchMeta struct {
+		Count struct {
+			Total      int64 `bson:"total"`
+			LowerBound int64 `bson:"lowerBound"`
+		} `bson:"count"`
+	}
+	if metaCur.Next(ctx) {
+		if err := metaCur.Decode(&searchMeta); err != nil {
+			log.Errorf("Unable to decode the search meta: %v", err)
+		}
+	}
+
+	page.pageInfo = &pb.PageInfo{
+		TotalCount: searchMeta.Count.Total,
+		Limit:      int32(limit),
+		Offset:     q.offset,
+		HasMore:    hasMore,
+	}
+	if q.countMode == pb.CountMode_ESTIMATED {
+		page.pageInfo.TotalCount = searchMeta.Count.LowerBound
+		page.pageInfo.TotalCountEstimated = searchMeta.Count.LowerBound >= s.countEstimateLimit()
+	}
+	return page, nil
+}
+
+// pageInfo counts the listings matching the query and builds the paging metadata.
+func (s *Service) pageInfo(ctx context.Context, q listQuery, hasMore bool) (*pb.PageInfo, error) {
+	total, estimated, err := s.countListings(ctx, q.filter, q.countMode, q.collation)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PageInfo{
+		TotalCount:          total,
+		TotalCountEstimated: estimated,
+		Limit:               int32(s.getLimit(q.limit)),
+		Offset:              q.offset,
+		HasMore:             hasMore,
+	}, nil
+}
+
+// countListings counts the listings matching the filter. An estimated count stops at the configured estimate limit
+// and reports whether the limit was reached.
+func (s *Service) countListings(ctx context.Context, filter primitive.D, mode pb.CountMode, collation *options.Collation) (int64, bool, error) {
+	if filter == nil {
+		filter = primitive.D{}
+	}
+	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
+
+	if mode == pb.CountMode_ESTIMATED && len(filter) == 0 {
+		// the estimate of all the listings includes the tombstones of soft deleted ones.
+		total, err := mongoCollection.EstimatedDocumentCount(ctx, options.EstimatedDocumentCount().SetMaxTime(time.Duration(s.MaxQueryTimeSecs)*time.Second))
+		if err != nil {
+			log.Errorf("Error while counting mls listings: %v", err)
+			return 0, false, status.Errorf(codes.Internal, "Error while counting mls listings")
+		}
+		return total, true, nil
+	}
+
+	filter = tombstone.Exclude(filter)
+	countOptions := options.Count().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if collation != nil {
+		countOptions.SetCollation(collation)
+	}
+	if mode == pb.CountMode_ESTIMATED {
+		countOptions.SetLimit(s.countEstimateLimit())
+	}
+	total, err := mongoCollection.CountDocuments(ctx, filter, countOptions)
+	if err != nil {
+		log.Errorf("Error while counting mls listings: %v", err)
+		return 0, false, status.Errorf(codes.Internal, "Error while counting mls listings")
+	}
+	return total, mode == pb.CountMode_ESTIMATED && total >= s.countEstimateLimit(), nil
+}
+
+func (s *Service) countEstimateLimit() int64 {
+	if s.Pagination.CountEstimateLimit <= 0 {
+		return 10000
+	}
+	return s.Pagination.CountEstimateLimit
+}
+
+// after returns the predicate for listings after the token position in the order of the keys.
+// Null sorts before any value, which makes it the last value of a descending key.
+func (t *pageToken) after(keys []sortKey) primitive.D {
+	clauses := bson.A{}
+	for i, k := range keys {
+		var beyond primitive.D
+		v := t.Values[i]
+		switch {
+		case v.Type == bsontype.Null && k.desc:
+			// nothing sorts after null.
+			continue
+		case v.Type == bsontype.Null:
+			beyond = primitive.D{{Key: k.path, Value: bson.D{{Key: "$ne", Value: nil}}}}
+		case k.desc:
+			beyond = primitive.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: k.path, Value: bson.D{{Key: "$lt", Value: v}}}},
+				bson.D{{Key: k.path, Value: nil}},
+			}}}
+		default:
+			beyond = primitive.D{{Key: k.path, Value: bson.D{{Key: "$gt", Value: v}}}}
+		}
+		clause := primitive.D{}
+		for j := 0; j < i; j++ {
+			clause = append(clause, bson.E{Key: keys[j].path, Value: t.Values[j]})
+		}
+		clauses = append(clauses, append(clause, beyond...))
+	}
+	return primitive.D{{Key: "$or", Value: clauses}}
+}
+
+func (t *pageToken) encode() (string, error) {
+	b, err := bson.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePageToken decodes a page token, which has to be created for the same sort keys.
+func decodePageToken(token string, keys []sortKey) (*pageToken, error) {
+	invalid := errors.New("pageToken is not valid")
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	t := &pageToken{}
+	if err := bson.Unmarshal(b, t); err != nil || t.Sort == "" {
+		return nil, invalid
+	}
+	if t.Sort != sortSpec(keys) {
+		return nil, errors.New("pageToken does not match the sort of the request")
+	}
+	if len(t.Values) != len(keys) {
+		return nil, invalid
+	}
+	for _, v := range t.Values {
+		if v.Type == 0 {
+			return nil, invalid
+		}
+	}
+	return t, nil
+}
+
+// rawValueOf copies the value of a dotted path out of the cursor buffer. A missing field is null.
+func rawValueOf(doc bson.Raw, path string) bson.RawValue {
+	v, err := doc.LookupErr(strings.Split(path, ".")...)
+	if err != nil {
+		return bson.RawValue{Type: bsontype.Null}
+	}
+	return bson.RawValue{Type: v.Type, Value: append([]byte(nil), v.Value...)}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	doc, _ := bson.Marshal(bson.D{{Key: "_id", Value: "SOLO_100018"}, {Key: "last_change_date", Value: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)}})
+	keys, _ := parseSort(nil)
+
+	token := &pageToken{Sort: sortSpec(keys), Values: []bson.RawValue{rawValueOf(doc, "last_change_date"), rawValueOf(doc, "_id")}}
+	encoded, err := token.encode()
+	assert.Nil(t, err)
+
+	decoded, err := decodePageToken(encoded, keys)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), decoded.Values[0].Time().UTC())
+	assert.Equal(t, "SOLO_100018", decoded.Values[1].StringValue())
+}
+
+func TestPageTokenMissingLastChangeDate(t *testing.T) {
+	doc, _ := bson.Marshal(bson.D{{Key: "_id", Value: "SOLO_100018"}})
+	keys, _ := parseSort(nil)
+
+	token := &pageToken{Sort: sortSpec(keys), Values: []bson.RawValue{rawValueOf(doc, "last_change_date"), rawValueOf(doc, "_id")}}
+	assert.Equal(t, bsontype.Null, token.Values[0].Type)
+
+	encoded, err := token.encode()
+	assert.Nil(t, err)
+	decoded, err := decodePageToken(encoded, keys)
+	assert.Nil(t, err)
+	assert.Equal(t, bsontype.Null, decoded.Values[0].Type)
+}
+
+func TestPageTokenOfOtherSort(t *testing.T) {
+	doc, _ := bson.Marshal(bson.D{{Key: "_id", Value: "SOLO_100018"}, {Key: "property", Value: bson.D{{Key: "listing", Value: bson.D{{Key: "price", Value: bson.D{{Key: "list_price", Value: 250000.0}}}}}}}})
+	keys, _ := parseSort([]string{"-list_price"})
+
+	token := &pageToken{Sort: sortSpec(keys), Values: []bson.RawValue{rawValueOf(doc, "property.listing.price.list_price"), rawValueOf(doc, "_id")}}
+	assert.Equal(t, 250000.0, token.Values[0].Double())
+	encoded, _ := token.encode()
+
+	_, err := decodePageToken(encoded, keys)
+	assert.Nil(t, err)
+
+	defaultKeys, _ := parseSort(nil)
+	_, err = decodePageToken(encoded, defaultKeys)
+	assert.NotNil(t, err)
+}
+
+func TestDecodeInvalidPageToken(t *testing.T) {
+	keys, _ := parseSort(nil)
+	for _, token := range []string{"not a token", "e30", ""} {
+		_, err := decodePageToken(token, keys)
+		assert.NotNil(t, err, token)
+	}
+}
+
+func TestPageTokenAfterDescending(t *testing.T) {
+	doc, _ := bson.Marshal(bson.D{{Key: "_id", Value: "SOLO_100018"}, {Key: "property", Value: bson.D{{Key: "listing", Value: bson.D{{Key: "price", Value: bson.D{{Key: "list_price", Value: 250000.0}}}}}}}})
+	keys, _ := parseSort([]string{"-list_price"})
+	price, id := rawValueOf(doc, "property.listing.price.list_price"), rawValueOf(doc, "_id")
+
+	token := &pageToken{Sort: sortSpec(keys), Values: []bson.RawValue{price, id}}
+	// lower prices and listings without a price come after a descending price.
+	assert.Equal(t, primitive.D{{Key: "$or", Value: bson.A{
+		primitive.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "property.listing.price.list_price", Value: bson.D{{Key: "$lt", Value: price}}}},
+			bson.D{{Key: "property.listing.price.list_price", Value: nil}},
+		}}},
+		primitive.D{{Key: "property.listing.price.list_price", Value: price}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: id}}}},
+	}}}, token.after(keys))
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/mlspatch"
+	"mlslisting/internal/mlsvalidation"
+	"mlslisting/internal/tombstone"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// patchValidatedFields are the fields whose business rules are validated when a patch changes them.
+var patchValidatedFields = []string{
+	"property.listing.standard_status",
+	"property.listing.price",
+	"property.listing.dates",
+}
+
+// PatchMlsListing updates the fields of a listing named by the update mask, those without a value in the request are cleared.
+// The fields must be writable by the listing source, its status, price and dates changes are validated as UpdateMlsListingByListingId does.
+func (s *Service) PatchMlsListing(ctx context.Context, in *pb.PatchMlsListingRequest) (*pb.PatchMlsListingResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/patchListing")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingId":       validation.Validate(in.ListingId, validation.Required),
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+		"UpdateMask":      validation.Validate(in.UpdateMask.GetPaths(), validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	fields, err := mlspatch.Compile(in.UpdateMask)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	if field, ok := mlspatch.Unwritable(fields, s.Patch.WritableFields(in.SourceSystemKey)); ok {
+		msg := fmt.Sprintf("Field %s of listings of %s is not writable.", field.Path, in.SourceSystemKey)
+		log.Errorf(msg)
+		return nil, status.Errorf(codes.PermissionDenied, msg)
+	}
+	expected, checkVersion, err := parseExpectedVersion(ctx, in.ExpectedVersion)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if md != nil {
+		log.Printf("request has been made by %s to patch mls listing %s of %s", md.Get("apikey"), in.ListingId, in.SourceSystemKey)
+	}
+	collection := s.MongoDatabase.Collection(s.ListingsCollection)
+	filter := primitive.D{{Key: "listing_id", Value: in.ListingId}, {Key: "source_system_key", Value: in.SourceSystemKey}, tombstone.NotDeleted()}
+
+	listingFromDB := &pb.MlsListing{}
+	found := collection.FindOne(ctx, filter)
+	if err := found.Decode(listingFromDB); err != nil {
+		return nil, listingWriteError(err, in.ListingId, "patch")
+	}
+	if current := decodeVersion(found); checkVersion && !expected.matches(current) {
+		return nil, versionConflict(ctx, in.ListingId, expected, current)
+	}
+	if err := mlsvalidation.ValidateUpdateSource(in.SourceSystemKey, listingFromDB); err != nil {
+		return nil, err
+	}
+	fromStatus := listingFromDB.GetProperty().GetListing().GetStandardStatus()
+	changesStatus := false
+	for _, path := range patchValidatedFields {
+		if mlspatch.Touches(fields, path) {
+			patched := proto.Clone(listingFromDB).(*pb.MlsListing)
+			mlspatch.Merge(patched, in.MlsListing, fields)
+			if err := mlsvalidation.ValidatePatchedListing(listingFromDB, patched); err != nil {
+				return nil, err
+			}
+			changesStatus = patched.GetProperty().GetListing().GetStandardStatus() != fromStatus
+			break
+		}
+	}
+
+	now := time.Now()
+	update := append(mlspatch.Update(fields, in.MlsListing,
+		bson.E{Key: "last_change_date", Value: now},
+		bson.E{Key: "property.listing.dates.last_change_date", Value: now},
+	), bson.E{Key: "$inc", Value: bson.D{{Key: "revision", Value: 1}}})
+	if mlspatch.Touches(fields, derived.GisPath) {
+		patched := proto.Clone(listingFromDB).(*pb.MlsListing)
+		mlspatch.Merge(patched, in.MlsListing, fields)
+		update = derived.WithGeoLocation(update, patched.GetProperty().GetLocation().GetGis())
+	}
+
+	// patches expecting a version fail when a concurrent write changed the listing since it was read.
+	updateFilter := filter
+	if checkVersion {
+		updateFilter = append(expected.filter(), filter...)
+	}
+	// status changes are written only from the status they were validated from.
+	if changesStatus {
+		updateFilter = append(primitive.D{statusFilter(fromStatus)}, updateFilter...)
+	}
+	var patched pb.MlsListing
+	updated := collection.FindOneAndUpdate(ctx, updateFilter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	err = updated.Decode(&patched)
+	if err != nil {
+		if err == mongo.ErrNoDocuments && checkVersion {
+			return nil, changedListingConflict(ctx, collection, filter, in.ListingId, expected)
+		}
+		if err == mongo.ErrNoDocuments && changesStatus {
+			return nil, changedStatusConflict(ctx, collection, filter, in.ListingId, fromStatus)
+		}
+		return nil, listingWriteError(err, in.ListingId, "patch")
+	}
+	setETag(ctx, decodeVersion(updated))
+	return &pb.PatchMlsListingResponse{MlsListing: &patched}, nil
+}
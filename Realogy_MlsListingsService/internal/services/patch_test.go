@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"mlslisting/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestPatchMlsListingInvalid(t *testing.T) {
+	s := &Service{Patch: &config.PatchConfig{Policies: []config.PatchPolicy{
+		{Sources: []string{"SOLO"}, WritableFields: []string{"property"}},
+		{Sources: []string{"*"}, WritableFields: []string{"property.listing.remarks"}},
+	}}}
+	for _, in := range []*pb.PatchMlsListingRequest{
+		{SourceSystemKey: "SOLO", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"property.listing.remarks"}}},
+		{ListingId: "100018", SourceSystemKey: "SOLO"},
+		{ListingId: "100018", SourceSystemKey: "SOLO", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"property.listing.listing_id"}}},
+		{ListingId: "100018", SourceSystemKey: "SOLO", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"property.listing.remarks"}}, ExpectedVersion: "W/\"1\""},
+	} {
+		_, err := s.PatchMlsListing(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+
+	// feed listings only allow the fields of the "*" policy.
+	_, err := s.PatchMlsListing(context.Background(), &pb.PatchMlsListingRequest{
+		ListingId: "100018", SourceSystemKey: "KY_WKRMLS", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"property.listing.price.list_price"}},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "Field property.listing.price.list_price of listings of KY_WKRMLS is not writable.", status.Convert(err).Message())
+}
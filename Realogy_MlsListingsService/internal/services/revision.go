@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ifMatchHeader is the metadata of the ETag an update expects the listing to have, forwarded from the If-Match http header.
+	ifMatchHeader = "if-match"
+	// etagHeader is the response metadata of the ETag of a listing, sent as the ETag http header.
+	etagHeader = "etag"
+)
+
+// listingVersion is the version of a listing document: its revision, incremented by the writes of this service, and its last
+// change date, which the writes of the feeds also change.
+type listingVersion struct {
+	Revision       int64     `bson:"revision"`
+	LastChangeDate time.Time `bson:"last_change_date"`
+}
+
+// decodeVersion returns the version of the listing document of a find result or cursor.
+func decodeVersion(document interface{ Decode(interface{}) error }) listingVersion {
+	var version listingVersion
+	if err := document.Decode(&version); err != nil {
+		log.Debugf("Unable to decode the listing version: %v", err)
+	}
+	return version
+}
+
+// lastChange returns the last change date of the version in unix milliseconds, the precision of document dates, 0 without one.
+func (v listingVersion) lastChange() int64 {
+	if v.LastChangeDate.IsZero() {
+		return 0
+	}
+	return v.LastChangeDate.UnixMilli()
+}
+
+// etag returns the ETag of the version, e.g. "3-1696118400000" with its quotes.
+func (v listingVersion) etag() string {
+	return strconv.Quote(fmt.Sprintf("%d-%d", v.Revision, v.lastChange()))
+}
+
+// expectedVersion is the version an update expects the listing to have, a revision or the version of an ETag.
+// A revision alone does not detect the writes of the feeds, they do not increment it.
+type expectedVersion struct {
+	revision int64
+	// lastChange is the last change date of the ETag in unix milliseconds, unchecked for a revision alone.
+	lastChange int64
+	etag       bool
+}
+
+// parseExpectedVersion returns the version an update expects the listing to have, from its expected version or else the
+// If-Match header. It is false when the update applies to any version, without either or with If-Match "*".
+func parseExpectedVersion(ctx context.Context, version string) (expectedVersion, bool, error) {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ifMatchHeader); len(values) > 0 {
+				version = strings.TrimSpace(strings.Join(values, ","))
+			}
+		}
+	}
+	if version == "" || version == "*" {
+		return expectedVersion{}, false, nil
+	}
+	if strings.HasPrefix(version, "W/") {
+		return expectedVersion{}, false, fmt.Errorf("expected version %s is a weak ETag, updates need a strong one", version)
+	}
+	if len(version) > 1 && strings.HasPrefix(version, `"`) && strings.HasSuffix(version, `"`) {
+		version = version[1 : len(version)-1]
+	}
+	var expected expectedVersion
+	revision, lastChange, isETag := strings.Cut(version, "-")
+	var err error
+	expected.revision, err = strconv.ParseInt(revision, 10, 64)
+	if err == nil && isETag {
+		expected.lastChange, err = strconv.ParseInt(lastChange, 10, 64)
+		expected.etag = true
+	}
+	if err != nil || expected.revision < 0 || expected.lastChange < 0 {
+		return expectedVersion{}, false, fmt.Errorf("expected version %q is not a listing revision or its ETag", version)
+	}
+	return expected, true, nil
+}
+
+// matches returns whether a listing has the expected version.
+func (e expectedVersion) matches(v listingVersion) bool {
+	return e.revision == v.Revision && (!e.etag || e.lastChange == v.lastChange())
+}
+
+// String returns the expected version in the form it was sent, without quotes.
+func (e expectedVersion) String() string {
+	if e.etag {
+		return fmt.Sprintf("%d-%d", e.revision, e.lastChange)
+	}
+	return strconv.FormatInt(e.revision, 10)
+}
+
+// filter returns the conditions of the listing documents at the expected version.
+func (e expectedVersion) filter() primitive.D {
+	conditions := primitive.D{revisionFilter(e.revision)}
+	if e.etag {
+		conditions = append(conditions, lastChangeFilter(e.lastChange))
+	}
+	return conditions
+}
+
+// revisionFilter matches listing documents at a revision, documents written before revisions are at revision 0.
+func revisionFilter(revision int64) bson.E {
+	if revision == 0 {
+		return bson.E{Key: "revision", Value: bson.M{"$in": bson.A{nil, 0}}}
+	}
+	return bson.E{Key: "revision", Value: revision}
+}
+
+// lastChangeFilter matches listing documents last changed at a date in unix milliseconds, 0 for documents without one.
+func lastChangeFilter(lastChange int64) bson.E {
+	if lastChange == 0 {
+		return bson.E{Key: "last_change_date", Value: bson.M{"$in": bson.A{nil}}}
+	}
+	return bson.E{Key: "last_change_date", Value: time.UnixMilli(lastChange).UTC()}
+}
+
+// setETag sends the ETag of a listing version in the response header.
+func setETag(ctx context.Context, version listingVersion) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagHeader, version.etag())); err != nil {
+		log.Debugf("Unable to set the ETag header: %v", err)
+	}
+}
+
+// versionConflict returns the error of an update expecting another version than the current one of the listing.
+// It is sent with the ETag of the current version, which the gateway answers with 412 Precondition Failed.
+func versionConflict(ctx context.Context, listingId string, expected expectedVersion, current listingVersion) error {
+	setETag(ctx, current)
+	var msg string
+	if expected.etag {
+		msg = fmt.Sprintf("Listing %s has changed, its ETag is %s and not the expected %s.", listingId, current.etag(), strconv.Quote(expected.String()))
+	} else {
+		msg = fmt.Sprintf("Listing %s has changed, its revision is %d and not the expected %d.", listingId, current.Revision, expected.revision)
+	}
+	log.Errorf(msg)
+	return status.Errorf(codes.FailedPrecondition, msg)
+}
+
+// changedListingConflict returns the error of an update whose listing was changed by a concurrent write after it was read.
+func changedListingConflict(ctx context.Context, collection *mongo.Collection, filter primitive.D, listingId string, expected expectedVersion) error {
+	result := collection.FindOne(ctx, filter, options.FindOne().SetProjection(bson.M{"revision": 1, "last_change_date": 1}))
+	if err := result.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			msg := fmt.Sprintf("Unable to find Realogy Listing for given listingID %s", listingId)
+			log.Errorf(msg)
+			return status.Errorf(codes.NotFound, msg)
+		}
+		msg := fmt.Sprintf("error updating doc %v", listingId)
+		log.Errorf("%v: %v", msg, err)
+		return status.Error(codes.Internal, msg)
+	}
+	return versionConflict(ctx, listingId, expected, decodeVersion(result))
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseExpectedVersion(t *testing.T) {
+	for version, expected := range map[string]expectedVersion{
+		"3":                 {revision: 3},
+		`"3"`:               {revision: 3},
+		" 0 ":               {revision: 0},
+		`"3-1696118400000"`: {revision: 3, lastChange: 1696118400000, etag: true},
+		"0-0":               {revision: 0, lastChange: 0, etag: true},
+	} {
+		parsed, ok, err := parseExpectedVersion(context.Background(), version)
+		assert.Nil(t, err, version)
+		assert.True(t, ok, version)
+		assert.Equal(t, expected, parsed, version)
+	}
+
+	// the If-Match header is read when the request has no expected version.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, `"7-1696118400000"`))
+	parsed, ok, err := parseExpectedVersion(ctx, "")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, expectedVersion{revision: 7, lastChange: 1696118400000, etag: true}, parsed)
+	parsed, _, _ = parseExpectedVersion(ctx, "2")
+	assert.Equal(t, expectedVersion{revision: 2}, parsed)
+
+	for _, ctx := range []context.Context{context.Background(), metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, "*"))} {
+		_, ok, err := parseExpectedVersion(ctx, "")
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	}
+
+	for _, version := range []string{`W/"3"`, "-1", "abc", `"3", "4"`, "3-", "3-abc", "3--1"} {
+		_, _, err := parseExpectedVersion(context.Background(), version)
+		assert.NotNil(t, err, version)
+	}
+}
+
+func TestExpectedVersionMatches(t *testing.T) {
+	changed := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	current := listingVersion{Revision: 3, LastChangeDate: changed}
+	assert.Equal(t, `"3-`+strconv.FormatInt(changed.UnixMilli(), 10)+`"`, current.etag())
+	assert.Equal(t, `"0-0"`, listingVersion{}.etag())
+
+	etag, _, _ := parseExpectedVersion(context.Background(), current.etag())
+	assert.True(t, etag.matches(current))
+	// feeds change the last change date without incrementing the revision, only the ETag detects their writes.
+	fed := listingVersion{Revision: 3, LastChangeDate: changed.Add(time.Minute)}
+	assert.False(t, etag.matches(fed))
+	assert.True(t, expectedVersion{revision: 3}.matches(fed))
+	assert.False(t, expectedVersion{revision: 2}.matches(fed))
+}
+
+func TestExpectedVersionFilter(t *testing.T) {
+	assert.Equal(t, primitive.D{{Key: "revision", Value: int64(4)}}, expectedVersion{revision: 4}.filter())
+	assert.Equal(t, primitive.D{
+		{Key: "revision", Value: int64(4)},
+		{Key: "last_change_date", Value: time.UnixMilli(1696118400000).UTC()},
+	}, expectedVersion{revision: 4, lastChange: 1696118400000, etag: true}.filter())
+	// documents written before revisions or without a last change date have none.
+	assert.Equal(t, primitive.D{
+		{Key: "revision", Value: bson.M{"$in": bson.A{nil, 0}}},
+		{Key: "last_change_date", Value: bson.M{"$in": bson.A{nil}}},
+	}, expectedVersion{etag: true}.filter())
+}
+
+func TestVersionConflict(t *testing.T) {
+	current := listingVersion{Revision: 3, LastChangeDate: time.UnixMilli(1696118400000)}
+	err := versionConflict(context.Background(), "100018", expectedVersion{revision: 2}, current)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "Listing 100018 has changed, its revision is 3 and not the expected 2.", status.Convert(err).Message())
+
+	err = versionConflict(context.Background(), "100018", expectedVersion{revision: 3, lastChange: 1696118340000, etag: true}, current)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, `Listing 100018 has changed, its ETag is "3-1696118400000" and not the expected "3-1696118340000".`, status.Convert(err).Message())
+}
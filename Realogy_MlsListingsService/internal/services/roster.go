@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/roster"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// ListAgents returns the agents of a source from the roster kept by roster.Builder.
+func (s *Service) ListAgents(ctx context.Context, in *pb.ListAgentsRequest) (*pb.ListAgentsResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listAgents")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	filter := rosterFilter(in.SourceSystemKey, in.MasterId, in.ActiveOnly)
+	if in.OfficeMlsId != "" {
+		filter = append(filter, bson.E{Key: "office_mls_id", Value: in.OfficeMlsId})
+	}
+	limit := s.getLimit(in.Limit)
+	agents := []*pb.RosterAgent{}
+	if err := s.findRoster(ctx, s.AgentsCollection, filter, "full_name", in.Offset, limit, &agents); err != nil {
+		log.Errorf("Unable to find the agents of %s. %v", in.SourceSystemKey, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find the agents of %s.", in.SourceSystemKey)
+	}
+
+	hasMore := int64(len(agents)) > limit
+	if hasMore {
+		agents = agents[:limit]
+	}
+	if len(agents) == 0 {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find agents for %s", in))
+	}
+	return &pb.ListAgentsResponse{
+		Agents:   agents,
+		PageInfo: &pb.PageInfo{Limit: int32(limit), Offset: in.Offset, HasMore: hasMore},
+	}, nil
+}
+
+// GetAgent returns an agent of the roster by its mls id within a source, or by its master id.
+func (s *Service) GetAgent(ctx context.Context, in *pb.GetAgentRequest) (*pb.GetAgentResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/getAgent")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.When(in.MasterId == "", validation.Required)),
+		"MlsId":           validation.Validate(in.MlsId, validation.When(in.MasterId == "", validation.Required)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	var filter bson.D
+	if in.SourceSystemKey != "" && in.MlsId != "" {
+		filter = bson.D{{Key: "_id", Value: roster.Id(in.SourceSystemKey, in.MlsId)}}
+	} else {
+		filter = rosterFilter(in.SourceSystemKey, in.MasterId, false)
+	}
+	// an agent with the same master id in several sources is taken from its most recently active one.
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "last_activity_time", Value: -1}, {Key: "_id", Value: 1}})
+
+	agent := &pb.RosterAgent{}
+	err = s.MongoDatabase.Collection(s.AgentsCollection).FindOne(ctx, filter, findOptions).Decode(agent)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find agent for %s", in))
+	}
+	if err != nil {
+		log.Errorf("Unable to find agent %s. %v", in, err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Unable to find agent for %s", in))
+	}
+	return &pb.GetAgentResponse{Agent: agent}, nil
+}
+
+// ListOffices returns the offices of a source from the roster kept by roster.Builder.
+func (s *Service) ListOffices(ctx context.Context, in *pb.ListOfficesRequest) (*pb.ListOfficesResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listOffices")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	limit := s.getLimit(in.Limit)
+	offices := []*pb.RosterOffice{}
+	if err := s.findRoster(ctx, s.OfficesCollection, rosterFilter(in.SourceSystemKey, in.MasterId, in.ActiveOnly), "name", in.Offset, limit, &offices); err != nil {
+		log.Errorf("Unable to find the offices of %s. %v", in.SourceSystemKey, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find the offices of %s.", in.SourceSystemKey)
+	}
+
+	hasMore := int64(len(offices)) > limit
+	if hasMore {
+		offices = offices[:limit]
+	}
+	if len(offices) == 0 {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find offices for %s", in))
+	}
+	return &pb.ListOfficesResponse{
+		Offices:  offices,
+		PageInfo: &pb.PageInfo{Limit: int32(limit), Offset: in.Offset, HasMore: hasMore},
+	}, nil
+}
+
+// rosterFilter filters the members of a roster by source, master id and active listings, each when set.
+func rosterFilter(sourceSystemKey string, masterId string, activeOnly bool) bson.D {
+	filter := bson.D{}
+	if sourceSystemKey != "" {
+		filter = append(filter, bson.E{Key: "source_system_key", Value: sourceSystemKey})
+	}
+	if masterId != "" {
+		filter = append(filter, bson.E{Key: "master_id", Value: masterId})
+	}
+	if activeOnly {
+		filter = append(filter, bson.E{Key: "active_listing_count", Value: bson.D{{Key: "$gt", Value: 0}}})
+	}
+	return filter
+}
+
+// findRoster reads a page of roster members ordered by name, with one more than the limit to tell whether there is a next page.
+func (s *Service) findRoster(ctx context.Context, collection string, filter bson.D, name string, offset int32, limit int64, results interface{}) error {
+	findOptions := s.findOptions(int32(limit), offset).
+		SetLimit(limit + 1).
+		SetSort(bson.D{{Key: name, Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := s.MongoDatabase.Collection(collection).Find(ctx, filter, findOptions)
+	if err != nil {
+		return err
+	}
+	return cursor.All(ctx, results)
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestRosterInvalid(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.ListAgents(context.Background(), &pb.ListAgentsRequest{OfficeMlsId: "OF1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListOffices(context.Background(), &pb.ListOfficesRequest{MasterId: "M1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for _, in := range []*pb.GetAgentRequest{
+		{},
+		{SourceSystemKey: "CRMLS"},
+		{MlsId: "AG1"},
+	} {
+		_, err := s.GetAgent(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
+
+func TestRosterFilter(t *testing.T) {
+	assert.Equal(t, bson.D{{Key: "source_system_key", Value: "CRMLS"}}, rosterFilter("CRMLS", "", false))
+	assert.Equal(t, bson.D{
+		{Key: "source_system_key", Value: "CRMLS"},
+		{Key: "master_id", Value: "M1"},
+		{Key: "active_listing_count", Value: bson.D{{Key: "$gt", Value: 0}}},
+	}, rosterFilter("CRMLS", "M1", true))
+	assert.Equal(t, bson.D{{Key: "master_id", Value: "M1"}}, rosterFilter("", "M1", false))
+}
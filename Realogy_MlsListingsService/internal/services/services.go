@@ -3,7 +3,13 @@ package services
 import (
 	"context"
 	"mlslisting/internal/config"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/eventhub"
+	"mlslisting/internal/mlsfilter"
+	"mlslisting/internal/mlsprojection"
 	"mlslisting/internal/mlsvalidation"
+	"mlslisting/internal/subscription"
+	"mlslisting/internal/tombstone"
 	"mlslisting/internal/transformer"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,13 +40,27 @@ import (
 
 type Service struct {
 	pb.MlsListingServiceServer
-	MongoDatabase      *mongo.Database
-	ListingsCollection string
-	MaxQueryTimeSecs   int
-	Pagination         *config.PaginationConfig
-	Stream             *config.StreamConfig
-	BySource           *config.BySource
-	ByAddress          *config.ByAddress
+	MongoDatabase                *mongo.Database
+	ListingsCollection           string
+	HistoryCollection            string
+	SubscriptionsCollection      string
+	WebhooksCollection           string
+	WebhookDeadLettersCollection string
+	AgentsCollection             string
+	OfficesCollection            string
+	MaxQueryTimeSecs             int
+	Pagination                   *config.PaginationConfig
+	Stream                       *config.StreamConfig
+	BySource                     *config.BySource
+	ByAddress                    *config.ByAddress
+	Webhooks                     *config.WebhooksConfig
+	Bulk                         *config.BulkConfig
+	Export                       *config.ExportConfig
+	Patch                        *config.PatchConfig
+	Comps                        *config.CompsConfig
+	Dedup                        *config.DedupConfig
+	// shared change streams of StreamMlsListingEvent, nil when each stream watches the listings itself.
+	Hub *eventhub.Hub
 }
 
 type MlsEventResponse struct {
@@ -89,10 +109,21 @@ func (s *Service) GetMlsListingByListingId(ctx context.Context, in *pb.GetMlsLis
 	if in.PostalCode != "" {
 		pipeline = append(pipeline, bson.E{Key: "property.location.address.postal_code", Value: bson.M{"$eq": in.PostalCode}})
 	}
+	pipeline = append(pipeline, tombstone.NotDeleted())
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetCollation(&options.Collation{Locale: "en", Strength: 2}) // case insensitive search
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		// the revision and last change date are read for the ETag.
+		findOptions.SetProjection(mlsprojection.Include(projection, "revision", "last_change_date"))
+	}
 
 	cur, err := mongoCollection.Find(ctx, &pipeline, findOptions)
 	if err != nil {
@@ -100,6 +131,7 @@ func (s *Service) GetMlsListingByListingId(ctx context.Context, in *pb.GetMlsLis
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
 	}
 	// iterate mongo cursor and create response
+	var version listingVersion
 	for cur.Next(ctx) {
 		var result pb.MlsListing
 		err := cur.Decode(&result)
@@ -107,6 +139,7 @@ func (s *Service) GetMlsListingByListingId(ctx context.Context, in *pb.GetMlsLis
 			// incase of error, log and process next item TODO: Metrics for failed items
 			log.Errorf("Unable to decode the document: %v", err)
 		}
+		version = decodeVersion(cur)
 		response.MlsListings = append(response.MlsListings, &result)
 	}
 
@@ -114,6 +147,9 @@ func (s *Service) GetMlsListingByListingId(ctx context.Context, in *pb.GetMlsLis
 		log.Errorf("Unable to find mls listings for %s", in.ListingId)
 		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find mls listings for %s", in))
 	}
+	if len(response.MlsListings) == 1 {
+		setETag(ctx, version)
+	}
 	return response, nil
 }
 
@@ -127,6 +163,11 @@ func (s *Service) UpdateMlsListingByListingId(ctx context.Context, in *pb.Update
 		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
 	}.Filter()
 
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	expected, checkVersion, err := parseExpectedVersion(ctx, in.ExpectedVersion)
 	if err != nil {
 		log.Errorf("Validation Error. %v", err)
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
@@ -136,9 +177,11 @@ func (s *Service) UpdateMlsListingByListingId(ctx context.Context, in *pb.Update
 	var filter primitive.D
 	filter = append(filter, bson.E{Key: "listing_id", Value: in.ListingId})
 	filter = append(filter, bson.E{Key: "source_system_key", Value: in.SourceSystemKey})
+	filter = append(filter, tombstone.NotDeleted())
 
 	listingFromDB := &pb.MlsListing{}
-	if err := mongoCollection.FindOne(context.TODO(), filter, &options.FindOneOptions{}).Decode(listingFromDB); err != nil {
+	found := mongoCollection.FindOne(context.TODO(), filter, &options.FindOneOptions{})
+	if err := found.Decode(listingFromDB); err != nil {
 		if err == mongo.ErrNoDocuments {
 			msg := fmt.Sprintf("Unable to find Realogy Listing for given listingID %s", in.ListingId)
 			log.Errorf(msg)
@@ -150,6 +193,10 @@ func (s *Service) UpdateMlsListingByListingId(ctx context.Context, in *pb.Update
 		}
 	}
 
+	if current := decodeVersion(found); checkVersion && !expected.matches(current) {
+		return nil, versionConflict(ctx, in.ListingId, expected, current)
+	}
+
 	// validate business rules for updating a listing
 	err = mlsvalidation.ValidateUpdateListing(in, listingFromDB)
 	if err != nil {
@@ -181,14 +228,33 @@ func (s *Service) UpdateMlsListingByListingId(ctx context.Context, in *pb.Update
 
 	update := bson.M{
 		"$set": flattenedBson,
+		"$inc": bson.M{"revision": 1},
 	}
 	after := options.After
 	findOneAndUpdateOptions := options.FindOneAndUpdateOptions{
 		ReturnDocument: &after,
 	}
 
-	err = mongoCollection.FindOneAndUpdate(ctx, filter, update, &findOneAndUpdateOptions).Decode(&listingFromDB)
+	// updates expecting a version fail when a concurrent write changed the listing since it was read.
+	updateFilter := filter
+	if checkVersion {
+		updateFilter = append(expected.filter(), filter...)
+	}
+	// status changes are written only from the status they were validated from.
+	fromStatus := listingFromDB.GetProperty().GetListing().GetStandardStatus()
+	changesStatus := in.Property.GetListing().GetStandardStatus() != "" && in.Property.GetListing().GetStandardStatus() != fromStatus
+	if changesStatus {
+		updateFilter = append(primitive.D{statusFilter(fromStatus)}, updateFilter...)
+	}
+	updated := mongoCollection.FindOneAndUpdate(ctx, updateFilter, update, &findOneAndUpdateOptions)
+	err = updated.Decode(&listingFromDB)
 	if err != nil {
+		if err == mongo.ErrNoDocuments && checkVersion {
+			return nil, changedListingConflict(ctx, mongoCollection, filter, in.ListingId, expected)
+		}
+		if err == mongo.ErrNoDocuments && changesStatus {
+			return nil, changedStatusConflict(ctx, mongoCollection, filter, in.ListingId, fromStatus)
+		}
 		if err == mongo.ErrNoDocuments {
 			msg := fmt.Sprintf("Unable to find Realogy Listing for given listingID %s", in.ListingId)
 			log.Errorf("no documents matched to update %v", err)
@@ -197,6 +263,7 @@ func (s *Service) UpdateMlsListingByListingId(ctx context.Context, in *pb.Update
 		msg := fmt.Sprintf("error updating doc %v", in.ListingId)
 		return nil, status.Error(codes.Internal, msg)
 	}
+	setETag(ctx, decodeVersion(updated))
 	resp := pb.UpdateMlsListingByListingIdResponse{
 		MlsListings: listingFromDB,
 	}
@@ -216,38 +283,13 @@ func (s *Service) AddMlsListings(ctx context.Context, in *pb.MlsListingInput) (*
 		log.Printf("request has been made by %s to insert mls listing", md.Get("apikey"))
 	}
 
-	// validations for request attributes.
-	err := validation.Errors{
-		"ListingId":          validation.Validate(in.ListingId, validation.Required),
-		"RdmSourceSystemKey": validation.Validate(in.RdmSourceSystemKey, validation.Required, validation.In("SOLO", "ELL", "LC")),
-		"StandardStatus":     validation.Validate(in.Property.Listing.StandardStatus, validation.Required, validation.In("ACTIVE", "INACTIVE", "SOLD", "CANCELED", "HOLD", "UNKNOWN", "EXPIRED", "TEMP", "TERMINATED", "PENDING", "WITHDRAWN")),
-		"PropertyType":       validation.Validate(in.Property.PropertyType, validation.Required, validation.In("SFR", "MFD", "CONDO", "COOP", "TOWNHOUSE", "MFR", "LAND", "FARM", "RENTAL", "COMMERCIAL_SALE", "COMMERCIAL_LEASE")),
-		"PriceInput":         validation.Validate(in.Property.Listing.Price, validation.Required),
-	}.Filter()
-	if err != nil {
-		log.Errorf("Validation Error. %v", err)
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
-	}
-	in.Property.Listing.ListingId = in.ListingId
-
-	// validate business rules for a new listing
-	err = mlsvalidation.ValidateInsertListing(in)
+	err := validateListingInput(in)
 	if err != nil {
 		return nil, err
 	}
 
-	mlsListing := transformer.TransformListingInputToMlsListing(in)
-	doc := bson.D{
-		{Key: "_id", Value: in.RdmSourceSystemKey + "_" + in.ListingId},
-		{Key: "listing_id", Value: in.ListingId},
-		{Key: "rdm_source_system_Key", Value: in.RdmSourceSystemKey},
-		{Key: "property", Value: mlsListing.Property},
-		{Key: "last_changed_date", Value: time.Now()},
-		// Restrict the POST endpoint to only ELL, SOLO & LC sources. Since these are
-		// internal sources, for internal sources, the rdm_source_system_key and source_system_key is same
-		{Key: "source_system_key", Value: in.RdmSourceSystemKey},
-		{Key: "inserted_by", Value: insertedBy},
-	}
+	doc := append(bson.D{{Key: "_id", Value: listingDocumentId(in)}}, listingDocument(in)...)
+	doc = append(doc, bson.E{Key: "inserted_by", Value: insertedBy}, bson.E{Key: "revision", Value: 1})
 
 	var d pb.MlsListing
 
@@ -269,6 +311,50 @@ func (s *Service) AddMlsListings(ctx context.Context, in *pb.MlsListingInput) (*
 	return &pb.AddListingsResponse{MlsListings: &d}, nil
 }
 
+// validateListingInput validates a listing to create, the attributes of the request and the business rules of a new listing.
+func validateListingInput(in *pb.MlsListingInput) error {
+	if in.Property == nil || in.Property.Listing == nil || in.Property.Location == nil || in.Property.Location.Address == nil {
+		log.Errorf("Validation Error. Property, listing and address are required.")
+		return status.Errorf(codes.InvalidArgument, "Invalid input. Property: listing and location address cannot be blank.")
+	}
+
+	// validations for request attributes.
+	err := validation.Errors{
+		"ListingId":          validation.Validate(in.ListingId, validation.Required),
+		"RdmSourceSystemKey": validation.Validate(in.RdmSourceSystemKey, validation.Required, validation.In(internalSources...)),
+		"StandardStatus":     validation.Validate(in.Property.Listing.StandardStatus, validation.Required, validation.In("ACTIVE", "INACTIVE", "SOLD", "CANCELED", "HOLD", "UNKNOWN", "EXPIRED", "TEMP", "TERMINATED", "PENDING", "WITHDRAWN")),
+		"PropertyType":       validation.Validate(in.Property.PropertyType, validation.Required, validation.In("SFR", "MFD", "CONDO", "COOP", "TOWNHOUSE", "MFR", "LAND", "FARM", "RENTAL", "COMMERCIAL_SALE", "COMMERCIAL_LEASE")),
+		"PriceInput":         validation.Validate(in.Property.Listing.Price, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	in.Property.Listing.ListingId = in.ListingId
+
+	// validate business rules for a new listing
+	return mlsvalidation.ValidateInsertListing(in)
+}
+
+func listingDocumentId(in *pb.MlsListingInput) string {
+	return in.RdmSourceSystemKey + "_" + in.ListingId
+}
+
+// listingDocument returns the fields of the listing document of a validated listing input, other than its id. Listing inputs
+// have no gis coordinates, the document has no geo location.
+func listingDocument(in *pb.MlsListingInput) bson.D {
+	mlsListing := transformer.TransformListingInputToMlsListing(in)
+	return bson.D{
+		{Key: "listing_id", Value: in.ListingId},
+		{Key: "rdm_source_system_Key", Value: in.RdmSourceSystemKey},
+		{Key: "property", Value: mlsListing.Property},
+		{Key: "last_change_date", Value: time.Now()},
+		// Restrict the POST endpoint to only ELL, SOLO & LC sources. Since these are
+		// internal sources, for internal sources, the rdm_source_system_key and source_system_key is same
+		{Key: "source_system_key", Value: in.RdmSourceSystemKey},
+	}
+}
+
 func (s *Service) GetMlsListingByListingGuid(ctx context.Context, in *pb.GetMlsListingByListingGuidRequest) (*pb.GetMlsListingByListingGuidResponse, error) {
 	err := validation.Errors{
 		"ListingGuid": validation.Validate(in.ListingGuid, validation.Required),
@@ -290,10 +376,20 @@ func (s *Service) GetMlsListingByListingGuid(ctx context.Context, in *pb.GetMlsL
 	} else {
 		filter = bson.M{"dash.listing_guid": in.ListingGuid}
 	}
+	filter[tombstone.DeletedField] = tombstone.NotDeleted().Value
+
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
 
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
 	cur, err := mongoCollection.Find(ctx, &filter, findOptions)
 	if err != nil {
@@ -331,35 +427,32 @@ func (s *Service) GetMlsListingsByCity(ctx context.Context, in *pb.GetMlsListing
 
 	response := &pb.GetMlsListingsByCityResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	if in.State != "" {
 		pipeline = append(pipeline, bson.E{Key: "property.location.address.city", Value: in.City}, bson.E{Key: "property.location.address.state_or_province", Value: in.State})
 	} else {
 		pipeline = append(pipeline, bson.E{Key: "property.location.address.city", Value: in.City})
 	}
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	findOptions := s.findOptions(in.Limit, in.Offset)
-	findOptions.SetCollation(&options.Collation{Locale: "en", Strength: 2}) // index with collation should exists.
-	cur, err := mongoCollection.Find(ctx, &pipeline, findOptions)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+		collation: &options.Collation{Locale: "en", Strength: 2},
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -380,29 +473,27 @@ func (s *Service) GetMlsListingsByState(ctx context.Context, in *pb.GetMlsListin
 
 	response := &pb.GetMlsListingsByStateResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.location.address.state_or_province", Value: in.State})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -423,29 +514,27 @@ func (s *Service) GetMlsListingsByPostalCode(ctx context.Context, in *pb.GetMlsL
 
 	response := &pb.GetMlsListingsByPostalCodeResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.location.address.postal_code", Value: in.PostalCode})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. // TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in.PostalCode)
@@ -466,9 +555,6 @@ func (s *Service) GetMlsListingBySource(ctx context.Context, in *pb.GetMlsListin
 
 	response := &pb.GetMlsListingsBySourceResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	//Comma separated source system key
 	sanitizedSourceSystemKey := strings.ReplaceAll(in.SourceSystemKey, " ", "")
@@ -512,24 +598,25 @@ func (s *Service) GetMlsListingBySource(ctx context.Context, in *pb.GetMlsListin
 		pipeline = append(pipeline, bson.E{Key: "master_id.company_master_id", Value: in.CompanyMasterId})
 	}
 
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in.SourceSystemKey)
@@ -550,32 +637,30 @@ func (s *Service) GetMlsListingsByAgentId(ctx context.Context, in *pb.GetMlsList
 
 	response := &pb.GetMlsListingsByAgentIdResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.listing.agent_office.list_agent.list_agent_mls_id", Value: in.ListAgentMlsId})
 	if in.SourceSystemKey != "" {
 		pipeline = append(pipeline, bson.E{Key: "source_system_key", Value: in.SourceSystemKey})
 	}
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -596,31 +681,28 @@ func (s *Service) GetMlsListingsByAgentMasterId(ctx context.Context, in *pb.GetM
 
 	response := &pb.GetMlsListingsByAgentMasterIdResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "master_id.list_agent_master_id", Value: in.ListAgentMasterId})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	findOptions := s.findOptions(in.Limit, in.Offset)
-	findOptions.SetCollation(&options.Collation{Locale: "en", Strength: 2})
-	cur, err := mongoCollection.Find(ctx, &pipeline, findOptions)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+		collation: &options.Collation{Locale: "en", Strength: 2},
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -641,29 +723,27 @@ func (s *Service) GetMlsListingsByAgentGuid(ctx context.Context, in *pb.GetMlsLi
 
 	response := &pb.GetMlsListingsByAgentGuidResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "dash.listing_agent_guid", Value: in.ListingAgentGuid})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -686,9 +766,6 @@ func (s *Service) GetMlsListingsByAddress(ctx context.Context, in *pb.GetMlsList
 	log.Debugf("Listings for address: %s", in)
 	response := &pb.GetMlsListingsByAddressResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	searchQuery := "property.location.address.unparsed_address:\"" + in.UnparsedAddress + "\""
 
 	if in.City != "" {
@@ -701,37 +778,27 @@ func (s *Service) GetMlsListingsByAddress(ctx context.Context, in *pb.GetMlsList
 		searchQuery = searchQuery + " AND property.location.address.postal_code:\"" + in.PostalCode + "\""
 	}
 
-	searchPipeline := bson.A{
-		bson.D{{"$search",
-			bson.D{
-				bson.E{Key: "index", Value: s.ByAddress.SearchIndex},
-				bson.E{Key: "queryString",
-					Value: bson.D{
-						{"defaultPath", "property.location.address.unparsed_address"},
-						{"query", searchQuery},
-					},
-				}},
-		}},
+	search := bson.D{
+		bson.E{Key: "index", Value: s.ByAddress.SearchIndex},
+		bson.E{Key: "queryString",
+			Value: bson.D{
+				{"defaultPath", "property.location.address.unparsed_address"},
+				{"query", searchQuery},
+			},
+		},
 	}
 
-	opts := options.Aggregate()
-	cur, err := mongoCollection.Aggregate(ctx, searchPipeline, opts)
-
+	page, err := s.searchListings(ctx, search, listQuery{
+		limit:     in.Limit,
+		offset:    in.Offset,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
-	}
-
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+		return nil, err
 	}
+	response.MlsListings, response.PageInfo = page.listings, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -752,28 +819,22 @@ func (s *Service) GetMlsListingsBySubdivision(ctx context.Context, in *pb.GetMls
 
 	response := &pb.GetMlsListingsBySubdivisionResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.location.area.subdivision_name", Value: in.SubdivisionName})
 
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
-	}
-
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -794,31 +855,28 @@ func (s *Service) GetMlsListingsByOfficeMasterId(ctx context.Context, in *pb.Get
 
 	response := &pb.GetMlsListingsByOfficeMasterIdResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "master_id.list_office_master_id", Value: in.ListOfficeMasterId})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	findOptions := s.findOptions(in.Limit, in.Offset)
-	findOptions.SetCollation(&options.Collation{Locale: "en", Strength: 2})
-	cur, err := mongoCollection.Find(ctx, &pipeline, findOptions)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+		collation: &options.Collation{Locale: "en", Strength: 2},
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -839,31 +897,28 @@ func (s *Service) GetMlsListingsByCompanyMasterId(ctx context.Context, in *pb.Ge
 
 	response := &pb.GetMlsListingsByCompanyMasterIdResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "master_id.company_master_id", Value: in.CompanyMasterId})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	findOptions := s.findOptions(in.Limit, in.Offset)
-	findOptions.SetCollation(&options.Collation{Locale: "en", Strength: 2})
-	cur, err := mongoCollection.Find(ctx, &pipeline, findOptions)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+		collation: &options.Collation{Locale: "en", Strength: 2},
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -884,29 +939,27 @@ func (s *Service) GetMlsListingsByCompanyStaffId(ctx context.Context, in *pb.Get
 
 	response := &pb.GetMlsListingsByCompanyStaffIdResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "master_id.company_staff_master_id", Value: in.CompanyStaffMasterId})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -927,29 +980,27 @@ func (s *Service) GetMlsListingsByCompanyStaffGuid(ctx context.Context, in *pb.G
 
 	response := &pb.GetMlsListingsByCompanyStaffGuidResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "dash.company_staff_guid", Value: in.CompanyStaffGuid})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
-
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
 	}
 
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
+	if err != nil {
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls listings for %s", in)
@@ -963,9 +1014,6 @@ func (s *Service) GetMlsSoldListings(ctx context.Context, in *pb.GetMlsSoldListi
 
 	response := &pb.GetMlsSoldListingsResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
 	var pipeline primitive.D
 	pipeline, err := SoldListingsPipeline(in, pipeline)
 
@@ -973,22 +1021,19 @@ func (s *Service) GetMlsSoldListings(ctx context.Context, in *pb.GetMlsSoldListi
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid date range to find sold listings [%s]. %v", in, err.Error()))
 	}
 
-	cur, err := mongoCollection.Find(ctx, &pipeline, s.findOptions(in.Limit, in.Offset))
+	page, err := s.findListings(ctx, listQuery{
+		filter:    pipeline,
+		limit:     in.Limit,
+		offset:    in.Offset,
+		pageToken: in.PageToken,
+		countMode: in.CountMode,
+		sort:      in.Sort,
+		fields:    in.Fields,
+	})
 	if err != nil {
-		log.Errorf("Error while processing the request to search mls: %v", err)
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching mls %s", in))
-	}
-
-	// iterate mongo cursor and create response
-	for cur.Next(ctx) {
-		var result pb.MlsListing
-		err := cur.Decode(&result)
-		if err != nil {
-			// log error and process next item. no need to abort the loop. TODO: Metrics
-			log.Errorf("Unable to decode the document: %v", err)
-		}
-		response.MlsListings = append(response.MlsListings, &result)
+		return nil, err
 	}
+	response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 
 	if len(response.MlsListings) == 0 {
 		log.Errorf("Unable to find mls sold listings for the given date range")
@@ -1016,13 +1061,26 @@ func (s *Service) StreamMlsListingByCity(in *pb.GetMlsListingsByCityRequest, str
 	} else {
 		pipeline = append(pipeline, bson.E{Key: "property.location.address.city", Value: in.City})
 	}
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
 
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cur, err := collection.Find(ctx, pipeline, findOptions)
+	cur, err := collection.Find(ctx, tombstone.Exclude(pipeline), findOptions)
 	defer cur.Close(ctx)
 	if err != nil {
 		log.Errorf("Error while processing the request to search mls: %v", err)
@@ -1057,13 +1115,26 @@ func (s *Service) StreamMlsListingByState(in *pb.GetMlsListingsByStateRequest, s
 
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.location.address.state_or_province", Value: in.State})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
 
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cur, err := collection.Find(ctx, pipeline, findOptions)
+	cur, err := collection.Find(ctx, tombstone.Exclude(pipeline), findOptions)
 	defer cur.Close(ctx)
 
 	if err != nil {
@@ -1100,13 +1171,26 @@ func (s *Service) StreamMlsListingByPostalCode(in *pb.GetMlsListingsByPostalCode
 
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "property.location.address.postal_code", Value: in.PostalCode})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
 
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cur, err := collection.Find(ctx, pipeline, findOptions)
+	cur, err := collection.Find(ctx, tombstone.Exclude(pipeline), findOptions)
 	defer cur.Close(ctx)
 
 	if err != nil {
@@ -1143,13 +1227,26 @@ func (s *Service) StreamMlsListingBySource(in *pb.GetMlsListingsBySourceRequest,
 
 	var pipeline primitive.D
 	pipeline = append(pipeline, bson.E{Key: "source_system_key", Value: in.SourceSystemKey})
-	pipeline = append(pipeline, aggregatePipelineFilter(in.Filter)...)
+	pipeline, err = mlsfilter.Apply(pipeline, in.Filter)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
 
 	// mongodb find options
 	findOptions := options.Find()
 	findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
 
-	cur, err := collection.Find(ctx, pipeline, findOptions)
+	cur, err := collection.Find(ctx, tombstone.Exclude(pipeline), findOptions)
 	defer cur.Close(ctx)
 
 	if err != nil {
@@ -1173,7 +1270,7 @@ func (s *Service) StreamMlsListingBySource(in *pb.GetMlsListingsBySourceRequest,
 
 /*
  API to stream mls events. Clients can either subscribe to events specific to source system key (or) property type (or) operation type (or) combination of these.
- "marker" is passed by the client to resume events in case of failures.
+ "marker" is passed by the client to resume events in case of failures. Named subscriptions are resumed from their checkpoint by the server.
 */
 func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, stream pb.MlsListingService_StreamMlsListingEventServer) error {
 
@@ -1187,6 +1284,14 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 
 	ctx, _ = context.WithDeadline(stream.Context(), time.Now().Add(time.Duration(s.Stream.DeadlineSecs)*time.Second)) //default deadline from config. if "Grpc-Timeout" is set in the header that should override.
 
+	var sub *subscription.Subscription
+	if in.Subscription != "" {
+		var err error
+		if sub, err = s.openSubscription(ctx, in); err != nil {
+			return err
+		}
+	}
+
 	// get mongodb collection
 	collection := s.MongoDatabase.Collection(s.ListingsCollection)
 
@@ -1212,11 +1317,27 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 	}
 	changeStreamPipeline = append(changeStreamPipeline, bson.D{{"$or", operationType}})
 
-	pipeline := mongo.Pipeline{bson.D{{"$match", bson.D{{"$and",
+	// soft deletes and restores are matched as the delete and insert events they stand for.
+	pipeline := mongo.Pipeline{tombstone.ChangeStage(), derived.ChangeStage(), bson.D{{"$match", bson.D{{"$and",
 		changeStreamPipeline,
 	}},
 	}}}
 
+	projection, err := mlsprojection.Compile(in.Fields)
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	if projection != nil {
+		// the event fields are kept, only the listing is projected.
+		projection = append(primitive.D{
+			{Key: "operationType", Value: 1},
+			{Key: "clusterTime", Value: 1},
+			{Key: "documentKey", Value: 1},
+		}, mlsprojection.Prefix(mlsprojection.Include(projection, "last_change_date"), "fullDocument")...)
+		pipeline = append(pipeline, bson.D{{Key: "$project", Value: projection}})
+	}
+
 	var changeStreamOptions options.ChangeStreamOptions
 	changeStreamOptions = *options.ChangeStream().SetFullDocument(options.UpdateLookup)
 	if in.Marker != "" {
@@ -1241,7 +1362,50 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 		}
 	}()
 
-	cs, err := collection.Watch(ctx, pipeline, &changeStreamOptions)
+	var checkpointer *subscription.Checkpointer
+	if sub != nil {
+		store := subscription.NewStore(s.MongoDatabase.Collection(s.SubscriptionsCollection))
+		checkpointer = subscription.NewCheckpointer(store, sub, time.Duration(s.Stream.CheckpointIntervalSecs)*time.Second)
+		defer func() {
+			if err := checkpointer.Flush(context.Background()); err != nil {
+				log.Errorf("Unable to checkpoint subscription %s. %v", sub.Name, err)
+			}
+		}()
+	}
+
+	// a subscription checkpointed while catching up continues its catch-up, it has no marker to resume.
+	catchingUp := sub != nil && in.Marker == "" && in.ChangeStartTime == nil && sub.CatchingUp()
+
+	if s.Hub != nil && in.ChangeStartTime == nil && !catchingUp {
+		subscriber, err := s.Hub.Subscribe(ctx, in.Subscription, pipeline, in.Marker)
+		if err == nil {
+			defer subscriber.Close()
+			return s.sendSharedEvents(ctx, subscriber, checkpointer, stream)
+		}
+		// markers no longer buffered by the hub, and start times, are resumed by a change stream of their own.
+		if err != eventhub.ErrMarkerNotFound {
+			log.Warnf("Unable to subscribe to a shared change stream, watching listings for the stream. %v", err)
+		}
+	}
+
+	var cs *mongo.ChangeStream
+	if !catchingUp {
+		cs, err = collection.Watch(ctx, pipeline, &changeStreamOptions)
+	}
+	if catchingUp || err != nil && sub != nil && !sub.ChangeDate.IsZero() {
+		// the checkpoint is a catch-up marker or is no longer in the oplog, catch up on the listings changed since and continue the change stream from now.
+		if err != nil {
+			log.Warnf("Unable to resume subscription %s. %v", sub.Name, err)
+		}
+		log.Infof("Catching up subscription %s on listings changed since %v", sub.Name, sub.ChangeDate)
+		until := time.Now()
+		if err := s.catchUp(ctx, sub, projection, checkpointer, stream); err != nil {
+			return err
+		}
+		changeStreamOptions.SetResumeAfter(nil)
+		changeStreamOptions.SetStartAtOperationTime(&primitive.Timestamp{T: uint32(until.UTC().Unix())})
+		cs, err = collection.Watch(ctx, pipeline, &changeStreamOptions)
+	}
 	if cs != nil {
 		defer cs.Close(ctx)
 	}
@@ -1263,6 +1427,14 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 			// TODO: Log error. Add Metrics. See if a partial data can be sent otherwise continue to next events.
 			continue
 		}
+		var changed struct {
+			Listing struct {
+				LastChangeDate time.Time `bson:"last_change_date"`
+			} `bson:"fullDocument"`
+		}
+		if err := cs.Decode(&changed); err != nil {
+			log.Errorf("unable to decode the last change date of the listing for event (id: %s). error : %v", *eventResponse.EventData.EventId, err)
+		}
 
 		if &eventResponse != nil {
 			result.MlsListing = eventResponse.MlsListing
@@ -1275,6 +1447,11 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 				log.Errorf("Error while streaming mls listings: %v", err)
 				return err
 			}
+			if checkpointer != nil {
+				if err := checkpointer.Sent(ctx, result.MlsChange.Marker, changed.Listing.LastChangeDate); err != nil {
+					log.Errorf("Unable to checkpoint subscription %s. %v", sub.Name, err)
+				}
+			}
 			log.Debugf("Sending mls [%s] event", result.MlsChange.ChangeType)
 		}
 	}
@@ -1284,37 +1461,6 @@ func (s *Service) StreamMlsListingEvent(in *pb.StreamMlsListingEventRequest, str
 }
 
 // aggregate mongodb pipeline
-func aggregatePipelineFilter(filter *pb.MlsFilter) primitive.D {
-	var pipeline primitive.D
-	if filter != nil {
-		if filter.PropertyType != nil && len(filter.PropertyType) > 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.property_type", Value: bson.M{"$in": filter.PropertyType}})
-		}
-		if filter.StandardStatus != nil && len(filter.StandardStatus) > 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.listing.standard_status", Value: bson.M{"$in": filter.StandardStatus}})
-		}
-		if filter.ArchitectureStyle != nil && len(filter.ArchitectureStyle) > 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.structure.architecture_style", Value: bson.M{"$in": filter.ArchitectureStyle}})
-		}
-		if filter.RdmSourceSystemKey != "" {
-			pipeline = append(pipeline, bson.E{Key: "property.listing.rdm_source_system_key", Value: filter.RdmSourceSystemKey})
-		}
-		if filter.ListPriceMin != 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.listing.price.list_price", Value: bson.M{"$gte": filter.ListPriceMin}})
-		}
-		if filter.ListPriceMax != 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.listing.price.list_price", Value: bson.M{"$lte": filter.ListPriceMax}})
-		}
-		if filter.BedroomsMin != 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.structure.bedrooms_total", Value: bson.M{"$gte": filter.BedroomsMin}})
-		}
-		if filter.PostalCode != nil && len(filter.PostalCode) > 0 {
-			pipeline = append(pipeline, bson.E{Key: "property.location.address.postal_code", Value: bson.M{"$in": filter.PostalCode}})
-		}
-	}
-	return pipeline
-}
-
 // mongodb find options
 func (s *Service) findOptions(limit int32, offset int32) *options.FindOptions {
 	// mongodb find options
@@ -1355,29 +1501,31 @@ func (s *Service) SearchMlsListings(ctx context.Context, in *pb.SearchMlsListing
 
 	response := &pb.SearchMlsListingsResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
-	var mongodbCur *mongo.Cursor
-	var mongodbErr error
 	if in.Q != nil {
+		if in.Q.ListingId == "" {
+			msg := "unable to find listings due to internal error"
+			log.Errorf("%s. search query is empty", msg)
+			return nil, status.Errorf(codes.Internal, msg)
+		}
 
-		if in.Q.ListingId != "" {
-			operator, operand := parseSearchQuery(in.Q.ListingId)
-			if len(operand) < 3 {
-				msg := "minimum 3 chars required to search listings"
-				log.Errorf("Validation Error. %v", msg)
-				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
-			}
-			pipeline := s.searchPipeline("wildcard", "listing_id", operand, operator, in.Limit, in.Offset)
-
-			opts := options.Aggregate()
-			mongodbCur, mongodbErr = mongoCollection.Aggregate(ctx, pipeline, opts)
-			if mongodbErr != nil {
-				log.Errorf("Error while searching listings : %v", mongodbErr)
-				return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching listings : %s", in))
-			}
+		operator, operand := parseSearchQuery(in.Q.ListingId)
+		if len(operand) < 3 {
+			msg := "minimum 3 chars required to search listings"
+			log.Errorf("Validation Error. %v", msg)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
 		}
+		page, err := s.searchListings(ctx, s.searchStage("wildcard", "listing_id", operand, operator), listQuery{
+			limit:     in.Limit,
+			offset:    in.Offset,
+			pageToken: in.PageToken,
+			countMode: in.CountMode,
+			sort:      in.Sort,
+			fields:    in.Fields,
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.MlsListings, response.PageInfo = page.listings, page.pageInfo
 
 	} else {
 		if !in.IsRealogyListing && !in.IsLuxuryListing {
@@ -1432,30 +1580,19 @@ func (s *Service) SearchMlsListings(ctx context.Context, in *pb.SearchMlsListing
 		//	pipeline = append(pipeline, bson.E{Key: "last_change_date", Value: bson.M{"$gte": allowedLastChangeTs}})
 		//}
 
-		// mongodb find options
-		findOptions := s.findOptions(in.Limit, in.Offset)
-		findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
-
-		mongodbCur, mongodbErr = mongoCollection.Find(ctx, &pipeline, findOptions)
-		if mongodbErr != nil {
-			log.Errorf("Error while processing the request to search mls: %v", mongodbErr)
-			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching listings for input : %s", in))
-		}
-	}
-
-	if mongodbCur == nil {
-		msg := "unable to find listings due to internal error"
-		log.Errorf("%s. mongodb cursor is nil", msg)
-		return nil, status.Errorf(codes.Internal, msg)
-	}
-	// iterate mongo cursor and create response
-	for mongodbCur.Next(ctx) {
-		var result pb.MlsListing
-		err := mongodbCur.Decode(&result)
+		page, err := s.findListings(ctx, listQuery{
+			filter:    pipeline,
+			limit:     in.Limit,
+			offset:    in.Offset,
+			pageToken: in.PageToken,
+			countMode: in.CountMode,
+			sort:      in.Sort,
+			fields:    in.Fields,
+		})
 		if err != nil {
-			log.Errorf("unable to decode the document: %v", err)
+			return nil, err
 		}
-		response.MlsListings = append(response.MlsListings, &result)
+		response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 	}
 
 	if len(response.MlsListings) == 0 {
@@ -1484,29 +1621,31 @@ func (s *Service) GetRealogyListings(ctx context.Context, in *pb.RealogyListings
 
 	response := &pb.RealogyListingsResponse{}
 
-	// get mongodb collection
-	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
-
-	var mongodbCur *mongo.Cursor
-	var mongodbErr error
 	if in.Q != nil {
+		if in.Q.ListingId == "" {
+			msg := "unable to find listings due to internal error"
+			log.Errorf("%s. search query is empty", msg)
+			return nil, status.Errorf(codes.Internal, msg)
+		}
 
-		if in.Q.ListingId != "" {
-			operator, operand := parseSearchQuery(in.Q.ListingId)
-			if len(operand) < 3 {
-				msg := "minimum 3 chars required to search listings"
-				log.Errorf("Validation Error. %v", msg)
-				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
-			}
-			pipeline := s.searchPipeline("wildcard", "listing_id", operand, operator, in.Limit, in.Offset)
-
-			opts := options.Aggregate()
-			mongodbCur, mongodbErr = mongoCollection.Aggregate(ctx, pipeline, opts)
-			if mongodbErr != nil {
-				log.Errorf("Error while searching listings : %v", mongodbErr)
-				return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching listings : %s", in))
-			}
+		operator, operand := parseSearchQuery(in.Q.ListingId)
+		if len(operand) < 3 {
+			msg := "minimum 3 chars required to search listings"
+			log.Errorf("Validation Error. %v", msg)
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", msg))
+		}
+		page, err := s.searchListings(ctx, s.searchStage("wildcard", "listing_id", operand, operator), listQuery{
+			limit:     in.Limit,
+			offset:    in.Offset,
+			pageToken: in.PageToken,
+			countMode: in.CountMode,
+			sort:      in.Sort,
+			fields:    in.Fields,
+		})
+		if err != nil {
+			return nil, err
 		}
+		response.MlsListings, response.PageInfo = page.listings, page.pageInfo
 
 	} else {
 
@@ -1535,30 +1674,19 @@ func (s *Service) GetRealogyListings(ctx context.Context, in *pb.RealogyListings
 				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Listings cannot be searched beyond last %v days", s.BySource.AllowedLastChangeDays))
 			}
 		}
-		// mongodb find options
-		findOptions := s.findOptions(in.Limit, in.Offset)
-		findOptions.SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
-
-		mongodbCur, mongodbErr = mongoCollection.Find(ctx, &pipeline, findOptions)
-		if mongodbErr != nil {
-			log.Errorf("Error while processing the request to search mls: %v", mongodbErr)
-			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while searching listings for input : %s", in))
-		}
-	}
-
-	if mongodbCur == nil {
-		msg := "unable to find listings due to internal error"
-		log.Errorf("%s. mongodb cursor is nil", msg)
-		return nil, status.Errorf(codes.Internal, msg)
-	}
-	// iterate mongo cursor and create response
-	for mongodbCur.Next(ctx) {
-		var result pb.MlsListing
-		err := mongodbCur.Decode(&result)
+		page, err := s.findListings(ctx, listQuery{
+			filter:    pipeline,
+			limit:     in.Limit,
+			offset:    in.Offset,
+			pageToken: in.PageToken,
+			countMode: in.CountMode,
+			sort:      in.Sort,
+			fields:    in.Fields,
+		})
 		if err != nil {
-			log.Errorf("unable to decode the document: %v", err)
+			return nil, err
 		}
-		response.MlsListings = append(response.MlsListings, &result)
+		response.MlsListings, response.NextPageToken, response.PageInfo = page.listings, page.nextPageToken, page.pageInfo
 	}
 
 	if len(response.MlsListings) == 0 {
@@ -1583,10 +1711,9 @@ func parseSearchQuery(query string) (string, string) {
 	return "", ""
 }
 
-func (s *Service) searchPipeline(mongodbOperator string, path string, query string, operator string, limit int32, offset int32) primitive.A {
+func (s *Service) searchStage(mongodbOperator string, path string, query string, operator string) primitive.D {
 	var searchQuery string
-	//pipeline := primitive.A{}
-	log.Printf("search pipeline - mongodbOperator: [%v], path: [%v], query: [%v], operator: [%v]", mongodbOperator, path, query, operator)
+	log.Printf("search stage - mongodbOperator: [%v], path: [%v], query: [%v], operator: [%v]", mongodbOperator, path, query, operator)
 	if mongodbOperator == "wildcard" {
 		if operator == pb.ComparisonOperators_like.String() {
 			searchQuery = "*" + query + "*"
@@ -1595,20 +1722,15 @@ func (s *Service) searchPipeline(mongodbOperator string, path string, query stri
 		}
 		log.Printf("search query: %s", searchQuery)
 
-		return bson.A{
-			bson.D{{"$search",
-				bson.D{
-					bson.E{Key: "index", Value: "listingIdSearchIdx"},
-					bson.E{Key: "wildcard",
-						Value: bson.D{
-							{"path", path},
-							{"query", searchQuery},
-							{"allowAnalyzedField", true},
-						},
-					}},
-			}},
-			bson.D{{Key: "$limit", Value: s.getLimit(limit)}},
-			bson.D{{Key: "$skip", Value: offset}},
+		return bson.D{
+			bson.E{Key: "index", Value: "listingIdSearchIdx"},
+			bson.E{Key: "wildcard",
+				Value: bson.D{
+					{"path", path},
+					{"query", searchQuery},
+					{"allowAnalyzedField", true},
+				},
+			},
 		}
 	}
 	return nil
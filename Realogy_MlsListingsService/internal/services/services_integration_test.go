@@ -3,15 +3,21 @@
 package services
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mlslisting/internal/config"
 	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+	"mlslisting/internal/roster"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -29,6 +35,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -352,6 +359,139 @@ func TestIntegrationGetMlsListingBySource(t *testing.T) {
 	assert.Equal(t, 1, len(response3.MlsListings)) // Maximum "Limit" is set as "2" in the env.
 }
 
+func TestIntegrationGetMlsListingBySourceWithPageToken(t *testing.T) {
+
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// test data has 3 "BRIGHTMLS" listings. first page of maximum limit (2) should have a next page token.
+	response, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(response.MlsListings))
+	assert.NotEmpty(t, response.NextPageToken)
+
+	// second page has the remaining listing and no next page token.
+	response2, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, PageToken: response.NextPageToken})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response2.MlsListings))
+	assert.Empty(t, response2.NextPageToken)
+	for _, listing := range response.MlsListings {
+		assert.NotEqual(t, listing.Property.Listing.ListingId, response2.MlsListings[0].Property.Listing.ListingId)
+	}
+
+	// offset cannot be combined with a page token.
+	_, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Offset: 1, PageToken: response.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// invalid page token.
+	_, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", PageToken: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationGetMlsListingBySourceWithPageInfo(t *testing.T) {
+
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// test data has 3 "BRIGHTMLS" listings. the total count is for all pages.
+	response, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), response.PageInfo.TotalCount)
+	assert.False(t, response.PageInfo.TotalCountEstimated)
+	assert.Equal(t, int32(2), response.PageInfo.Limit)
+	assert.Equal(t, int32(0), response.PageInfo.Offset)
+	assert.True(t, response.PageInfo.HasMore)
+
+	response, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, Offset: 2, CountMode: pb.CountMode_ESTIMATED})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), response.PageInfo.TotalCount)
+	assert.False(t, response.PageInfo.TotalCountEstimated)
+	assert.Equal(t, int32(2), response.PageInfo.Offset)
+	assert.False(t, response.PageInfo.HasMore)
+}
+
+func TestIntegrationGetMlsListingBySourceWithSort(t *testing.T) {
+
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// pages of the 3 "BRIGHTMLS" listings by descending list price.
+	response, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, Sort: []string{"-list_price"}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, response.NextPageToken)
+	response2, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, Sort: []string{"-list_price"}, PageToken: response.NextPageToken})
+	assert.Nil(t, err)
+	listings := append(response.MlsListings, response2.MlsListings...)
+	assert.Equal(t, 3, len(listings))
+	for i := 1; i < len(listings); i++ {
+		assert.GreaterOrEqual(t, listings[i-1].Property.Listing.Price.ListPrice, listings[i].Property.Listing.Price.ListPrice)
+	}
+
+	// the page token is for the sort it was created with.
+	_, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, PageToken: response.NextPageToken})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// sort fields are whitelisted.
+	_, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Sort: []string{"unparsed_address"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationGetMlsListingBySourceWithFields(t *testing.T) {
+
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	fields := &fieldmaskpb.FieldMask{Paths: []string{"property.listing.price.list_price"}}
+	response, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, Fields: fields})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(response.MlsListings))
+	for _, listing := range response.MlsListings {
+		assert.Nil(t, listing.Media)
+		assert.Nil(t, listing.Property.Location)
+		assert.Empty(t, listing.Property.Listing.ListingId)
+	}
+
+	// the next page token is still read from the projected listings.
+	response2, err := client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Limit: 2, Fields: fields, PageToken: response.NextPageToken})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response2.MlsListings))
+
+	_, err = client.GetMlsListingBySource(ctx, &pb.GetMlsListingsBySourceRequest{SourceSystemKey: "BRIGHTMLS", Fields: &fieldmaskpb.FieldMask{Paths: []string{"property.price"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestIntegrationGetMlsListingBySourceWithLastChangeDate(t *testing.T) {
 
 	// connection to server
@@ -890,6 +1030,167 @@ func TestIntegrationAddListings(t *testing.T) {
 	assert.Equal(t, err, expectedError2)
 }
 
+func TestIntegrationBulkUpsertMlsListings(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	listingId := fmt.Sprintf("bulk%d", time.Now().UnixNano())
+	listing := &pb.MlsListingInput{ListingId: listingId, RdmSourceSystemKey: "SOLO", Property: &pb.PropertyInput{PropertyType: "LAND",
+		Listing:  &pb.ListingInput{StandardStatus: "ACTIVE", Price: &pb.PriceInput{ListPrice: 60000}},
+		Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irving", Country: "India"}}}}
+	invalid := &pb.MlsListingInput{ListingId: listingId, Property: listing.Property}
+
+	response, err := client.BulkUpsertMlsListings(ctx, &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{listing, invalid, listing}})
+	assert.Nil(t, err)
+	assert.Equal(t, "inserted", response.Results[0].Result)
+	assert.Equal(t, "SOLO_"+listingId, response.Results[0].MlsId)
+	assert.Equal(t, "rejected", response.Results[1].Result)
+	assert.Equal(t, "Invalid input. RdmSourceSystemKey: cannot be blank.", response.Results[1].Reason)
+	assert.Equal(t, "rejected", response.Results[2].Result)
+	assert.Equal(t, int32(1), response.Inserted)
+	assert.Equal(t, int32(2), response.Rejected)
+
+	listing.Property.Listing.Price.ListPrice = 65000
+	response, err = client.BulkUpsertMlsListings(ctx, &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{listing}})
+	assert.Nil(t, err)
+	assert.Equal(t, "updated", response.Results[0].Result)
+	assert.Equal(t, int32(1), response.Updated)
+}
+
+func TestIntegrationDeleteMlsListing(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	listingId := fmt.Sprintf("delete%d", time.Now().UnixNano())
+	listing := &pb.MlsListingInput{ListingId: listingId, RdmSourceSystemKey: "SOLO", Property: &pb.PropertyInput{PropertyType: "LAND",
+		Listing:  &pb.ListingInput{StandardStatus: "ACTIVE", Price: &pb.PriceInput{ListPrice: 60000}},
+		Location: &pb.LocationInput{Address: &pb.AddressInput{City: "Irving", Country: "India"}}}}
+	_, err = client.BulkUpsertMlsListings(ctx, &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{listing}})
+	assert.Nil(t, err)
+
+	// soft deleted listings are not read, nor upserted.
+	deleted, err := client.DeleteMlsListing(ctx, &pb.DeleteMlsListingRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Nil(t, err)
+	assert.Equal(t, "SOLO_"+listingId, deleted.MlsId)
+	assert.NotNil(t, deleted.DeletedTime)
+	_, err = client.GetMlsListingByListingId(ctx, &pb.GetMlsListingByListingIdRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	upserted, err := client.BulkUpsertMlsListings(ctx, &pb.BulkUpsertMlsListingsRequest{Listings: []*pb.MlsListingInput{listing}})
+	assert.Nil(t, err)
+	assert.Equal(t, "rejected", upserted.Results[0].Result)
+	assert.Equal(t, "Listing SOLO_"+listingId+" is deleted, restore it to update it.", upserted.Results[0].Reason)
+	_, err = client.DeleteMlsListing(ctx, &pb.DeleteMlsListingRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	restored, err := client.RestoreMlsListing(ctx, &pb.RestoreMlsListingRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Nil(t, err)
+	assert.False(t, restored.MlsListing.Deleted)
+	_, err = client.GetMlsListingByListingId(ctx, &pb.GetMlsListingByListingIdRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Nil(t, err)
+
+	// hard deleted listings can not be restored.
+	deleted, err = client.DeleteMlsListing(ctx, &pb.DeleteMlsListingRequest{ListingId: listingId, SourceSystemKey: "SOLO", Hard: true})
+	assert.Nil(t, err)
+	assert.Nil(t, deleted.DeletedTime)
+	_, err = client.RestoreMlsListing(ctx, &pb.RestoreMlsListingRequest{ListingId: listingId, SourceSystemKey: "SOLO"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.DeleteMlsListing(ctx, &pb.DeleteMlsListingRequest{ListingId: "100018", SourceSystemKey: "KY_WKRMLS"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationQueryMlsListings(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	response, err := client.QueryMlsListings(ctx, &pb.QueryMlsListingsRequest{
+		Filter: "ListingId eq '100018' and ListPrice ge 0",
+		Select: []string{"ListingKey", "ListingId", "ListPrice"},
+		Top:    5,
+		Count:  true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), response.Count)
+	assert.Equal(t, []string{"ListingKey", "ListingId", "ListPrice"}, response.Select)
+	if assert.Equal(t, 1, len(response.Listings)) {
+		assert.Equal(t, "100018", response.Listings[0].Property.Listing.ListingId)
+		assert.Equal(t, "100018-KY_WKRMLS", response.ListingKeys[0])
+		// fields not selected are not returned.
+		assert.Nil(t, response.Listings[0].Property.Location)
+	}
+
+	_, err = client.QueryMlsListings(ctx, &pb.QueryMlsListingsRequest{Filter: "Town eq 'Irving'"})
+	assert.Equal(t, status.Errorf(codes.InvalidArgument, `Invalid input. $filter: unknown field "Town"`), err)
+}
+
+func TestIntegrationExportMlsListings(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.ExportMlsListings(ctx, &pb.ExportMlsListingsRequest{
+		SourceSystemKey: "KY_WKRMLS",
+		Format:          pb.ExportFormat_CSV,
+		Gzip:            true,
+		Fields:          &fieldmaskpb.FieldMask{Paths: []string{"property.listing.listing_id", "property.property_type"}},
+	})
+	assert.Nil(t, err)
+	var data []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if !assert.Nil(t, err) {
+			return
+		}
+		assert.Equal(t, "application/gzip", chunk.ContentType)
+		data = append(data, chunk.Data...)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	rows, err := csv.NewReader(reader).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"property.listing.listing_id", "property.property_type"}, rows[0])
+	assert.Contains(t, rows, []string{"100018", "LAND"})
+
+	stream, err = client.ExportMlsListings(ctx, &pb.ExportMlsListingsRequest{SourceSystemKey: "KY_WKRMLS", Fields: &fieldmaskpb.FieldMask{Paths: []string{"property.price"}}})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestIntegrationUpdateListings(t *testing.T) {
 	// connection to server
 	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
@@ -939,6 +1240,294 @@ func TestIntegrationUpdateListings(t *testing.T) {
 	assert.Equal(t, "SOLD", response3.MlsListings.Property.Listing.StandardStatus)
 	assert.Equal(t, float64(1250000), response3.MlsListings.Property.Listing.Price.ClosePrice)
 	assert.Equal(t, testDate.Seconds, response3.MlsListings.Property.Listing.Dates.CloseDate.Seconds)
+
+	//Case5: a SOLD listing can not go back on the market
+	request4 := &pb.UpdateMlsListingByListingIdRequest{ListingId: "493278", SourceSystemKey: "CABCREIS", Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{StandardStatus: "ACTIVE"}}}
+	expectedError4 := status.Errorf(codes.FailedPrecondition, "Standard Status can not change from SOLD to ACTIVE")
+
+	response4, err := client.UpdateMlsListingByListingId(ctx, request4)
+	assert.Nil(t, response4)
+	assert.Equal(t, expectedError4, err)
+}
+
+func TestIntegrationGetStatusLifecycle(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.GetStatusLifecycle(ctx, &pb.GetStatusLifecycleRequest{})
+	assert.Nil(t, err)
+	for _, rule := range response.Statuses {
+		if rule.StandardStatus == "SOLD" {
+			assert.Empty(t, rule.Transitions)
+			assert.Equal(t, []string{"property.listing.dates.close_date", "property.listing.price.close_price"}, rule.RequiredFields)
+		}
+	}
+}
+
+func TestIntegrationGetListingDuplicates(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// the test listing has no duplicates from other sources, it is its own canonical listing.
+	response, err := client.GetListingDuplicates(ctx, &pb.GetListingDuplicatesRequest{ListingId: "493278", SourceSystemKey: "CABCREIS"})
+	assert.Nil(t, err)
+	assert.Empty(t, response.ClusterId)
+	assert.Equal(t, "CABCREIS_493278", response.CanonicalMlsId)
+	assert.Len(t, response.Duplicates, 1)
+	assert.True(t, response.Duplicates[0].Canonical)
+
+	_, err = client.GetListingDuplicates(ctx, &pb.GetListingDuplicatesRequest{ListingId: "493278", SourceSystemKey: "UNKNOWN"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetListingDuplicates(ctx, &pb.GetListingDuplicatesRequest{ListingId: "493278"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationUpdateListingsExpectedVersion(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	listings, err := client.GetMlsListingByListingId(ctx, &pb.GetMlsListingByListingIdRequest{ListingId: "493278", SourceSystemKey: "CABCREIS"})
+	assert.Nil(t, err)
+	revision := listings.MlsListings[0].Revision
+
+	// an update expecting the current revision increments it.
+	var header metadata.MD
+	request := &pb.UpdateMlsListingByListingIdRequest{ListingId: "493278", SourceSystemKey: "CABCREIS", ExpectedVersion: fmt.Sprint(revision),
+		Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{Price: &pb.UpdatePrice{ListPrice: 61000}}}}
+	response, err := client.UpdateMlsListingByListingId(ctx, request, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Equal(t, revision+1, response.MlsListings.Revision)
+	etag := header.Get("etag")
+	assert.Len(t, etag, 1)
+	assert.True(t, strings.HasPrefix(etag[0], fmt.Sprintf(`"%d-`, revision+1)), etag)
+
+	// the same update is now a conflict, sent with the ETag of the current version.
+	_, err = client.UpdateMlsListingByListingId(ctx, request, grpc.Header(&header))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, etag, header.Get("etag"))
+
+	// feeds write listings without incrementing their revision, an update expecting the ETag read before fails.
+	_, err = mongodbUpdate(&bson.M{"_id": "CABCREIS_493278"}, &bson.M{"$set": bson.M{"last_change_date": time.Now().Add(time.Minute)}})
+	assert.Nil(t, err)
+	request.ExpectedVersion = etag[0]
+	_, err = client.UpdateMlsListingByListingId(ctx, request, grpc.Header(&header))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NotEqual(t, etag, header.Get("etag"))
+	request.ExpectedVersion = header.Get("etag")[0]
+	_, err = client.UpdateMlsListingByListingId(ctx, request)
+	assert.Nil(t, err)
+
+	// so is an If-Match header of an older revision.
+	request.ExpectedVersion = ""
+	_, err = client.UpdateMlsListingByListingId(metadata.AppendToOutgoingContext(ctx, "if-match", fmt.Sprintf(`"%d"`, revision)), request)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	request.ExpectedVersion = `W/"1"`
+	_, err = client.UpdateMlsListingByListingId(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationPatchMlsListing(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// remarks can be set, and cleared by masking them without a value.
+	request := &pb.PatchMlsListingRequest{ListingId: "493278", SourceSystemKey: "CABCREIS",
+		MlsListing: &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{Remarks: &pb.Remarks{PublicRemarks: "Patched remarks"}}}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"property.listing.remarks.public_remarks"}}}
+	var header metadata.MD
+	response, err := client.PatchMlsListing(ctx, request, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Equal(t, "Patched remarks", response.MlsListing.Property.Listing.Remarks.PublicRemarks)
+	assert.Len(t, header.Get("etag"), 1)
+	assert.True(t, strings.HasPrefix(header.Get("etag")[0], fmt.Sprintf(`"%d-`, response.MlsListing.Revision)))
+
+	request.MlsListing = nil
+	request.ExpectedVersion = fmt.Sprint(response.MlsListing.Revision)
+	response, err = client.PatchMlsListing(ctx, request)
+	assert.Nil(t, err)
+	assert.Equal(t, "", response.MlsListing.Property.Listing.Remarks.GetPublicRemarks())
+
+	// feed listings only allow the fields of the "*" policy.
+	request.UpdateMask.Paths = []string{"property.structure.bedrooms_total"}
+	_, err = client.PatchMlsListing(ctx, request)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// they include the dates, which are written without the read-only last change and inserted dates.
+	request.ExpectedVersion = ""
+	request.MlsListing = &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{Dates: &pb.Dates{OnMarketDate: timestamppb.Now()}}}}
+	request.UpdateMask.Paths = []string{"property.listing.dates"}
+	response, err = client.PatchMlsListing(ctx, request)
+	assert.Nil(t, err)
+	assert.NotNil(t, response.MlsListing.Property.Listing.Dates.OnMarketDate)
+	assert.NotNil(t, response.MlsListing.Property.Listing.Dates.LastChangeDate)
+
+	// status changes are validated as updates are.
+	_, err = mongodbUpdate(&bson.M{"_id": "CABCREIS_493278"}, &bson.M{
+		"$set":   bson.M{"property.listing.standard_status": "ACTIVE"},
+		"$unset": bson.M{"property.listing.dates.close_date": ""},
+	})
+	assert.Nil(t, err)
+	request.ExpectedVersion = ""
+	request.MlsListing = &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{StandardStatus: "SOLD"}}}
+	request.UpdateMask.Paths = []string{"property.listing.standard_status"}
+	_, err = client.PatchMlsListing(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// and follow the status lifecycle.
+	_, err = mongodbUpdate(&bson.M{"_id": "CABCREIS_493278"}, &bson.M{"$set": bson.M{"property.listing.standard_status": "SOLD"}})
+	assert.Nil(t, err)
+	request.MlsListing = &pb.MlsListing{Property: &pb.Property{Listing: &pb.Listing{StandardStatus: "ACTIVE"}}}
+	_, err = client.PatchMlsListing(ctx, request)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestIntegrationGetUpcomingOpenHouses(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// an open house tomorrow, a canceled one and a live stream the day after.
+	tomorrow := time.Now().UTC().Truncate(time.Hour).Add(24 * time.Hour)
+	_, err = mongodbUpdate(&bson.M{"_id": "CABCREIS_493278"}, &bson.M{"$set": bson.M{
+		"open_house.open_homes": bson.A{
+			bson.M{"open_house_start_time": tomorrow, "open_house_end_time": tomorrow.Add(2 * time.Hour), "open_house_remarks": "Open house"},
+			bson.M{"open_house_start_time": tomorrow.Add(time.Hour), "is_canceled": true},
+		},
+		"live_stream_open_house.live_stream_open_homes": bson.A{
+			bson.M{"open_house_start_time": tomorrow.Add(24 * time.Hour), "url": "https://example.com/live"},
+		},
+	}})
+	assert.Nil(t, err)
+
+	response, err := client.GetUpcomingOpenHouses(ctx, &pb.GetUpcomingOpenHousesRequest{SourceSystemKey: "CABCREIS",
+		StartTime: timestamppb.New(tomorrow), EndTime: timestamppb.New(tomorrow.Add(48 * time.Hour))})
+	assert.Nil(t, err)
+	var openHouses []*pb.UpcomingOpenHouse
+	for _, openHouse := range response.OpenHouses {
+		if openHouse.MlsId == "CABCREIS_493278" {
+			openHouses = append(openHouses, openHouse)
+		}
+	}
+	assert.Equal(t, 2, len(openHouses))
+	assert.Equal(t, "IN_PERSON", openHouses[0].Type)
+	assert.Equal(t, "Open house", openHouses[0].Remarks)
+	assert.Equal(t, "493278", openHouses[0].ListingId)
+	assert.Equal(t, "LIVE_STREAM", openHouses[1].Type)
+	assert.Equal(t, "https://example.com/live", openHouses[1].Url)
+}
+
+func TestIntegrationGetComparableSales(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// a sale of last month in New York.
+	_, err = mongodbUpdate(&bson.M{"_id": "NY_REBNY_OLRS_1016635"}, &bson.M{"$set": bson.M{
+		"property.listing.dates.close_date": time.Now().UTC().AddDate(0, -1, 0),
+		"geo_location":                      bson.M{"type": "Point", "coordinates": bson.A{-74.0086363, 40.7196889}},
+	}})
+	assert.Nil(t, err)
+
+	response, err := client.GetComparableSales(ctx, &pb.GetComparableSalesRequest{
+		Point:             &pb.GeoPoint{Latitude: 40.7200, Longitude: -74.0090},
+		BuildingAreaTotal: 2704,
+		SoldWithinDays:    90,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2704.0, response.Subject.BuildingAreaTotal)
+	var sale *pb.ComparableSale
+	for _, comparable := range response.ComparableSales {
+		if comparable.MlsListing.Property.Listing.ListingId == "1016635" {
+			sale = comparable
+		}
+	}
+	assert.NotNil(t, sale)
+	assert.Equal(t, 1.0, sale.Similarity)
+	assert.InDelta(t, 6950000/2704.0, sale.PricePerSquareFoot, 0.01)
+	assert.Less(t, sale.DistanceMeters, 100.0)
+
+	_, err = client.GetComparableSales(ctx, &pb.GetComparableSalesRequest{ListingId: "unknown", SourceSystemKey: "NY_REBNY_OLRS"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIntegrationRoster(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// rebuild the rosters rather than wait for the builder of the server.
+	database := mongoCollection.Database()
+	builder := roster.NewBuilder(mongoCollection, database.Collection(conf.MongoDB.Collections["listing_agents"]),
+		database.Collection(conf.MongoDB.Collections["listing_offices"]), time.Hour)
+	assert.Nil(t, builder.Rebuild(ctx))
+
+	// listing 100018 is listed by agent 21 of office 23.
+	agent, err := client.GetAgent(ctx, &pb.GetAgentRequest{SourceSystemKey: "KY_WKRMLS", MlsId: "21"})
+	assert.Nil(t, err)
+	assert.Equal(t, "KY_WKRMLS_21", agent.Agent.Id)
+	assert.Equal(t, "JOEY RICKS", agent.Agent.FullName)
+	assert.Equal(t, "23", agent.Agent.OfficeMlsId)
+	assert.GreaterOrEqual(t, agent.Agent.ListingCount, int32(1))
+
+	agents, err := client.ListAgents(ctx, &pb.ListAgentsRequest{SourceSystemKey: "KY_WKRMLS", OfficeMlsId: "23"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, agents.Agents)
+	assert.Equal(t, int32(2), agents.PageInfo.Limit)
+
+	offices, err := client.ListOffices(ctx, &pb.ListOfficesRequest{SourceSystemKey: "KY_WKRMLS"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, offices.Offices)
+
+	_, err = client.GetAgent(ctx, &pb.GetAgentRequest{SourceSystemKey: "KY_WKRMLS", MlsId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
 }
 
 // test changes api by date/time. the api client can send point in time to receive mls events.
@@ -996,6 +1585,325 @@ COMPLETE:
 	assert.NotEqual(t, 0, eventCount)
 }
 
+// a named subscription keeps its filters and checkpoints the marker of the events acknowledged on it.
+func TestIntegrationStreamMlsListingEventBySubscription(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	name := fmt.Sprintf("integration-%d", time.Now().UnixNano())
+	receive := func(request *pb.StreamMlsListingEventRequest, listingId string) *pb.StreamMlsListingEventResponse {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		stream, err := client.StreamMlsListingEvent(ctx, request)
+		assert.Nil(t, err)
+		time.Sleep(time.Second) // let the change stream open before the update.
+
+		result, _ := mongodbUpdate(&bson.M{"_id": listingId}, &bson.M{"$currentDate": bson.M{"last_change_date": true}})
+		log.Printf("updated %v document in mongodb for change stream testing.", result.ModifiedCount)
+
+		event, err := stream.Recv()
+		assert.Nil(t, err)
+		_, err = client.AckMlsListingEvent(ctx, &pb.AckMlsListingEventRequest{Subscription: name, Marker: event.MlsChange.Marker})
+		assert.Nil(t, err)
+		return event
+	}
+
+	event := receive(&pb.StreamMlsListingEventRequest{Subscription: name, SourceSystemKey: "CABCREIS"}, "CABCREIS_490266")
+	assert.Equal(t, "CABCREIS_490266", event.MlsId)
+
+	// the acknowledged checkpoint is saved when the stream ends.
+	var saved bson.M
+	assert.Eventually(t, func() bool {
+		err := mongoCollection.Database().Collection(conf.MongoDB.Collections["listing_subscriptions"]).FindOne(context.Background(), bson.M{"_id": name}).Decode(&saved)
+		return err == nil && saved["marker"] == event.MlsChange.Marker
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// reconnect with just the name, the subscription is still filtered by its source.
+	event = receive(&pb.StreamMlsListingEventRequest{Subscription: name}, "CABCREIS_490266")
+	assert.Equal(t, "CABCREIS", event.MlsListing.Property.Listing.SourceSystemKey)
+
+	// the filters of a subscription can not be changed.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := client.StreamMlsListingEvent(ctx, &pb.StreamMlsListingEventRequest{Subscription: name, SourceSystemKey: "AR_TMLS"})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// unknown subscription
+	_, err = client.AckMlsListingEvent(ctx, &pb.AckMlsListingEventRequest{Subscription: name + "-unknown", Marker: event.MlsChange.Marker})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// streams with the same filters share a change stream, and resume from its buffered events.
+func TestIntegrationStreamMlsListingEventShared(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	request := &pb.StreamMlsListingEventRequest{SourceSystemKey: "CABCREIS", ChangeType: "update"}
+	first, err := client.StreamMlsListingEvent(ctx, request)
+	assert.Nil(t, err)
+	second, err := client.StreamMlsListingEvent(ctx, request)
+	assert.Nil(t, err)
+	time.Sleep(time.Second) // let the change stream open before the update.
+
+	mongodbUpdate(&bson.M{"_id": "CABCREIS_490266"}, &bson.M{"$currentDate": bson.M{"last_change_date": true}})
+	event, err := first.Recv()
+	assert.Nil(t, err)
+	sameEvent, err := second.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, event.MlsChange.Marker, sameEvent.MlsChange.Marker)
+
+	// resume after the first event, the next one is replayed.
+	mongodbUpdate(&bson.M{"_id": "CABCREIS_490266"}, &bson.M{"$currentDate": bson.M{"last_change_date": true}})
+	next, err := first.Recv()
+	assert.Nil(t, err)
+	resumed, err := client.StreamMlsListingEvent(ctx, &pb.StreamMlsListingEventRequest{SourceSystemKey: "CABCREIS", ChangeType: "update", Marker: event.MlsChange.Marker})
+	assert.Nil(t, err)
+	replayed, err := resumed.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, next.MlsChange.Marker, replayed.MlsChange.Marker)
+}
+
+func TestIntegrationSearchMlsListingsByGeoInvalidInput(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// no area.
+	_, err = client.SearchMlsListingsByGeo(ctx, &pb.SearchMlsListingsByGeoRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// point and bounding box together.
+	_, err = client.SearchMlsListingsByGeo(ctx, &pb.SearchMlsListingsByGeoRequest{
+		Point:        &pb.GeoPoint{Latitude: 40.7, Longitude: -74.0},
+		RadiusMeters: 1000,
+		BoundingBox:  &pb.GeoBoundingBox{SouthWest: &pb.GeoPoint{Latitude: 40.6, Longitude: -74.1}, NorthEast: &pb.GeoPoint{Latitude: 40.8, Longitude: -73.9}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// latitude out of range.
+	_, err = client.SearchMlsListingsByGeo(ctx, &pb.SearchMlsListingsByGeoRequest{Point: &pb.GeoPoint{Latitude: 91, Longitude: -74.0}, RadiusMeters: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// polygon ring not closed.
+	_, err = client.SearchMlsListingsByGeo(ctx, &pb.SearchMlsListingsByGeoRequest{Polygon: `{"type":"Polygon","coordinates":[[[-74.1,40.6],[-73.9,40.6],[-73.9,40.8],[-74.1,40.8]]]}`})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationGetMlsListingsStatsBySource(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingsStatsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.GetMlsListingsStatsBySource(ctx, &pb.GetMlsListingsStatsBySourceRequest{SourceSystemKey: "BRIGHTMLS"})
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "BRIGHTMLS", response.MlsListingsStats.Source)
+	assert.NotNil(t, response.MlsListingsStats.ListingsLastUpdateTime)
+
+	var byPropertyType int32
+	for _, pt := range response.MlsListingsStats.ActiveListingsByPropertyType {
+		byPropertyType += pt.Count
+	}
+	assert.Equal(t, response.MlsListingsStats.TotalActiveListings, byPropertyType)
+
+	// unknown source
+	_, err = client.GetMlsListingsStatsBySource(ctx, &pb.GetMlsListingsStatsBySourceRequest{SourceSystemKey: "UNKNOWN_SOURCE"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIntegrationGetMlsListingsStatsByAgentGuid(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingsStatsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.GetMlsListingsStatsByAgentGuid(ctx, &pb.GetMlsListingsStatsByAgentGuidRequest{ListingAgentGuid: "87654"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), response.TotalListings)
+
+	// missing agent guid
+	_, err = client.GetMlsListingsStatsByAgentGuid(ctx, &pb.GetMlsListingsStatsByAgentGuidRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIntegrationGetMarketStats(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingsStatsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var header metadata.MD
+	response, err := client.GetMarketStats(ctx, &pb.GetMarketStatsRequest{AreaType: pb.MarketAreaType_POSTAL_CODE, Areas: []string{"33418"}}, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Len(t, response.Areas, 1)
+	assert.Equal(t, "33418", response.Areas[0].Area)
+
+	var active int32
+	for _, stats := range response.Areas[0].ByPropertyType {
+		active += stats.ActiveInventory
+	}
+	assert.Equal(t, response.Areas[0].Total.ActiveInventory, active)
+	assert.NotEmpty(t, header.Get("cache-control"))
+
+	// the same request is answered from the cache.
+	cached, err := client.GetMarketStats(ctx, &pb.GetMarketStatsRequest{AreaType: pb.MarketAreaType_POSTAL_CODE, Areas: []string{"33418"}})
+	assert.Nil(t, err)
+	assert.Equal(t, response.GeneratedTime.AsTime(), cached.GeneratedTime.AsTime())
+
+	// unknown area
+	_, err = client.GetMarketStats(ctx, &pb.GetMarketStatsRequest{AreaType: pb.MarketAreaType_CITY, Areas: []string{"UNKNOWN_CITY"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// missing areas
+	_, err = client.GetMarketStats(ctx, &pb.GetMarketStatsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// the history recorder records the status change of an updated listing.
+func TestIntegrationGetMlsListingHistory(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the listing is put back on the market, a sold listing can not go pending.
+	_, err = mongodbUpdate(&bson.M{"_id": "CABCREIS_493278"}, &bson.M{"$set": bson.M{"property.listing.standard_status": "ACTIVE"}})
+	assert.Nil(t, err)
+	_, err = client.UpdateMlsListingByListingId(ctx, &pb.UpdateMlsListingByListingIdRequest{ListingId: "493278", SourceSystemKey: "CABCREIS", Property: &pb.UpdateProperty{Listing: &pb.UpdateListing{StandardStatus: "PENDING",
+		Dates: &pb.UpdateDates{PendingTimestamp: timestamppb.Now()}}}})
+	assert.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		response, err := client.GetMlsListingHistory(ctx, &pb.GetMlsListingHistoryRequest{ListingId: "493278", SourceSystemKey: "CABCREIS"})
+		if err != nil {
+			return false
+		}
+		last := response.History[len(response.History)-1]
+		return last.StandardStatus == "PENDING" && len(response.Summary.DaysInStatus) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// missing source system key
+	_, err = client.GetMlsListingHistory(ctx, &pb.GetMlsListingHistoryRequest{ListingId: "493278"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// unknown listing
+	_, err = client.GetMlsListingHistory(ctx, &pb.GetMlsListingHistoryRequest{ListingId: "unknown", SourceSystemKey: "CABCREIS"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIntegrationMlsListingWebhooks(t *testing.T) {
+	// connection to server
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewMlsListingServiceClient(conn)
+
+	background, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// webhooks are managed by the api key that registered them.
+	ctx := metadata.AppendToOutgoingContext(background, "apikey", "integration-test")
+	other := metadata.AppendToOutgoingContext(background, "apikey", "integration-test-other")
+
+	created, err := client.CreateMlsListingWebhook(ctx, &pb.CreateMlsListingWebhookRequest{Url: "https://example.com/mls/events", SourceSystemKey: "CABCREIS"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, created.WebhookId)
+	assert.NotEmpty(t, created.Secret)
+
+	listed, err := client.ListMlsListingWebhooks(ctx, &pb.ListMlsListingWebhooksRequest{})
+	assert.Nil(t, err)
+	found := false
+	for _, webhook := range listed.Webhooks {
+		if webhook.WebhookId == created.WebhookId {
+			found = true
+			assert.Empty(t, webhook.Secret)
+		}
+	}
+	assert.True(t, found)
+
+	deadLetters, err := client.ListMlsListingWebhookDeadLetters(ctx, &pb.ListMlsListingWebhookDeadLettersRequest{WebhookId: created.WebhookId})
+	assert.Nil(t, err)
+	assert.Empty(t, deadLetters.DeadLetters)
+
+	// the webhooks of other api keys are not found.
+	listed, err = client.ListMlsListingWebhooks(other, &pb.ListMlsListingWebhooksRequest{})
+	assert.Nil(t, err)
+	for _, webhook := range listed.Webhooks {
+		assert.NotEqual(t, created.WebhookId, webhook.WebhookId)
+	}
+	_, err = client.ListMlsListingWebhookDeadLetters(other, &pb.ListMlsListingWebhookDeadLettersRequest{WebhookId: created.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.DeleteMlsListingWebhook(other, &pb.DeleteMlsListingWebhookRequest{WebhookId: created.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.ListMlsListingWebhooks(background, &pb.ListMlsListingWebhooksRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.DeleteMlsListingWebhook(ctx, &pb.DeleteMlsListingWebhookRequest{WebhookId: created.WebhookId})
+	assert.Nil(t, err)
+
+	// deleted webhook
+	_, err = client.DeleteMlsListingWebhook(ctx, &pb.DeleteMlsListingWebhookRequest{WebhookId: created.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.ReplayMlsListingWebhookDeadLetters(ctx, &pb.ReplayMlsListingWebhookDeadLettersRequest{WebhookId: created.WebhookId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// invalid url and change type
+	_, err = client.CreateMlsListingWebhook(ctx, &pb.CreateMlsListingWebhookRequest{Url: "example.com/mls/events"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.CreateMlsListingWebhook(ctx, &pb.CreateMlsListingWebhookRequest{Url: "https://example.com/mls/events", ChangeType: "catchup"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	// internal url
+	_, err = client.CreateMlsListingWebhook(ctx, &pb.CreateMlsListingWebhookRequest{Url: "http://169.254.169.254/latest/meta-data"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 // this function perform mongodb update operation for a given filter and update bson.
 func mongodbUpdate(filter *bson.M, update *bson.M) (*mongo.UpdateResult, error) {
 	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
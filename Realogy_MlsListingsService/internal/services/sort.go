@@ -0,0 +1,99 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// sortableFields maps the sort fields of list requests to listing document paths.
+// Every field is backed by an index with "_id" (see db/mongodb_index.js) and mapped in the search indexes.
+var sortableFields = map[string]string{
+	"last_change_date":      "last_change_date",
+	"list_price":            "property.listing.price.list_price",
+	"days_on_market":        "property.listing.days_on_market",
+	"listing_contract_date": "property.listing.dates.listing_contract_date",
+}
+
+// defaultSort orders listings by last change date when a request has no sort.
+var defaultSort = []string{"last_change_date"}
+
+// sortKey is a listing document path to sort on.
+type sortKey struct {
+	path string
+	desc bool
+}
+
+// parseSort validates the sort fields of a request against sortableFields. A field prefixed with "-" sorts descending.
+// The returned keys end with "_id" so that the order is total.
+func parseSort(fields []string) ([]sortKey, error) {
+	if len(fields) == 0 {
+		fields = defaultSort
+	}
+	keys := make([]sortKey, 0, len(fields)+1)
+	seen := map[string]bool{}
+	for _, field := range fields {
+		name := strings.TrimPrefix(field, "-")
+		path, ok := sortableFields[name]
+		if !ok {
+			return nil, fmt.Errorf("sort field %q is not supported. supported fields are %s", name, strings.Join(sortableFieldNames(), ", "))
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("sort field %q is repeated", name)
+		}
+		seen[name] = true
+		keys = append(keys, sortKey{path: path, desc: strings.HasPrefix(field, "-")})
+	}
+	return append(keys, sortKey{path: "_id"}), nil
+}
+
+// sortDocument returns the mongodb sort document for the keys.
+func sortDocument(keys []sortKey) primitive.D {
+	document := primitive.D{}
+	for _, k := range keys {
+		direction := 1
+		if k.desc {
+			direction = -1
+		}
+		document = append(document, bson.E{Key: k.path, Value: direction})
+	}
+	return document
+}
+
+// searchSortDocument returns the sort option of a $search stage. Atlas search has no "_id" mapping to break ties on,
+// so the keys are used without it. It is nil when the request has no sort and the search relevance is kept.
+func searchSortDocument(fields []string) (primitive.D, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	keys, err := parseSort(fields)
+	if err != nil {
+		return nil, err
+	}
+	return sortDocument(keys[:len(keys)-1]), nil
+}
+
+// sortSpec is the canonical form of the keys, which ties a page token to the sort it was created for.
+func sortSpec(keys []sortKey) string {
+	spec := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k.desc {
+			spec = append(spec, "-"+k.path)
+		} else {
+			spec = append(spec, k.path)
+		}
+	}
+	return strings.Join(spec, ",")
+}
+
+func sortableFieldNames() []string {
+	names := make([]string, 0, len(sortableFields))
+	for name := range sortableFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestParseSortDefault(t *testing.T) {
+	keys, err := parseSort(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, primitive.D{{Key: "last_change_date", Value: 1}, {Key: "_id", Value: 1}}, sortDocument(keys))
+}
+
+func TestParseSort(t *testing.T) {
+	keys, err := parseSort([]string{"-list_price", "days_on_market"})
+	assert.Nil(t, err)
+	assert.Equal(t, primitive.D{
+		{Key: "property.listing.price.list_price", Value: -1},
+		{Key: "property.listing.days_on_market", Value: 1},
+		{Key: "_id", Value: 1},
+	}, sortDocument(keys))
+	assert.Equal(t, "-property.listing.price.list_price,property.listing.days_on_market,_id", sortSpec(keys))
+}
+
+func TestParseSortInvalid(t *testing.T) {
+	for _, sort := range [][]string{{"_id"}, {"unparsed_address"}, {"list_price", "-list_price"}, {""}} {
+		_, err := parseSort(sort)
+		assert.NotNil(t, err, "sort %v", sort)
+	}
+}
+
+func TestSearchSortDocument(t *testing.T) {
+	sort, err := searchSortDocument(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, sort)
+
+	sort, err = searchSortDocument([]string{"-last_change_date"})
+	assert.Nil(t, err)
+	assert.Equal(t, primitive.D{{Key: "last_change_date", Value: -1}}, sort)
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/config"
+	"mlslisting/internal/marketstats"
+	"mlslisting/internal/tombstone"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// cacheControlHeader is the response metadata of how long statistics may be cached, see gateway.grpcHeaderMatcher.
+const cacheControlHeader = "cache-control"
+
+// StatsService serves listing statistics computed from the listings collection.
+type StatsService struct {
+	pb.MlsListingsStatsServiceServer
+	MongoDatabase      *mongo.Database
+	ListingsCollection string
+	MaxQueryTimeSecs   int
+	MarketStats        *config.MarketStatsConfig
+	MarketStatsCache   *marketstats.Cache
+}
+
+// statsFacetResult is the decoded output of the stats $facet aggregation.
+type statsFacetResult struct {
+	Active []struct {
+		Total         int32 `bson:"total"`
+		WithPhotos    int32 `bson:"with_photos"`
+		WithOpenHomes int32 `bson:"with_open_homes"`
+	} `bson:"active"`
+	ByPropertyType []struct {
+		PropertyType string `bson:"_id"`
+		Count        int32  `bson:"count"`
+	} `bson:"by_property_type"`
+	LastUpdate []struct {
+		Listings  time.Time `bson:"listings"`
+		Photos    time.Time `bson:"photos"`
+		OpenHomes time.Time `bson:"open_homes"`
+	} `bson:"last_update"`
+}
+
+func (s *StatsService) GetMlsListingsStatsBySource(ctx context.Context, in *pb.GetMlsListingsStatsBySourceRequest) (*pb.GetMlsListingsStatsBySourceResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/statsBySource")
+	defer span.End()
+
+	err := validation.Errors{
+		"SourceSystemKey": validation.Validate(in.SourceSystemKey, validation.Required),
+	}.Filter()
+
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
+
+	activeMatch := bson.D{{Key: "$match", Value: bson.D{{Key: "property.listing.standard_status", Value: "ACTIVE"}}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "source_system_key", Value: in.SourceSystemKey}, tombstone.NotDeleted()}}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "active", Value: bson.A{
+				activeMatch,
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: nil},
+					{Key: "total", Value: bson.D{{Key: "$sum", Value: 1}}},
+					{Key: "with_photos", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+						bson.D{{Key: "$gt", Value: bson.A{"$media.num_images", 0}}}, 1, 0}}}}}},
+					{Key: "with_open_homes", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$open_house.is_open_homes", true}}}, 1, 0}}}}}},
+				}}},
+			}},
+			{Key: "by_property_type", Value: bson.A{
+				activeMatch,
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: "$property.property_type"},
+					{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+				}}},
+				bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+			}},
+			{Key: "last_update", Value: bson.A{
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: nil},
+					{Key: "listings", Value: bson.D{{Key: "$max", Value: "$last_change_date"}}},
+					{Key: "photos", Value: bson.D{{Key: "$max", Value: "$media.last_change_timestamp"}}},
+					{Key: "open_homes", Value: bson.D{{Key: "$max", Value: bson.D{{Key: "$max", Value: "$open_house.open_homes.modification_timestamp"}}}}},
+				}}},
+			}},
+		}}},
+	}
+
+	opts := options.Aggregate().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	cur, err := mongoCollection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		log.Errorf("Error while aggregating mls listings stats: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while aggregating mls listings stats for %s", in))
+	}
+	defer cur.Close(ctx)
+
+	var facet statsFacetResult
+	if cur.Next(ctx) {
+		if err := cur.Decode(&facet); err != nil {
+			log.Errorf("Unable to decode the mls listings stats: %v", err)
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while aggregating mls listings stats for %s", in))
+		}
+	}
+
+	if len(facet.LastUpdate) == 0 {
+		log.Errorf("Unable to find mls listings stats for %s", in.SourceSystemKey)
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find mls listings stats for %s", in))
+	}
+
+	stats := &pb.MlsListingsStats{Source: in.SourceSystemKey}
+	if len(facet.Active) > 0 {
+		stats.TotalActiveListings = facet.Active[0].Total
+		stats.ActiveListingsWithPhotos = facet.Active[0].WithPhotos
+		stats.ActiveListingsWithOpenHomes = facet.Active[0].WithOpenHomes
+	}
+	for _, pt := range facet.ByPropertyType {
+		stats.ActiveListingsByPropertyType = append(stats.ActiveListingsByPropertyType,
+			&pb.ActiveListingsByPropertyType{PropertyType: pt.PropertyType, Count: pt.Count})
+	}
+	lastUpdate := facet.LastUpdate[0]
+	stats.ListingsLastUpdateTime = statsTimestamp(lastUpdate.Listings)
+	stats.PhotosLastUpdateTime = statsTimestamp(lastUpdate.Photos)
+	stats.OpenHomesLastUpdateTime = statsTimestamp(lastUpdate.OpenHomes)
+
+	return &pb.GetMlsListingsStatsBySourceResponse{MlsListingsStats: stats}, nil
+}
+
+func (s *StatsService) GetMlsListingsStatsByAgentGuid(ctx context.Context, in *pb.GetMlsListingsStatsByAgentGuidRequest) (*pb.GetMlsListingsStatsByAgentGuidResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/statsByAgentGuid")
+	defer span.End()
+
+	err := validation.Errors{
+		"ListingAgentGuid": validation.Validate(in.ListingAgentGuid, validation.Required),
+	}.Filter()
+
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	mongoCollection := s.MongoDatabase.Collection(s.ListingsCollection)
+
+	opts := options.Count().SetMaxTime(time.Duration(s.MaxQueryTimeSecs) * time.Second)
+	total, err := mongoCollection.CountDocuments(ctx, bson.D{{Key: "dash.listing_agent_guid", Value: in.ListingAgentGuid}, tombstone.NotDeleted()}, opts)
+	if err != nil {
+		log.Errorf("Error while counting mls listings for agent guid: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while counting mls listings for %s", in))
+	}
+
+	return &pb.GetMlsListingsStatsByAgentGuidResponse{TotalListings: total}, nil
+}
+
+// GetMarketStats aggregates the market statistics of areas, see marketstats.Pipeline for what is counted.
+// Statistics are cached in memory and the gateway sends the ttl left as the max age of the Cache-Control header.
+func (s *StatsService) GetMarketStats(ctx context.Context, in *pb.GetMarketStatsRequest) (*pb.GetMarketStatsResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/marketStats")
+	defer span.End()
+
+	err := validation.Errors{
+		"Areas": validation.Validate(in.Areas, validation.Required, validation.Length(1, s.MarketStats.MaxAreas), validation.Each(validation.Required)),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	start, end, err := s.marketStatsWindow(in, time.Now())
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	if cached, ok := s.MarketStatsCache.Get(in); ok {
+		setCacheControl(ctx, s.MarketStatsCache.TTL()-time.Since(cached.GeneratedTime.AsTime()))
+		return cached, nil
+	}
+
+	filter := bson.D{}
+	if in.State != "" {
+		filter = append(filter, bson.E{Key: "property.location.address.state_or_province", Value: in.State})
+	}
+	if in.SourceSystemKey != "" {
+		filter = append(filter, bson.E{Key: "source_system_key", Value: in.SourceSystemKey})
+	}
+	if len(in.PropertyTypes) > 0 {
+		filter = append(filter, bson.E{Key: "property.property_type", Value: bson.D{{Key: "$in", Value: in.PropertyTypes}}})
+	}
+	pipeline := marketstats.Pipeline(in.AreaType, in.Areas, filter, start, end)
+
+	opts := options.Aggregate().SetMaxTime(time.Duration(s.MarketStats.MaxQueryTimeSecs) * time.Second).SetAllowDiskUse(true)
+	cur, err := s.MongoDatabase.Collection(s.ListingsCollection).Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		log.Errorf("Error while aggregating market stats: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while aggregating market stats for %s", in))
+	}
+	var groups []marketstats.Group
+	if err := cur.All(ctx, &groups); err != nil {
+		log.Errorf("Unable to decode the market stats: %v", err)
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Error while aggregating market stats for %s", in))
+	}
+
+	response := &pb.GetMarketStatsResponse{
+		AreaType:      in.AreaType,
+		StartTime:     timestamppb.New(start),
+		EndTime:       timestamppb.New(end),
+		Areas:         marketstats.Summarize(groups, in.Areas, start, end),
+		GeneratedTime: timestamppb.Now(),
+	}
+	if len(response.Areas) == 0 {
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Unable to find listings for the market stats of %s", in))
+	}
+	s.MarketStatsCache.Put(in, response)
+	setCacheControl(ctx, s.MarketStatsCache.TTL())
+	return response, nil
+}
+
+// marketStatsWindow returns the window of a market stats request, by default the days before now.
+func (s *StatsService) marketStatsWindow(in *pb.GetMarketStatsRequest, now time.Time) (time.Time, time.Time, error) {
+	end := now
+	if in.EndTime != nil {
+		end = in.EndTime.AsTime()
+	}
+	start := end.AddDate(0, 0, -int(s.MarketStats.DefaultWindowDays))
+	if in.StartTime != nil {
+		start = in.StartTime.AsTime()
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("endTime must be after startTime")
+	}
+	if end.Sub(start) > time.Duration(s.MarketStats.MaxWindowDays)*24*time.Hour {
+		return start, end, fmt.Errorf("the window from startTime to endTime can not be longer than %d days", s.MarketStats.MaxWindowDays)
+	}
+	return start, end, nil
+}
+
+// setCacheControl sends the time a response may be cached for, the gateway forwards it as the Cache-Control header.
+func setCacheControl(ctx context.Context, maxAge time.Duration) {
+	if maxAge <= 0 {
+		return
+	}
+	value := "max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(cacheControlHeader, value)); err != nil {
+		log.Debugf("Unable to set the Cache-Control header: %v", err)
+	}
+}
+
+func (s *StatsService) HealthCheck(ctx context.Context, in *pb.StatsHealthRequest) (*pb.HealthReply, error) {
+	err := s.MongoDatabase.Client().Ping(ctx, readpref.Nearest(readpref.WithMaxStaleness(90*time.Second)))
+	if err != nil {
+		log.Errorf("Error while pinging mongodb for stats health check : %v", err)
+		return nil, status.Errorf(codes.Unavailable, "mongodb is unavailable")
+	}
+	return &pb.HealthReply{Status: "ok"}, nil
+}
+
+// statsTimestamp converts an aggregated date to a proto timestamp, leaving it unset when no date was found.
+func statsTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"mlslisting/internal/config"
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+	"mlslisting/internal/marketstats"
+)
+
+var marketStatsConfig = &config.MarketStatsConfig{DefaultWindowDays: 30, MaxWindowDays: 366, MaxAreas: 2}
+
+func TestGetMarketStatsInvalid(t *testing.T) {
+	s := &StatsService{MarketStats: marketStatsConfig, MarketStatsCache: marketstats.NewCache(0)}
+	now := time.Now()
+	for _, in := range []*pb.GetMarketStatsRequest{
+		// an area is required.
+		{},
+		{Areas: []string{""}},
+		{Areas: []string{"92618", "92620", "92602"}},
+		{Areas: []string{"92618"}, StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(-time.Hour))},
+		{Areas: []string{"92618"}, StartTime: timestamppb.New(now.AddDate(-2, 0, 0))},
+	} {
+		_, err := s.GetMarketStats(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), in.String())
+	}
+}
+
+func TestGetMarketStatsCached(t *testing.T) {
+	s := &StatsService{MarketStats: marketStatsConfig, MarketStatsCache: marketstats.NewCache(time.Minute)}
+	in := &pb.GetMarketStatsRequest{Areas: []string{"92618"}}
+	stats := &pb.GetMarketStatsResponse{Areas: []*pb.MarketAreaStats{{Area: "92618"}}, GeneratedTime: timestamppb.Now()}
+	s.MarketStatsCache.Put(in, stats)
+
+	// cached statistics are returned without a query.
+	response, err := s.GetMarketStats(context.Background(), in)
+	assert.Nil(t, err)
+	assert.Equal(t, stats, response)
+}
+
+func TestMarketStatsWindow(t *testing.T) {
+	s := &StatsService{MarketStats: marketStatsConfig}
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	start, end, err := s.marketStatsWindow(&pb.GetMarketStatsRequest{}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, end)
+	assert.Equal(t, now.AddDate(0, 0, -30), start)
+
+	// the default window ends at the end time.
+	start, _, err = s.marketStatsWindow(&pb.GetMarketStatsRequest{EndTime: timestamppb.New(now.AddDate(0, -1, 0))}, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.AddDate(0, -1, -30), start)
+
+	_, _, err = s.marketStatsWindow(&pb.GetMarketStatsRequest{StartTime: timestamppb.New(now.AddDate(-1, 0, -2))}, now)
+	assert.True(t, strings.Contains(err.Error(), "366 days"))
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"mlslisting/internal/eventhub"
+	"mlslisting/internal/subscription"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// sendSharedEvents sends the events of a shared change stream until the stream ends or the subscriber is disconnected.
+func (s *Service) sendSharedEvents(ctx context.Context, subscriber *eventhub.Subscriber, checkpointer *subscription.Checkpointer,
+	stream pb.MlsListingService_StreamMlsListingEventServer) error {
+
+	for {
+		event, err := subscriber.Next(ctx)
+		if errors.Is(err, eventhub.ErrSlowConsumer) {
+			log.Warnf("Disconnecting listing event subscriber %s, its buffer is full.", subscriber.Id)
+			return status.Error(codes.ResourceExhausted, "Listing events were not received fast enough. Resume from the last marker received.")
+		}
+		if errors.Is(err, eventhub.ErrInterrupted) {
+			return status.Error(codes.Unavailable, "Listing change stream was interrupted. Resume from the last marker received.")
+		}
+		if err != nil { // deadline of the stream, or the client is gone.
+			log.Debugf("completed streaming listing changes.")
+			return nil
+		}
+
+		// the event is shared by the subscribers, and the listing may be redacted when it is sent.
+		result := proto.Clone(event.Response).(*pb.StreamMlsListingEventResponse)
+		if err := stream.Send(result); err != nil {
+			log.Errorf("Error while streaming mls listings: %v", err)
+			return err
+		}
+		if checkpointer != nil {
+			if err := checkpointer.Sent(ctx, result.MlsChange.Marker, event.ChangeDate); err != nil {
+				log.Errorf("Unable to checkpoint subscription %s. %v", subscriber.Subscription, err)
+			}
+		}
+		log.Debugf("Sending mls [%s] event", result.MlsChange.ChangeType)
+	}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mlslisting/internal/mlsprojection"
+	"mlslisting/internal/subscription"
+	"mlslisting/internal/tombstone"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// openSubscription opens the named subscription of a listing event request and fills in its filters.
+// Without a marker or start time in the request, events are resumed from the checkpoint of the subscription.
+func (s *Service) openSubscription(ctx context.Context, in *pb.StreamMlsListingEventRequest) (*subscription.Subscription, error) {
+	if strings.HasPrefix(in.Subscription, "_") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid input. Subscription names starting with \"_\" are reserved.")
+	}
+	if subscription.IsCatchUpMarker(in.Marker) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid input. Markers of listings sent while catching up do not resume a subscription, reconnect without a marker.")
+	}
+	store := subscription.NewStore(s.MongoDatabase.Collection(s.SubscriptionsCollection))
+	sub, err := store.Open(ctx, in.Subscription, subscription.Filter{
+		SourceSystemKey: in.SourceSystemKey,
+		PropertyType:    in.PropertyType,
+		ChangeType:      in.ChangeType,
+	})
+	if errors.Is(err, subscription.ErrFilterMismatch) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Invalid input. %v", err)
+	}
+	if err != nil {
+		log.Errorf("Unable to open subscription %s. %v", in.Subscription, err)
+		return nil, status.Errorf(codes.Internal, "Unable to open subscription.")
+	}
+
+	in.SourceSystemKey = sub.Filter.SourceSystemKey
+	in.PropertyType = sub.Filter.PropertyType
+	in.ChangeType = sub.Filter.ChangeType
+	// a subscription checkpointed while catching up has no marker to resume, it continues its catch-up.
+	if in.Marker == "" && in.ChangeStartTime == nil && !sub.CatchingUp() {
+		in.Marker = sub.Marker
+	}
+	return sub, nil
+}
+
+// AckMlsListingEvent records the marker of the last event a subscriber processed. The checkpoint of the subscription advances
+// to it when the stream that sent the event checkpoints.
+func (s *Service) AckMlsListingEvent(ctx context.Context, in *pb.AckMlsListingEventRequest) (*pb.AckMlsListingEventResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/ackListingChanges")
+	defer span.End()
+
+	err := validation.Errors{
+		"Subscription": validation.Validate(in.Subscription, validation.Required),
+		"Marker":       validation.Validate(in.Marker, validation.Required),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+	if strings.HasPrefix(in.Subscription, "_") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid input. Subscription names starting with \"_\" are reserved.")
+	}
+
+	store := subscription.NewStore(s.MongoDatabase.Collection(s.SubscriptionsCollection))
+	found, err := store.Ack(ctx, in.Subscription, in.Marker)
+	if err != nil {
+		log.Errorf("Unable to acknowledge events of subscription %s. %v", in.Subscription, err)
+		return nil, status.Errorf(codes.Internal, "Unable to acknowledge events.")
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Subscription %s not found.", in.Subscription)
+	}
+	return &pb.AckMlsListingEventResponse{}, nil
+}
+
+// catchUp sends the listings of a subscription changed since the last change date of its checkpoint, in order of their last
+// change date. It stands in for the change events that are no longer in the oplog, the change stream continues from the time
+// the catch-up started. The listings are sent with catch-up markers, the subscriber acknowledges them as the other events.
+// Hard deletes can not be caught up on, subscriptions to deletes catch up on the tombstones of soft deleted listings.
+func (s *Service) catchUp(ctx context.Context, sub *subscription.Subscription, projection primitive.D,
+	checkpointer *subscription.Checkpointer, stream pb.MlsListingService_StreamMlsListingEventServer) error {
+
+	filter := bson.D{{Key: "last_change_date", Value: bson.D{{Key: "$gte", Value: sub.ChangeDate}}}}
+	if sub.Filter.SourceSystemKey != "" {
+		filter = append(filter, bson.E{Key: "source_system_key", Value: sub.Filter.SourceSystemKey})
+	}
+	if sub.Filter.PropertyType != "" {
+		filter = append(filter, bson.E{Key: "property.property_type", Value: sub.Filter.PropertyType})
+	}
+	changeType := subscription.CatchUpChangeType
+	if sub.Filter.ChangeType == "delete" {
+		filter = append(filter, tombstone.Deleted())
+		changeType = "delete"
+	} else {
+		filter = append(filter, tombstone.NotDeleted())
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "last_change_date", Value: 1}, {Key: "_id", Value: 1}})
+	if projection != nil {
+		findOptions.SetProjection(mlsprojection.Include(projection, "last_change_date"))
+	}
+
+	cursor, err := s.MongoDatabase.Collection(s.ListingsCollection).Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Errorf("Unable to catch up subscription %s. %v", sub.Name, err)
+		return status.Errorf(codes.Internal, "Unable to catch up on listing changes.")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var changed struct {
+			Id             string    `bson:"_id"`
+			LastChangeDate time.Time `bson:"last_change_date"`
+		}
+		var listing pb.MlsListing
+		if err := cursor.Decode(&changed); err != nil {
+			log.Errorf("Unable to decode the mongo document: %v", err)
+			continue
+		}
+		if err := cursor.Decode(&listing); err != nil {
+			log.Errorf("Unable to decode the mongo document: %v", err)
+			continue
+		}
+		result := pb.StreamMlsListingEventResponse{
+			MlsListing: &listing,
+			MlsId:      changed.Id,
+			MlsChange: &pb.MlsChange{
+				Marker:     subscription.CatchUpMarker(changed.Id),
+				ChangeType: changeType,
+				ChangeTime: timestamppb.New(changed.LastChangeDate),
+			},
+		}
+		if err := stream.Send(&result); err != nil {
+			log.Errorf("Error while streaming mls listings: %v", err)
+			return err
+		}
+		if err := checkpointer.Sent(ctx, result.MlsChange.Marker, changed.LastChangeDate); err != nil {
+			log.Errorf("Unable to checkpoint subscription %s. %v", sub.Name, err)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		log.Errorf("Unable to catch up subscription %s. %v", sub.Name, err)
+		return status.Errorf(codes.Internal, "Unable to catch up on listing changes.")
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mlslisting/internal/webhook"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// CreateMlsListingWebhook registers a webhook of the api key of the request, the delivery of events is done by webhook.Dispatcher.
+// Webhooks are managed by the api key that registered them only.
+func (s *Service) CreateMlsListingWebhook(ctx context.Context, in *pb.CreateMlsListingWebhookRequest) (*pb.MlsListingWebhook, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/createWebhook")
+	defer span.End()
+
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = validation.Errors{
+		"Url":        validation.Validate(in.Url, validation.Required, validation.By(webhookUrl)),
+		"ChangeType": validation.Validate(in.ChangeType, validation.In("insert", "update", "replace", "delete")),
+	}.Filter()
+	if err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. %v", err))
+	}
+
+	created, err := s.webhooks().Create(ctx, owner, in)
+	if err != nil {
+		log.Errorf("Unable to create webhook. %v", err)
+		return nil, status.Errorf(codes.Internal, "Unable to create webhook.")
+	}
+	response := webhookResponse(created)
+	response.Secret = created.Secret
+	return response, nil
+}
+
+func (s *Service) ListMlsListingWebhooks(ctx context.Context, in *pb.ListMlsListingWebhooksRequest) (*pb.ListMlsListingWebhooksResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/listWebhooks")
+	defer span.End()
+
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.webhooks().Owned(ctx, owner)
+	if err != nil {
+		log.Errorf("Unable to find webhooks. %v", err)
+		return nil, status.Errorf(codes.Internal, "Unable to find webhooks.")
+	}
+	response := &pb.ListMlsListingWebhooksResponse{Webhooks: []*pb.MlsListingWebhook{}}
+	for _, registered := range webhooks {
+		response.Webhooks = append(response.Webhooks, webhookResponse(registered))
+	}
+	return response, nil
+}
+
+func (s *Service) DeleteMlsListingWebhook(ctx context.Context, in *pb.DeleteMlsListingWebhookRequest) (*pb.DeleteMlsListingWebhookResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/deleteWebhook")
+	defer span.End()
+
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.Validate(in.WebhookId, validation.Required); err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. WebhookId: %v", err))
+	}
+	deleted, err := s.webhooks().Delete(ctx, owner, in.WebhookId)
+	if err != nil {
+		log.Errorf("Unable to delete webhook %s. %v", in.WebhookId, err)
+		return nil, status.Errorf(codes.Internal, "Unable to delete webhook.")
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "Webhook %s not found.", in.WebhookId)
+	}
+	return &pb.DeleteMlsListingWebhookResponse{}, nil
+}
+
+func (s *Service) ListMlsListingWebhookDeadLetters(ctx context.Context, in *pb.ListMlsListingWebhookDeadLettersRequest) (*pb.ListMlsListingWebhookDeadLettersResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/webhookDeadLetters")
+	defer span.End()
+
+	registered, err := s.webhook(ctx, in.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters, err := s.webhooks().DeadLetters(ctx, registered.Id)
+	if err != nil {
+		log.Errorf("Unable to find dead letters of webhook %s. %v", registered.Id, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find dead letters.")
+	}
+	response := &pb.ListMlsListingWebhookDeadLettersResponse{DeadLetters: []*pb.MlsListingWebhookDeadLetter{}}
+	for _, deadLetter := range deadLetters {
+		response.DeadLetters = append(response.DeadLetters, &pb.MlsListingWebhookDeadLetter{
+			DeadLetterId:    deadLetter.Id,
+			WebhookId:       deadLetter.WebhookId,
+			Body:            deadLetter.Body,
+			Error:           deadLetter.Error,
+			Attempts:        deadLetter.Attempts,
+			CreatedTime:     timestamppb.New(deadLetter.CreatedTime),
+			LastAttemptTime: timestamppb.New(deadLetter.LastAttemptTime),
+		})
+	}
+	return response, nil
+}
+
+func (s *Service) ReplayMlsListingWebhookDeadLetters(ctx context.Context, in *pb.ReplayMlsListingWebhookDeadLettersRequest) (*pb.ReplayMlsListingWebhookDeadLettersResponse, error) {
+
+	ctx, span := trace.StartSpan(ctx, "/replayWebhookDeadLetters")
+	defer span.End()
+
+	registered, err := s.webhook(ctx, in.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	sender := webhook.NewSender(time.Duration(s.Webhooks.TimeoutSecs)*time.Second, s.Webhooks.MaxAttempts, time.Duration(s.Webhooks.RetryBackoffSecs)*time.Second)
+	delivered, failed, err := webhook.Replay(ctx, s.webhooks(), sender, registered, in.DeadLetterIds...)
+	if err != nil {
+		log.Errorf("Unable to replay dead letters of webhook %s. %v", registered.Id, err)
+		return nil, status.Errorf(codes.Internal, "Unable to replay dead letters.")
+	}
+	return &pb.ReplayMlsListingWebhookDeadLettersResponse{Delivered: int32(delivered), Failed: int32(failed)}, nil
+}
+
+func (s *Service) webhooks() *webhook.Store {
+	return webhook.NewStore(s.MongoDatabase.Collection(s.WebhooksCollection), s.MongoDatabase.Collection(s.WebhookDeadLettersCollection))
+}
+
+// webhook returns a webhook registered by the api key of the request, or the status error of the request for it. The webhooks
+// of other api keys are not found.
+func (s *Service) webhook(ctx context.Context, webhookId string) (*webhook.Webhook, error) {
+	owner, err := webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.Validate(webhookId, validation.Required); err != nil {
+		log.Errorf("Validation Error. %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid input. WebhookId: %v", err))
+	}
+	registered, err := s.webhooks().Get(ctx, owner, webhookId)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.NotFound, "Webhook %s not found.", webhookId)
+	}
+	if err != nil {
+		log.Errorf("Unable to find webhook %s. %v", webhookId, err)
+		return nil, status.Errorf(codes.Internal, "Unable to find webhook.")
+	}
+	return registered, nil
+}
+
+// webhookResponse returns a registered webhook without its secret.
+func webhookResponse(registered *webhook.Webhook) *pb.MlsListingWebhook {
+	return &pb.MlsListingWebhook{
+		WebhookId:       registered.Id,
+		Url:             registered.Url,
+		SourceSystemKey: registered.SourceSystemKey,
+		PropertyType:    registered.PropertyType,
+		ChangeType:      registered.ChangeType,
+		CreatedTime:     timestamppb.New(registered.CreatedTime),
+	}
+}
+
+// webhookOwner returns the api key of the request, webhooks are not managed without one.
+func webhookOwner(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if apiKey := md.Get("apikey"); len(apiKey) != 0 && apiKey[0] != "" {
+		return apiKey[0], nil
+	}
+	return "", status.Errorf(codes.Unauthenticated, "An api key is required to manage webhooks.")
+}
+
+// webhookUrl validates the url of a webhook. Hosts of the internal network are rejected, the addresses a host resolves to
+// are checked by webhook.Sender when delivering.
+func webhookUrl(value interface{}) error {
+	parsed, err := url.Parse(value.(string))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("must be an absolute http(s) url")
+	}
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if ip := net.ParseIP(host); (ip != nil && !webhook.PublicIP(ip)) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("must not be an internal address")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+func TestWebhookUrl(t *testing.T) {
+	assert.Nil(t, webhookUrl("https://example.com/mls/events"))
+	assert.Nil(t, webhookUrl("http://203.0.113.7:8080/events"))
+	for _, url := range []string{
+		"example.com/mls/events",
+		"ftp://example.com/events",
+		"http://localhost:8080/events",
+		"http://LOCALHOST./events",
+		"http://127.0.0.1/events",
+		"http://10.0.0.12/events",
+		"http://192.168.1.1/events",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/events",
+		"http://[fd00::1]/events",
+		"http://0.0.0.0/events",
+	} {
+		assert.NotNil(t, webhookUrl(url), url)
+	}
+}
+
+func TestWebhooksWithoutApiKey(t *testing.T) {
+	s := &Service{}
+	_, err := s.CreateMlsListingWebhook(context.Background(), &pb.CreateMlsListingWebhookRequest{Url: "https://example.com/mls/events"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.ListMlsListingWebhooks(context.Background(), &pb.ListMlsListingWebhooksRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.DeleteMlsListingWebhook(context.Background(), &pb.DeleteMlsListingWebhookRequest{WebhookId: "1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.ReplayMlsListingWebhookDeadLetters(context.Background(), &pb.ReplayMlsListingWebhookDeadLettersRequest{WebhookId: "1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// an internal url is invalid.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("apikey", "key"))
+	_, err = s.CreateMlsListingWebhook(ctx, &pb.CreateMlsListingWebhookRequest{Url: "http://169.254.169.254/latest/meta-data"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
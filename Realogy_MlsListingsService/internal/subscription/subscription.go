@@ -0,0 +1,208 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CatchUpChangeType is the change type of listings sent while a subscription catches up on changes no longer in the oplog.
+const CatchUpChangeType = "catchup"
+
+const catchUpMarkerPrefix = CatchUpChangeType + ":"
+
+// maxSent is the number of events sent a stream keeps for their acknowledgement, older events are no longer checkpointed.
+const maxSent = 10000
+
+// ErrFilterMismatch is returned when a subscription is opened with other filters than it was created with.
+var ErrFilterMismatch = errors.New("subscription filters can not be changed")
+
+// Filter is the part of a listing event request a subscription is created with.
+type Filter struct {
+	SourceSystemKey string `bson:"source_system_key"`
+	PropertyType    string `bson:"property_type"`
+	ChangeType      string `bson:"change_type"`
+}
+
+// Subscription is a named listing event subscription as stored in the subscriptions collection.
+type Subscription struct {
+	Name   string `bson:"_id"`
+	Filter Filter `bson:"filter"`
+	// marker of the last event acknowledged by the subscriber: a change stream resume token, or a catch-up marker.
+	Marker string `bson:"marker"`
+	// last change date of the listing of the last event acknowledged, catch-up scans start from it when the marker can not
+	// resume the change stream.
+	ChangeDate time.Time `bson:"change_date"`
+	// marker of the last event the subscriber acknowledged, the stream that sent it checkpoints it.
+	AckedMarker string    `bson:"acked_marker"`
+	CreatedAt   time.Time `bson:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// CatchingUp tells whether the subscription was checkpointed while catching up, its marker does not resume a change stream.
+func (s *Subscription) CatchingUp() bool {
+	return IsCatchUpMarker(s.Marker)
+}
+
+// CatchUpMarker is the marker of a listing sent while catching up. It is acknowledged as the other markers, but does not resume
+// a change stream.
+func CatchUpMarker(id string) string {
+	return catchUpMarkerPrefix + id
+}
+
+// IsCatchUpMarker tells whether a marker is the one of a listing sent while catching up.
+func IsCatchUpMarker(marker string) bool {
+	return strings.HasPrefix(marker, catchUpMarkerPrefix)
+}
+
+// Store persists subscriptions and their checkpoints.
+type Store struct {
+	collection *mongo.Collection
+	now        func() time.Time
+}
+
+func NewStore(collection *mongo.Collection) *Store {
+	return &Store{collection: collection, now: time.Now}
+}
+
+// Open returns the subscription of the name, created with the filter when it does not exist yet.
+// An empty filter opens an existing subscription with its own filter.
+func (s *Store) Open(ctx context.Context, name string, filter Filter) (*Subscription, error) {
+	now := s.now()
+	_, err := s.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: name}}, bson.D{{Key: "$setOnInsert", Value: bson.D{
+		{Key: "filter", Value: filter},
+		{Key: "marker", Value: ""},
+		{Key: "created_at", Value: now},
+		{Key: "updated_at", Value: now},
+	}}}, options.Update().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(err) { // concurrent upserts of a new subscription, one of them wins.
+		return nil, err
+	}
+
+	var subscription Subscription
+	if err := s.collection.FindOne(ctx, bson.D{{Key: "_id", Value: name}}).Decode(&subscription); err != nil {
+		return nil, err
+	}
+	if err := subscription.match(filter); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// Checkpoint records the last event acknowledged on a subscription, with the last change date of its listing.
+func (s *Store) Checkpoint(ctx context.Context, name string, marker string, changeDate time.Time) error {
+	_, err := s.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: name}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "marker", Value: marker},
+		{Key: "change_date", Value: changeDate},
+		{Key: "updated_at", Value: s.now()},
+	}}})
+	return err
+}
+
+// Ack records the marker of the last event the subscriber of a subscription acknowledged, it returns false when the
+// subscription does not exist.
+func (s *Store) Ack(ctx context.Context, name string, marker string) (bool, error) {
+	result, err := s.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: name}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "acked_marker", Value: marker},
+		{Key: "updated_at", Value: s.now()},
+	}}})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// Acked returns the marker of the last event the subscriber of a subscription acknowledged.
+func (s *Store) Acked(ctx context.Context, name string) (string, error) {
+	var subscription Subscription
+	err := s.collection.FindOne(ctx, bson.D{{Key: "_id", Value: name}}, options.FindOne().SetProjection(bson.D{{Key: "acked_marker", Value: 1}})).Decode(&subscription)
+	return subscription.AckedMarker, err
+}
+
+// match checks a requested filter against the filter the subscription was created with.
+func (s *Subscription) match(filter Filter) error {
+	if filter == (Filter{}) || filter == s.Filter {
+		return nil
+	}
+	return fmt.Errorf("%w, %q was created with %+v", ErrFilterMismatch, s.Name, s.Filter)
+}
+
+// sentEvent is an event sent on a subscription, waiting for its acknowledgement.
+type sentEvent struct {
+	marker     string
+	changeDate time.Time
+}
+
+// Checkpointer keeps the events sent on a subscription until the subscriber acknowledges them, and checkpoints the last event
+// acknowledged at most once per interval. Acknowledgements are recorded by Store.Ack, on any instance of the service: the
+// checkpoint only advances to markers the subscriber confirmed.
+type Checkpointer struct {
+	acked    func(ctx context.Context) (string, error)
+	save     func(ctx context.Context, marker string, changeDate time.Time) error
+	interval time.Duration
+	now      func() time.Time
+
+	// events sent since the last checkpoint, oldest first.
+	sent         []sentEvent
+	checkpointed string
+	savedAt      time.Time
+}
+
+// NewCheckpointer returns the checkpointer of a stream of the subscription, from its current checkpoint.
+func NewCheckpointer(store *Store, sub *Subscription, interval time.Duration) *Checkpointer {
+	acked := func(ctx context.Context) (string, error) {
+		return store.Acked(ctx, sub.Name)
+	}
+	save := func(ctx context.Context, marker string, changeDate time.Time) error {
+		return store.Checkpoint(ctx, sub.Name, marker, changeDate)
+	}
+	return &Checkpointer{acked: acked, save: save, interval: interval, now: time.Now, checkpointed: sub.Marker, savedAt: time.Now()}
+}
+
+// Sent records an event sent to the subscriber with the last change date of its listing, zero for an event without a listing.
+// The last acknowledged event is checkpointed when the interval has passed since the last checkpoint.
+func (c *Checkpointer) Sent(ctx context.Context, marker string, changeDate time.Time) error {
+	if changeDate.IsZero() && len(c.sent) > 0 {
+		changeDate = c.sent[len(c.sent)-1].changeDate
+	}
+	c.sent = append(c.sent, sentEvent{marker: marker, changeDate: changeDate})
+	if len(c.sent) > maxSent {
+		c.sent = c.sent[len(c.sent)-maxSent:]
+	}
+	if c.now().Sub(c.savedAt) < c.interval {
+		return nil
+	}
+	return c.Flush(ctx)
+}
+
+// Flush checkpoints the last event acknowledged, if it was sent by this stream since the last checkpoint.
+func (c *Checkpointer) Flush(ctx context.Context) error {
+	if len(c.sent) == 0 {
+		return nil
+	}
+	c.savedAt = c.now()
+	acked, err := c.acked(ctx)
+	if err != nil {
+		return err
+	}
+	if acked == "" || acked == c.checkpointed {
+		return nil
+	}
+	for i := len(c.sent) - 1; i >= 0; i-- {
+		if c.sent[i].marker != acked {
+			continue
+		}
+		if err := c.save(ctx, acked, c.sent[i].changeDate); err != nil {
+			return err
+		}
+		c.checkpointed, c.sent = acked, c.sent[i+1:]
+		return nil
+	}
+	return nil
+}
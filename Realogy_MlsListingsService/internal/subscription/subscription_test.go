@@ -0,0 +1,109 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	sub := &Subscription{Name: "sync", Filter: Filter{SourceSystemKey: "CABCREIS", ChangeType: "update"}}
+
+	assert.Nil(t, sub.match(Filter{}))
+	assert.Nil(t, sub.match(Filter{SourceSystemKey: "CABCREIS", ChangeType: "update"}))
+
+	err := sub.match(Filter{SourceSystemKey: "AR_TMLS"})
+	assert.True(t, errors.Is(err, ErrFilterMismatch))
+}
+
+type saved struct {
+	marker     string
+	changeDate time.Time
+}
+
+func checkpointer(interval time.Duration, now *time.Time, acked *string) (*Checkpointer, *[]saved) {
+	checkpoints := &[]saved{}
+	c := &Checkpointer{
+		acked: func(ctx context.Context) (string, error) {
+			return *acked, nil
+		},
+		save: func(ctx context.Context, marker string, changeDate time.Time) error {
+			*checkpoints = append(*checkpoints, saved{marker, changeDate})
+			return nil
+		},
+		interval: interval,
+		now:      func() time.Time { return *now },
+		savedAt:  *now,
+	}
+	return c, checkpoints
+}
+
+func TestCheckpointerInterval(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	acked := "1"
+	c, checkpoints := checkpointer(5*time.Second, &now, &acked)
+	ctx := context.Background()
+
+	assert.Nil(t, c.Sent(ctx, "1", now))
+	assert.Empty(t, *checkpoints)
+
+	changeDate := now
+	now = now.Add(5 * time.Second)
+	assert.Nil(t, c.Sent(ctx, "2", now))
+	assert.Equal(t, []saved{{"1", changeDate}}, *checkpoints)
+
+	// nothing acknowledged since the last save.
+	assert.Nil(t, c.Flush(ctx))
+	assert.Len(t, *checkpoints, 1)
+
+	acked = "2"
+	assert.Nil(t, c.Sent(ctx, "3", now))
+	assert.Nil(t, c.Flush(ctx))
+	assert.Equal(t, saved{"2", now}, (*checkpoints)[1])
+}
+
+// the checkpoint only advances to markers the subscriber acknowledged.
+func TestCheckpointerAcked(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	acked := ""
+	c, checkpoints := checkpointer(0, &now, &acked)
+	ctx := context.Background()
+
+	assert.Nil(t, c.Sent(ctx, "1", now))
+	assert.Nil(t, c.Sent(ctx, "2", now.Add(time.Hour)))
+	assert.Empty(t, *checkpoints)
+
+	// a marker this stream did not send.
+	acked = "other"
+	assert.Nil(t, c.Flush(ctx))
+	assert.Empty(t, *checkpoints)
+
+	// an event without a listing keeps the change date of the event before it.
+	acked = "3"
+	assert.Nil(t, c.Sent(ctx, "3", time.Time{}))
+	assert.Equal(t, []saved{{"3", now.Add(time.Hour)}}, *checkpoints)
+	assert.Empty(t, c.sent)
+
+	// catch-up markers are acknowledged as the others.
+	acked = CatchUpMarker("CABCREIS_1")
+	assert.Nil(t, c.Sent(ctx, acked, now))
+	assert.Equal(t, saved{acked, now}, (*checkpoints)[1])
+	assert.True(t, (&Subscription{Marker: acked}).CatchingUp())
+	assert.False(t, (&Subscription{Marker: "826"}).CatchingUp())
+}
+
+func TestCheckpointerSaveError(t *testing.T) {
+	now := time.Now()
+	acked := "1"
+	c, _ := checkpointer(0, &now, &acked)
+	c.save = func(ctx context.Context, marker string, changeDate time.Time) error {
+		return errors.New("unavailable")
+	}
+
+	assert.NotNil(t, c.Sent(context.Background(), "1", now))
+	// the event is kept for the next save.
+	assert.Len(t, c.sent, 1)
+}
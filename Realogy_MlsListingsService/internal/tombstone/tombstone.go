@@ -0,0 +1,63 @@
+// Package tombstone has the soft delete of listing documents. Soft deleted listings are kept as tombstones, they are
+// excluded from reads and seen by change streams as deletes.
+package tombstone
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// listing document fields of tombstones.
+const (
+	DeletedField     = "deleted"
+	DeletedTimeField = "deleted_time"
+	DeletedByField   = "deleted_by"
+)
+
+// Exclude returns a filter of the listings of filter that are not soft deleted. Filter is not modified.
+func Exclude(filter primitive.D) primitive.D {
+	excluded := make(primitive.D, 0, len(filter)+1)
+	return append(append(excluded, filter...), NotDeleted())
+}
+
+// NotDeleted is the condition of listings that are not soft deleted, documents written before tombstones have no deleted field.
+func NotDeleted() bson.E {
+	return bson.E{Key: DeletedField, Value: bson.D{{Key: "$ne", Value: true}}}
+}
+
+// Deleted is the condition of soft deleted listings.
+func Deleted() bson.E {
+	return bson.E{Key: DeletedField, Value: true}
+}
+
+// ExcludeSearch returns a $search stage document of the listings of search that are not soft deleted. The search operator
+// is a "must" clause of a compound operator, the search indexes map the deleted field as a boolean.
+func ExcludeSearch(search primitive.D) primitive.D {
+	excluded := primitive.D{}
+	var operators bson.A
+	for _, e := range search {
+		if e.Key == "index" {
+			excluded = append(excluded, e)
+			continue
+		}
+		operators = append(operators, bson.D{e})
+	}
+	return append(excluded, bson.E{Key: "compound", Value: bson.D{
+		{Key: "must", Value: operators},
+		{Key: "mustNot", Value: bson.A{bson.D{{Key: "equals", Value: bson.D{{Key: "path", Value: DeletedField}, {Key: "value", Value: true}}}}}},
+	}})
+}
+
+// ChangeStage is the change stream stage that turns the update soft deleting a listing into a "delete" event, and the one
+// restoring it into an "insert" event. It goes before the stages matching the operation type of events.
+func ChangeStage() bson.D {
+	softDeleted := bson.D{{Key: "$eq", Value: bson.A{"$updateDescription.updatedFields." + DeletedField, true}}}
+	restored := bson.D{{Key: "$in", Value: bson.A{DeletedField, bson.D{{Key: "$ifNull", Value: bson.A{"$updateDescription.removedFields", bson.A{}}}}}}}
+	return bson.D{{Key: "$addFields", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$switch", Value: bson.D{
+		{Key: "branches", Value: bson.A{
+			bson.D{{Key: "case", Value: softDeleted}, {Key: "then", Value: "delete"}},
+			bson.D{{Key: "case", Value: restored}, {Key: "then", Value: "insert"}},
+		}},
+		{Key: "default", Value: "$operationType"},
+	}}}}}}}
+}
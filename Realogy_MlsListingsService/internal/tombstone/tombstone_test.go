@@ -0,0 +1,52 @@
+package tombstone_test
+
+import (
+	"mlslisting/internal/tombstone"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestExclude(t *testing.T) {
+	filter := make(primitive.D, 1, 4)
+	filter[0] = bson.E{Key: "source_system_key", Value: "SOLO"}
+
+	excluded := tombstone.Exclude(filter)
+	assert.Equal(t, primitive.D{
+		{Key: "source_system_key", Value: "SOLO"},
+		{Key: "deleted", Value: bson.D{{Key: "$ne", Value: true}}},
+	}, excluded)
+	// the filter is kept as is, even with room to append to it.
+	assert.Equal(t, primitive.D{{Key: "source_system_key", Value: "SOLO"}}, filter)
+	assert.Equal(t, primitive.D{{Key: "deleted", Value: bson.D{{Key: "$ne", Value: true}}}}, tombstone.Exclude(nil))
+}
+
+func TestExcludeSearch(t *testing.T) {
+	search := primitive.D{
+		{Key: "index", Value: "listingIdSearchIdx"},
+		{Key: "wildcard", Value: bson.D{{Key: "path", Value: "listing_id"}, {Key: "query", Value: "1000*"}}},
+	}
+	data, err := bson.MarshalExtJSON(tombstone.ExcludeSearch(search), false, false)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"index": "listingIdSearchIdx",
+		"compound": {
+			"must": [{"wildcard": {"path": "listing_id", "query": "1000*"}}],
+			"mustNot": [{"equals": {"path": "deleted", "value": true}}]
+		}
+	}`, string(data))
+}
+
+func TestChangeStage(t *testing.T) {
+	data, err := bson.MarshalExtJSON(tombstone.ChangeStage(), false, false)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"$addFields": {"operationType": {"$switch": {
+		"branches": [
+			{"case": {"$eq": ["$updateDescription.updatedFields.deleted", true]}, "then": "delete"},
+			{"case": {"$in": ["deleted", {"$ifNull": ["$updateDescription.removedFields", []]}]}, "then": "insert"}
+		],
+		"default": "$operationType"
+	}}}}`, string(data))
+}
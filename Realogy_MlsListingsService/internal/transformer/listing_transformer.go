@@ -17,6 +17,7 @@ func TransformUpdateListingInputToUpdateMlsListing(in *pb.UpdateMlsListingByList
 	var cancelDate time.Time
 	var pendingDate time.Time
 	var closeDate time.Time
+	var remarks *models.UpdateRemarks
 	if !mlsvalidation.IsEmpty(in.Property) && !mlsvalidation.IsEmpty(in.Property.Listing) {
 		standardStatus = in.Property.Listing.StandardStatus
 		if !mlsvalidation.IsEmpty(in.Property.Listing.Price) {
@@ -40,6 +41,13 @@ func TransformUpdateListingInputToUpdateMlsListing(in *pb.UpdateMlsListingByList
 				closeDate = in.Property.Listing.Dates.CloseDate.AsTime()
 			}
 		}
+		if !mlsvalidation.IsEmpty(in.Property.Listing.Remarks) {
+			remarks = &models.UpdateRemarks{
+				PublicRemarks:   in.Property.Listing.Remarks.PublicRemarks,
+				PrivateRemarks:  in.Property.Listing.Remarks.PrivateRemarks,
+				SellingComments: in.Property.Listing.Remarks.SellingComments,
+			}
+		}
 	}
 
 	return models.UpdateMLSListing{
@@ -59,6 +67,7 @@ func TransformUpdateListingInputToUpdateMlsListing(in *pb.UpdateMlsListingByList
 					PendingTimestamp:    pendingDate,
 					CloseDate:           closeDate,
 				},
+				Remarks: remarks,
 			},
 		},
 	}
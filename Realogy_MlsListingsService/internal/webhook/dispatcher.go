@@ -0,0 +1,331 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"mlslisting/internal/derived"
+	"mlslisting/internal/subscription"
+	"mlslisting/internal/tombstone"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+const (
+	// subscription the dispatcher checkpoints its position in the change stream to, names starting with "_" are reserved.
+	checkpointName = "_webhooks"
+
+	retryDelay = 10 * time.Second
+)
+
+// errQueueFull is the error of the dead letters of the batches a full queue could not take.
+var errQueueFull = errors.New("delivery queue of the webhook is full")
+
+// batches are marshalled as the gateway marshals responses.
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// listingEvent is a listing change event along with the listing fields the webhook filters match.
+type listingEvent struct {
+	EventData struct {
+		EventId string `bson:"_data"`
+	} `bson:"_id"`
+	EventType   string              `bson:"operationType"`
+	EventTime   primitive.Timestamp `bson:"clusterTime"`
+	DocumentKey struct {
+		Id string `bson:"_id"`
+	} `bson:"documentKey"`
+	Listing *listingFields `bson:"fullDocument"`
+}
+
+type listingEventDocument struct {
+	Listing *pb.MlsListing `bson:"fullDocument"`
+}
+
+// Dispatcher delivers the change events of the listings collection to the registered webhooks in batches.
+// Deliveries are not coordinated across instances of the service, a single instance should run the dispatcher.
+type Dispatcher struct {
+	listings      *mongo.Collection
+	store         *Store
+	checkpoints   *subscription.Store
+	sender        *Sender
+	batchSize     int
+	batchInterval time.Duration
+	queueSize     int
+}
+
+// NewDispatcher returns a dispatcher keeping up to queueSize batches per webhook waiting for delivery.
+func NewDispatcher(listings *mongo.Collection, store *Store, checkpoints *subscription.Store, sender *Sender, batchSize int, batchInterval time.Duration, queueSize int) *Dispatcher {
+	return &Dispatcher{listings: listings, store: store, checkpoints: checkpoints, sender: sender, batchSize: batchSize, batchInterval: batchInterval, queueSize: queueSize}
+}
+
+// Run delivers listing changes until ctx is done. The change stream is resumed after the last batch delivered along with all
+// the batches before it, or started from now when that batch is no longer in the oplog.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := d.watch(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Webhook change stream failed, restarting. %v", err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (d *Dispatcher) watch(ctx context.Context) error {
+	checkpoint, err := d.checkpoints.Open(ctx, checkpointName, subscription.Filter{})
+	if err != nil {
+		return err
+	}
+	pipeline := mongo.Pipeline{
+		// soft deletes and restores are delivered as the delete and insert events they stand for.
+		tombstone.ChangeStage(),
+		// geo locations and duplicate markers are written to the listings by the service, they are not listing changes.
+		derived.ChangeStage(),
+		bson.D{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace", "delete"}}}}}}},
+	}
+	changeStreamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup).SetMaxAwaitTime(d.batchInterval)
+	if checkpoint.Marker != "" {
+		changeStreamOptions.SetResumeAfter(bson.D{{Key: "_data", Value: checkpoint.Marker}})
+	}
+	cs, err := d.listings.Watch(ctx, pipeline, changeStreamOptions)
+	if err != nil && checkpoint.Marker != "" {
+		log.Warnf("Unable to resume webhooks after %s, changes until now are not delivered. %v", checkpoint.Marker, err)
+		cs, err = d.listings.Watch(ctx, pipeline, changeStreamOptions.SetResumeAfter(nil))
+	}
+	if err != nil {
+		return err
+	}
+	defer cs.Close(context.Background())
+
+	// the queues stop with the change stream, the batches they have not delivered are delivered again on restart.
+	ctx, cancel := context.WithCancel(ctx)
+	queues := &queues{dispatcher: d, byWebhook: map[string]chan *delivery{}}
+	defer queues.stop(cancel)
+
+	var events []*listingEvent
+	var responses []*pb.StreamMlsListingEventResponse
+	var dispatched []*batch
+	flushAt := time.Now().Add(d.batchInterval)
+	for {
+		if cs.TryNext(ctx) {
+			var event listingEvent
+			var document listingEventDocument
+			if err := cs.Decode(&event); err != nil {
+				log.Errorf("Unable to decode listing event for webhooks. %v", err)
+				continue
+			}
+			if err := cs.Decode(&document); err != nil {
+				log.Errorf("Unable to decode listing event for webhooks. %v", err)
+				continue
+			}
+			events = append(events, &event)
+			responses = append(responses, event.response(document.Listing))
+		} else if err := cs.Err(); err != nil {
+			return err
+		}
+		if dispatched, err = d.checkpointDelivered(ctx, dispatched); err != nil {
+			return err
+		}
+		if len(events) < d.batchSize && time.Now().Before(flushAt) {
+			continue
+		}
+		if len(events) > 0 {
+			dispatchedBatch, err := queues.dispatch(ctx, events, responses)
+			if err != nil {
+				return err
+			}
+			dispatched = append(dispatched, dispatchedBatch)
+			events, responses = nil, nil
+		}
+		flushAt = time.Now().Add(d.batchInterval)
+	}
+}
+
+// checkpointDelivered checkpoints the last of the dispatched batches delivered along with all the batches before it, it returns
+// the batches still being delivered.
+func (d *Dispatcher) checkpointDelivered(ctx context.Context, dispatched []*batch) ([]*batch, error) {
+	delivered := 0
+	for delivered < len(dispatched) && dispatched[delivered].delivered() {
+		delivered++
+	}
+	if delivered == 0 {
+		return dispatched, nil
+	}
+	last := dispatched[delivered-1].last
+	var changeDate time.Time
+	if last.Listing != nil {
+		changeDate = last.Listing.LastChangeDate
+	}
+	if err := d.checkpoints.Checkpoint(ctx, checkpointName, last.EventData.EventId, changeDate); err != nil {
+		return dispatched, err
+	}
+	return dispatched[delivered:], nil
+}
+
+// batch is a dispatched batch of events, delivered once the deliveries to all the webhooks matching its events are done.
+type batch struct {
+	last    *listingEvent
+	pending int32
+}
+
+func (b *batch) delivered() bool {
+	return atomic.LoadInt32(&b.pending) == 0
+}
+
+func (b *batch) done() {
+	atomic.AddInt32(&b.pending, -1)
+}
+
+// delivery is the part of a batch for a webhook.
+type delivery struct {
+	webhook *Webhook
+	body    []byte
+	count   int
+	batch   *batch
+}
+
+// queues deliver the batches of each webhook in order, apart from the other webhooks: a slow webhook only delays its own
+// deliveries. A batch is kept as a dead letter when its attempts are exhausted, or when the queue of its webhook is full.
+type queues struct {
+	dispatcher *Dispatcher
+	byWebhook  map[string]chan *delivery
+	workers    sync.WaitGroup
+}
+
+// dispatch queues the deliveries of a batch to each webhook matching some of its events.
+func (q *queues) dispatch(ctx context.Context, events []*listingEvent, responses []*pb.StreamMlsListingEventResponse) (*batch, error) {
+	webhooks, err := q.dispatcher.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q.refresh(ctx, webhooks)
+
+	dispatched := &batch{last: events[len(events)-1]}
+	var deliveries []*delivery
+	for _, webhook := range webhooks {
+		var matched []*pb.StreamMlsListingEventResponse
+		for i, event := range events {
+			if webhook.matches(event.EventType, event.Listing) {
+				matched = append(matched, responses[i])
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		body, err := marshalOptions.Marshal(&pb.MlsListingWebhookBatch{WebhookId: webhook.Id, Events: matched})
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &delivery{webhook: webhook, body: body, count: len(matched), batch: dispatched})
+	}
+	dispatched.pending = int32(len(deliveries))
+
+	for _, delivery := range deliveries {
+		select {
+		case q.byWebhook[delivery.webhook.Id] <- delivery:
+		default:
+			log.Errorf("Delivery queue of webhook %s is full, %d listing events are kept as a dead letter.", delivery.webhook.Id, delivery.count)
+			if err := q.dispatcher.store.AddDeadLetter(ctx, delivery.webhook.Id, delivery.body, 0, errQueueFull); err != nil {
+				log.Errorf("Unable to keep dead letter of webhook %s. %v", delivery.webhook.Id, err)
+			}
+			dispatched.done()
+		}
+	}
+	return dispatched, nil
+}
+
+// refresh starts the queues of new webhooks and stops the ones of deleted webhooks.
+func (q *queues) refresh(ctx context.Context, webhooks []*Webhook) {
+	registered := map[string]bool{}
+	for _, webhook := range webhooks {
+		registered[webhook.Id] = true
+		if _, ok := q.byWebhook[webhook.Id]; !ok {
+			deliveries := make(chan *delivery, q.dispatcher.queueSize)
+			q.byWebhook[webhook.Id] = deliveries
+			q.workers.Add(1)
+			go q.deliver(ctx, deliveries)
+		}
+	}
+	for webhookId, deliveries := range q.byWebhook {
+		if !registered[webhookId] {
+			close(deliveries)
+			delete(q.byWebhook, webhookId)
+		}
+	}
+}
+
+// deliver delivers the batches of a queue until it is stopped. Nothing is dead lettered when ctx is done, and the batch is
+// not done: it is delivered again on restart.
+func (q *queues) deliver(ctx context.Context, deliveries <-chan *delivery) {
+	defer q.workers.Done()
+	for delivery := range deliveries {
+		attempts, err := q.dispatcher.sender.Send(ctx, delivery.webhook, delivery.body)
+		if err != nil && ctx.Err() != nil {
+			continue
+		}
+		if err != nil {
+			log.Errorf("Unable to deliver %d listing events to webhook %s after %d attempts. %v", delivery.count, delivery.webhook.Id, attempts, err)
+			if err := q.dispatcher.store.AddDeadLetter(ctx, delivery.webhook.Id, delivery.body, attempts, err); err != nil {
+				log.Errorf("Unable to keep dead letter of webhook %s. %v", delivery.webhook.Id, err)
+			}
+		}
+		delivery.batch.done()
+	}
+}
+
+// stop cancels the deliveries in progress and waits for the queues to stop.
+func (q *queues) stop(cancel context.CancelFunc) {
+	cancel()
+	for webhookId, deliveries := range q.byWebhook {
+		close(deliveries)
+		delete(q.byWebhook, webhookId)
+	}
+	q.workers.Wait()
+}
+
+// response returns the event as StreamMlsListingEvent streams it.
+func (e *listingEvent) response(listing *pb.MlsListing) *pb.StreamMlsListingEventResponse {
+	return &pb.StreamMlsListingEventResponse{
+		MlsId:      e.DocumentKey.Id,
+		MlsListing: listing,
+		MlsChange: &pb.MlsChange{
+			Marker:     e.EventData.EventId,
+			ChangeType: e.EventType,
+			ChangeTime: timestamppb.New(time.Unix(int64(e.EventTime.T), 0)),
+		},
+	}
+}
+
+// Replay delivers dead letters of a webhook again, all of them when no ids are given. Delivered dead letters are removed,
+// the attempts and error of the others are updated.
+func Replay(ctx context.Context, store *Store, sender *Sender, webhook *Webhook, deadLetterIds ...string) (delivered int, failed int, err error) {
+	deadLetters, err := store.DeadLetters(ctx, webhook.Id, deadLetterIds...)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, deadLetter := range deadLetters {
+		attempts, sendErr := sender.Send(ctx, webhook, []byte(deadLetter.Body))
+		if sendErr == nil {
+			delivered++
+			err = store.RemoveDeadLetter(ctx, deadLetter.Id)
+		} else {
+			failed++
+			err = store.RetriedDeadLetter(ctx, deadLetter.Id, attempts, sendErr)
+		}
+		if err != nil {
+			return delivered, failed, err
+		}
+	}
+	return delivered, failed, nil
+}
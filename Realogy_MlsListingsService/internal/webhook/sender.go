@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TimestampHeader = "X-Mls-Timestamp"
+	SignatureHeader = "X-Mls-Signature"
+)
+
+// ErrInternalAddress is the error of a delivery to an address of the internal network.
+var ErrInternalAddress = errors.New("webhooks are not delivered to internal addresses")
+
+// PublicIP tells whether an address may be the one of a webhook: loopback, private, link-local, multicast and unspecified
+// addresses are internal to the network of the service.
+func PublicIP(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// publicOnly is the dialer control refusing connections to internal addresses. Addresses are checked once resolved, a webhook
+// host resolving to an internal address, or redirecting to one, is refused as well.
+func publicOnly(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !PublicIP(net.ParseIP(host)) {
+		return ErrInternalAddress
+	}
+	return nil
+}
+
+// Sender POSTs signed batches to webhooks.
+type Sender struct {
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+// NewSender returns a sender making up to maxAttempts attempts per batch, waiting backoff after the first failed attempt
+// and twice as long after each following one.
+func NewSender(timeout time.Duration, maxAttempts int, backoff time.Duration) *Sender {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// webhooks are dialed directly, for their addresses to be checked.
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: publicOnly}).DialContext
+	return &Sender{client: &http.Client{Timeout: timeout, Transport: transport}, maxAttempts: maxAttempts, backoff: backoff, now: time.Now}
+}
+
+// Sign returns the signature of a batch body sent at timestamp (unix seconds), the hex HMAC-SHA256 of "<timestamp>.<body>".
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send delivers a batch body to a webhook, retrying failed attempts with backoff. Client errors other than 429, and internal
+// addresses, are not retried.
+// It returns the number of attempts made and the error of the last one.
+func (s *Sender) Send(ctx context.Context, webhook *Webhook, body []byte) (int, error) {
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := s.post(ctx, webhook, body)
+		if err == nil {
+			return attempt, nil
+		}
+		if !retry || attempt == s.maxAttempts {
+			return attempt, err
+		}
+		log.Warnf("Unable to deliver listing events to webhook %s, attempt %d of %d. %v", webhook.Id, attempt, s.maxAttempts, err)
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post makes a single delivery attempt, telling whether a failed attempt may be retried.
+func (s *Sender) post(ctx context.Context, webhook *Webhook, body []byte) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(TimestampHeader, timestamp)
+	request.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return !errors.Is(err, ErrInternalAddress), err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(response.Body, 4096)) // lets the connection be reused.
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+	retry := response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook responded %s", response.Status)
+}
@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	pb "mlslisting/internal/generated/realogy.com/api/mls/v1"
+)
+
+// Webhook is a registered webhook as stored in the webhooks collection.
+type Webhook struct {
+	Id              string    `bson:"_id"`
+	Owner           string    `bson:"owner"` // the api key that registered the webhook, only its owner manages it.
+	Url             string    `bson:"url"`
+	SourceSystemKey string    `bson:"source_system_key"`
+	PropertyType    string    `bson:"property_type"`
+	ChangeType      string    `bson:"change_type"`
+	Secret          string    `bson:"secret"`
+	CreatedTime     time.Time `bson:"created_time"`
+}
+
+// DeadLetter is a batch that could not be delivered, as stored in the dead letters collection.
+type DeadLetter struct {
+	Id              string    `bson:"_id"`
+	WebhookId       string    `bson:"webhook_id"`
+	Body            string    `bson:"body"`
+	Error           string    `bson:"error"`
+	Attempts        int32     `bson:"attempts"`
+	CreatedTime     time.Time `bson:"created_time"`
+	LastAttemptTime time.Time `bson:"last_attempt_time"`
+}
+
+// listingFields are the fields of a changed listing the webhook filters match, they are not part of pb.MlsListing.
+type listingFields struct {
+	SourceSystemKey string    `bson:"source_system_key"`
+	LastChangeDate  time.Time `bson:"last_change_date"`
+	Property        struct {
+		PropertyType string `bson:"property_type"`
+	} `bson:"property"`
+}
+
+// matches tells whether an event is delivered to the webhook. The filters are those of StreamMlsListingEvent,
+// all events except deletes are delivered by default. Hard deletes have no listing, they only match webhooks without source or property type.
+func (w *Webhook) matches(changeType string, listing *listingFields) bool {
+	if w.ChangeType == "" && changeType == "delete" || w.ChangeType != "" && w.ChangeType != changeType {
+		return false
+	}
+	if w.SourceSystemKey == "" && w.PropertyType == "" {
+		return true
+	}
+	if listing == nil {
+		return false
+	}
+	return (w.SourceSystemKey == "" || w.SourceSystemKey == listing.SourceSystemKey) &&
+		(w.PropertyType == "" || w.PropertyType == listing.Property.PropertyType)
+}
+
+// Store persists webhooks and their dead letters.
+type Store struct {
+	webhooks    *mongo.Collection
+	deadLetters *mongo.Collection
+	now         func() time.Time
+}
+
+func NewStore(webhooks *mongo.Collection, deadLetters *mongo.Collection) *Store {
+	return &Store{webhooks: webhooks, deadLetters: deadLetters, now: time.Now}
+}
+
+// Create registers a webhook of an owner with a new secret.
+func (s *Store) Create(ctx context.Context, owner string, in *pb.CreateMlsListingWebhookRequest) (*Webhook, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	webhook := &Webhook{
+		Id:              primitive.NewObjectID().Hex(),
+		Owner:           owner,
+		Url:             in.Url,
+		SourceSystemKey: in.SourceSystemKey,
+		PropertyType:    in.PropertyType,
+		ChangeType:      in.ChangeType,
+		Secret:          hex.EncodeToString(secret),
+		CreatedTime:     s.now().UTC(),
+	}
+	if _, err := s.webhooks.InsertOne(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// All returns the registered webhooks of all the owners, oldest first.
+func (s *Store) All(ctx context.Context) ([]*Webhook, error) {
+	return s.find(ctx, bson.D{})
+}
+
+// Owned returns the webhooks of an owner, oldest first.
+func (s *Store) Owned(ctx context.Context, owner string) ([]*Webhook, error) {
+	return s.find(ctx, bson.D{{Key: "owner", Value: owner}})
+}
+
+func (s *Store) find(ctx context.Context, filter bson.D) ([]*Webhook, error) {
+	cursor, err := s.webhooks.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_time", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	webhooks := []*Webhook{}
+	err = cursor.All(ctx, &webhooks)
+	return webhooks, err
+}
+
+// Get returns a webhook of an owner, mongo.ErrNoDocuments when the owner has not registered it.
+func (s *Store) Get(ctx context.Context, owner string, webhookId string) (*Webhook, error) {
+	var webhook Webhook
+	if err := s.webhooks.FindOne(ctx, bson.D{{Key: "_id", Value: webhookId}, {Key: "owner", Value: owner}}).Decode(&webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// Delete removes a webhook of an owner and its dead letters, it returns false when the owner has not registered the webhook.
+func (s *Store) Delete(ctx context.Context, owner string, webhookId string) (bool, error) {
+	result, err := s.webhooks.DeleteOne(ctx, bson.D{{Key: "_id", Value: webhookId}, {Key: "owner", Value: owner}})
+	if err != nil {
+		return false, err
+	}
+	if result.DeletedCount == 0 {
+		return false, nil
+	}
+	if _, err := s.deadLetters.DeleteMany(ctx, bson.D{{Key: "webhook_id", Value: webhookId}}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AddDeadLetter keeps a batch whose delivery attempts are exhausted.
+func (s *Store) AddDeadLetter(ctx context.Context, webhookId string, body []byte, attempts int, deliveryErr error) error {
+	now := s.now().UTC()
+	_, err := s.deadLetters.InsertOne(ctx, &DeadLetter{
+		Id:              primitive.NewObjectID().Hex(),
+		WebhookId:       webhookId,
+		Body:            string(body),
+		Error:           deliveryErr.Error(),
+		Attempts:        int32(attempts),
+		CreatedTime:     now,
+		LastAttemptTime: now,
+	})
+	return err
+}
+
+// DeadLetters returns the dead letters of a webhook, oldest first. All of them when no ids are given.
+func (s *Store) DeadLetters(ctx context.Context, webhookId string, deadLetterIds ...string) ([]*DeadLetter, error) {
+	filter := bson.D{{Key: "webhook_id", Value: webhookId}}
+	if len(deadLetterIds) > 0 {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: deadLetterIds}}})
+	}
+	cursor, err := s.deadLetters.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_time", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := []*DeadLetter{}
+	err = cursor.All(ctx, &deadLetters)
+	return deadLetters, err
+}
+
+// RemoveDeadLetter removes a dead letter once it is delivered.
+func (s *Store) RemoveDeadLetter(ctx context.Context, deadLetterId string) error {
+	_, err := s.deadLetters.DeleteOne(ctx, bson.D{{Key: "_id", Value: deadLetterId}})
+	return err
+}
+
+// RetriedDeadLetter records another failed delivery of a dead letter.
+func (s *Store) RetriedDeadLetter(ctx context.Context, deadLetterId string, attempts int, deliveryErr error) error {
+	_, err := s.deadLetters.UpdateOne(ctx, bson.D{{Key: "_id", Value: deadLetterId}}, bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "attempts", Value: attempts}}},
+		{Key: "$set", Value: bson.D{
+			{Key: "error", Value: deliveryErr.Error()},
+			{Key: "last_attempt_time", Value: s.now().UTC()},
+		}},
+	})
+	return err
+}
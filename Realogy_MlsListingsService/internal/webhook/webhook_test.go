@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	listing := &listingFields{SourceSystemKey: "CABCREIS"}
+	listing.Property.PropertyType = "SFR"
+
+	all := &Webhook{}
+	assert.True(t, all.matches("update", listing))
+	assert.False(t, all.matches("delete", nil))
+
+	bySource := &Webhook{SourceSystemKey: "CABCREIS"}
+	assert.True(t, bySource.matches("insert", listing))
+	assert.False(t, (&Webhook{SourceSystemKey: "AR_TMLS"}).matches("insert", listing))
+	assert.False(t, (&Webhook{SourceSystemKey: "CABCREIS", ChangeType: "delete"}).matches("delete", nil))
+
+	deletes := &Webhook{ChangeType: "delete"}
+	assert.True(t, deletes.matches("delete", nil))
+	assert.False(t, deletes.matches("update", listing))
+
+	assert.True(t, (&Webhook{PropertyType: "SFR", ChangeType: "replace"}).matches("replace", listing))
+	assert.False(t, (&Webhook{PropertyType: "LAND"}).matches("replace", listing))
+}
+
+// loopbackSender is a sender delivering to the test servers, they listen on the loopback address.
+func loopbackSender(maxAttempts int) *Sender {
+	sender := NewSender(time.Second, maxAttempts, time.Millisecond)
+	sender.client.Transport = nil
+	return sender
+}
+
+func TestSendSigned(t *testing.T) {
+	body := []byte(`{"webhookId":"1","events":[]}`)
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	webhook := &Webhook{Id: "1", Url: server.URL, Secret: "secret"}
+	attempts, err := loopbackSender(3).Send(context.Background(), webhook, body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, body, receivedBody)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	timestamp := received.Header.Get(TimestampHeader)
+	assert.NotEmpty(t, timestamp)
+	assert.Equal(t, Sign("secret", timestamp, body), received.Header.Get(SignatureHeader))
+}
+
+func TestSendRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	attempts, err := loopbackSender(3).Send(context.Background(), &Webhook{Url: server.URL}, []byte(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestSendGivesUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	attempts, err := loopbackSender(2).Send(context.Background(), &Webhook{Url: server.URL}, []byte(`{}`))
+	assert.EqualError(t, err, "webhook responded 500 Internal Server Error")
+	assert.Equal(t, 2, attempts)
+}
+
+// client errors other than 429 are not retried.
+func TestSendClientError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	attempts, err := loopbackSender(5).Send(context.Background(), &Webhook{Url: server.URL}, []byte(`{}`))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+// internal addresses are refused once resolved, and not retried.
+func TestSendInternalAddress(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	attempts, err := NewSender(time.Second, 3, time.Millisecond).Send(context.Background(), &Webhook{Url: server.URL}, []byte(`{}`))
+	assert.ErrorIs(t, err, ErrInternalAddress)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, 0, calls)
+}
+
+// a slow webhook does not delay the deliveries of the others.
+func TestQueues(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	var fastCalls int32
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fastCalls, 1)
+	}))
+	defer fast.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &queues{dispatcher: &Dispatcher{sender: loopbackSender(1), queueSize: 2}, byWebhook: map[string]chan *delivery{}}
+	slowWebhook, fastWebhook := &Webhook{Id: "slow", Url: slow.URL}, &Webhook{Id: "fast", Url: fast.URL}
+	q.refresh(ctx, []*Webhook{slowWebhook, fastWebhook})
+
+	first, second := &batch{pending: 2}, &batch{pending: 1}
+	q.byWebhook["slow"] <- &delivery{webhook: slowWebhook, body: []byte(`{}`), batch: first}
+	q.byWebhook["fast"] <- &delivery{webhook: fastWebhook, body: []byte(`{}`), batch: first}
+	q.byWebhook["fast"] <- &delivery{webhook: fastWebhook, body: []byte(`{}`), batch: second}
+	assert.Eventually(t, second.delivered, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fastCalls))
+	// the first batch is delivered once the slow webhook is.
+	assert.False(t, first.delivered())
+	close(release)
+	assert.Eventually(t, first.delivered, time.Second, time.Millisecond)
+
+	// the queues of deleted webhooks are stopped.
+	q.refresh(ctx, []*Webhook{fastWebhook})
+	assert.Len(t, q.byWebhook, 1)
+	q.stop(cancel)
+	assert.Empty(t, q.byWebhook)
+}
@@ -28,15 +28,22 @@ protoc -I/usr/local/include -I. \
           --go-grpc_out $GENERATED_PATH \
           --grpc-gateway_out=logtostderr=true:$GENERATED_PATH \
           --openapiv2_out=json_names_for_fields=true,logtostderr=true:./api/swagger \
-          --proto_path=$PROTO_ROOT_PATH realogy/api/mls/$API_VERSION/mls_listing.proto realogy/api/mls/$API_VERSION/tagger.proto
+          --proto_path=$PROTO_ROOT_PATH realogy/api/mls/$API_VERSION/mls_listing.proto realogy/api/mls/$API_VERSION/mls_listings_stats.proto realogy/api/mls/$API_VERSION/tagger.proto
 
 echo '\t2. Removing "xxx" fields and add appropriate struct tags'
 cd $GENERATED_PATH
 protoc -I/usr/local/include -I. \
             -I$GOPATH/pkg/mod \
             --gotag_out=xxx="graphql+\"-\" bson+\"-\" json+\"-\"":. \
-            --proto_path=$PROTO_ROOT_PATH realogy/api/mls/$API_VERSION/mls_listing.proto
+            --proto_path=$PROTO_ROOT_PATH realogy/api/mls/$API_VERSION/mls_listing.proto realogy/api/mls/$API_VERSION/mls_listings_stats.proto
 
-#echo '\t3. Generating mocks for test'
+echo '\t3. Generating go client stub of the display rules service'
+cd $APP_ROOT_PATH
+protoc -I/usr/local/include -I. \
+          --go_out $GENERATED_PATH \
+          --go-grpc_out $GENERATED_PATH \
+          --proto_path=$PROTO_ROOT_PATH realogy/api/mls/displayrules/$API_VERSION/mls_display_rules.proto
+
+#echo '\t4. Generating mocks for test'
 #cd $APP_ROOT_PATH
 #mockgen -package=mock -destination=internal/services/mock/mock_services.go mlslisting/internal/generated/realogy.com/api/mls/v1 MlsListingServiceClient
\ No newline at end of file